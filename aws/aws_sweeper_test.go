@@ -2,19 +2,28 @@ package aws
 
 import (
 	"context"
+	"errors"
+	"flag"
 	"fmt"
 	"log"
+	"net/http"
 	"os"
+	"reflect"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
 	"time"
+	_ "unsafe" // Required for go:linkname.
 
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/envvar"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
@@ -28,11 +37,125 @@ const defaultSweeperAssumeRoleDurationSeconds = 3600
 // This prevents client re-initialization for every resource with no benefit.
 var sweeperAwsClients map[string]interface{}
 
+// These flags augment the Terraform Plugin SDK -sweep, -sweep-run and -sweep-allow-failures flags.
+var flagSweepDryRun = flag.Bool("sweep-dry-run", false, "List the resources Sweepers would delete, without deleting them")
+var flagSweepTag = flag.String("sweep-tag", "", "Only delete resources carrying this tag, as key=value or key")
+
+// sweeperFuncs is the Terraform Plugin SDK's registry of sweepers added by resource.AddTestSweepers,
+// which is not otherwise exported.
+//
+//go:linkname sweeperFuncs github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource.sweeperFuncs
+var sweeperFuncs map[string]*resource.Sweeper
+
+// testSweepGuard, if set, blocks AWS API requests which may modify resources,
+// other than deletions by testSweepResourceOrchestratorContext.
+var testSweepGuard *sweep.Guard
+
+// testSweepTagFilter, if set, restricts sweeping to resources carrying the tag.
+var testSweepTagFilter *sweep.TagFilter
+
+// testSweepDryRunList records the resources which would be deleted during a dry run.
+var testSweepDryRunList = &testSweepDryRun{}
+
 func TestMain(m *testing.M) {
 	sweeperAwsClients = make(map[string]interface{})
+
+	flag.Parse()
+
+	if regions := flag.Lookup("sweep").Value.String(); regions != "" {
+		if err := testSweepRun(strings.Split(regions, ",")); err != nil {
+			log.Printf("[ERROR] %s", err)
+			os.Exit(1)
+		}
+
+		os.Exit(0)
+	}
+
 	resource.TestMain(m)
 }
 
+func TestSweeperDependencies(t *testing.T) {
+	if err := sweep.NewGraph(sweeperFuncs).Validate(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// testSweepRun runs the sweepers selected by the -sweep-run flag, and their dependencies, in each region.
+// Unlike the Terraform Plugin SDK runner, the whole sweeper dependency graph is validated first,
+// and sweeping can be previewed with -sweep-dry-run or restricted to tagged resources with -sweep-tag.
+func testSweepRun(regions []string) error {
+	allowFailures := flag.Lookup("sweep-allow-failures").Value.String() == "true"
+	g := sweep.NewGraph(sweeperFuncs)
+
+	if err := g.Validate(); err != nil {
+		return fmt.Errorf("error validating sweeper dependencies: %w", err)
+	}
+
+	names, err := g.Order(g.Filter(flag.Lookup("sweep-run").Value.String()))
+
+	if err != nil {
+		return err
+	}
+
+	if *flagSweepTag != "" {
+		testSweepTagFilter, err = sweep.ParseTagFilter(*flagSweepTag)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if *flagSweepDryRun || testSweepTagFilter != nil {
+		testSweepGuard = sweep.NewGuard()
+
+		wrap := httpTransportWrapper
+		httpTransportWrapper = func(transport http.RoundTripper) http.RoundTripper {
+			if wrap != nil {
+				transport = wrap(transport)
+			}
+
+			return testSweepGuard.Wrap(transport)
+		}
+	}
+
+	var sweeperErrorFound bool
+
+	for _, region := range regions {
+		log.Printf("[DEBUG] Running Sweepers for region (%s):\n", region)
+		start := time.Now()
+
+		for _, name := range names {
+			testSweepDryRunList.setSweeper(region, name)
+
+			log.Printf("[DEBUG] Running Sweeper (%s) in region (%s)", name, region)
+			sweeperStart := time.Now()
+			err := sweeperFuncs[name].F(region)
+			log.Printf("[DEBUG] Completed Sweeper (%s) in region (%s) in %s", name, region, time.Since(sweeperStart))
+
+			if err != nil {
+				sweeperErrorFound = true
+				log.Printf("[ERROR] Error running Sweeper (%s) in region (%s): %s", name, region, err)
+
+				if !allowFailures {
+					return fmt.Errorf("sweeper (%s) for region (%s) failed: %w", name, region, err)
+				}
+			}
+		}
+
+		log.Printf("Completed Sweepers for region (%s) in %s", region, time.Since(start))
+	}
+
+	if *flagSweepDryRun {
+		testSweepDryRunList.print()
+	}
+
+	if sweeperErrorFound {
+		return errors.New("at least one sweeper failed")
+	}
+
+	return nil
+}
+
 // sharedClientForRegion returns a common AWSClient setup needed for the sweeper
 // functions for a given region
 func sharedClientForRegion(region string) (interface{}, error) {
@@ -58,24 +181,28 @@ func sharedClientForRegion(region string) (interface{}, error) {
 	}
 
 	if role := os.Getenv(envvar.TfAwsAssumeRoleARN); role != "" {
-		conf.AssumeRoleARN = role
+		assumeRole := &AssumeRole{
+			RoleARN: role,
+		}
 
-		conf.AssumeRoleDurationSeconds = defaultSweeperAssumeRoleDurationSeconds
+		assumeRole.DurationSeconds = defaultSweeperAssumeRoleDurationSeconds
 		if v := os.Getenv(envvar.TfAwsAssumeRoleDuration); v != "" {
 			d, err := strconv.Atoi(v)
 			if err != nil {
 				return nil, fmt.Errorf("environment variable %s: %w", envvar.TfAwsAssumeRoleDuration, err)
 			}
-			conf.AssumeRoleDurationSeconds = d
+			assumeRole.DurationSeconds = d
 		}
 
 		if v := os.Getenv(envvar.TfAwsAssumeRoleExternalID); v != "" {
-			conf.AssumeRoleExternalID = v
+			assumeRole.ExternalID = v
 		}
 
 		if v := os.Getenv(envvar.TfAwsAssumeRoleSessionName); v != "" {
-			conf.AssumeRoleSessionName = v
+			assumeRole.SessionName = v
 		}
+
+		conf.AssumeRole = append(conf.AssumeRole, assumeRole)
 	}
 
 	// configures a default client for the region, using the above env vars
@@ -108,6 +235,28 @@ func testSweepResourceOrchestrator(sweepResources []*testSweepResource) error {
 }
 
 func testSweepResourceOrchestratorContext(ctx context.Context, sweepResources []*testSweepResource, delay time.Duration, delayRand time.Duration, minTimeout time.Duration, pollInterval time.Duration, timeout time.Duration) error {
+	if testSweepTagFilter != nil {
+		sweepResources = testSweepResourcesWithTag(sweepResources, testSweepTagFilter)
+	}
+
+	if *flagSweepDryRun {
+		for _, sweepResource := range sweepResources {
+			testSweepDryRunList.add(sweepResource)
+		}
+
+		return nil
+	}
+
+	if testSweepGuard != nil {
+		return testSweepGuard.Allow(func() error {
+			return testSweepDeleteResources(ctx, sweepResources, delay, delayRand, minTimeout, pollInterval, timeout)
+		})
+	}
+
+	return testSweepDeleteResources(ctx, sweepResources, delay, delayRand, minTimeout, pollInterval, timeout)
+}
+
+func testSweepDeleteResources(ctx context.Context, sweepResources []*testSweepResource, delay time.Duration, delayRand time.Duration, minTimeout time.Duration, pollInterval time.Duration, timeout time.Duration) error {
 	var g multierror.Group
 
 	for _, sweepResource := range sweepResources {
@@ -140,6 +289,164 @@ func testSweepResourceOrchestratorContext(ctx context.Context, sweepResources []
 	return g.Wait().ErrorOrNil()
 }
 
+// testSweepResourcesWithTag returns the resources carrying the tag.
+// Each resource is read to determine its tags. Resources without tags are never selected.
+func testSweepResourcesWithTag(sweepResources []*testSweepResource, filter *sweep.TagFilter) []*testSweepResource {
+	var result []*testSweepResource
+
+	for _, sweepResource := range sweepResources {
+		tags, err := testSweepResourceTags(sweepResource)
+
+		if err != nil {
+			log.Printf("[WARN] Skipping sweep of resource (%s), error reading tags: %s", sweepResource.d.Id(), err)
+			continue
+		}
+
+		if !filter.Match(tags) {
+			log.Printf("[DEBUG] Skipping sweep of resource (%s), tag (%s) not found", sweepResource.d.Id(), filter)
+			continue
+		}
+
+		result = append(result, sweepResource)
+	}
+
+	return result
+}
+
+// testSweepResourceTags reads the resource and returns its tags, including provider default tags.
+func testSweepResourceTags(sweepResource *testSweepResource) (map[string]interface{}, error) {
+	var key string
+
+	for _, k := range []string{"tags_all", "tags"} {
+		if _, ok := sweepResource.resource.Schema[k]; ok {
+			key = k
+			break
+		}
+	}
+
+	if key == "" {
+		return nil, nil
+	}
+
+	if err := testSweepReadResource(sweepResource.resource, sweepResource.d, sweepResource.meta); err != nil {
+		return nil, err
+	}
+
+	if sweepResource.d.Id() == "" {
+		return nil, nil
+	}
+
+	tags, _ := sweepResource.d.Get(key).(map[string]interface{})
+
+	return tags, nil
+}
+
+func testSweepReadResource(resource *schema.Resource, d *schema.ResourceData, meta interface{}) error {
+	if resource.ReadContext != nil || resource.ReadWithoutTimeout != nil {
+		var diags diag.Diagnostics
+
+		if resource.ReadContext != nil {
+			diags = resource.ReadContext(context.Background(), d, meta)
+		} else {
+			diags = resource.ReadWithoutTimeout(context.Background(), d, meta)
+		}
+
+		for i := range diags {
+			if diags[i].Severity == diag.Error {
+				return fmt.Errorf("error reading resource: %s", diags[i].Summary)
+			}
+		}
+
+		return nil
+	}
+
+	return resource.Read(d, meta)
+}
+
+// testSweepDryRun records, by region, the resources which sweepers would delete.
+type testSweepDryRun struct {
+	mu        sync.Mutex
+	region    string
+	sweeper   string
+	regions   []string
+	resources map[string][]string
+
+	// types maps the code pointer of resources' delete functions to their resource type names,
+	// as sweepers construct new *schema.Resource values rather than using the provider's.
+	types map[uintptr]string
+}
+
+func (r *testSweepDryRun) setSweeper(region, sweeper string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.resources[region]; !ok {
+		if r.resources == nil {
+			r.resources = make(map[string][]string)
+		}
+
+		r.regions = append(r.regions, region)
+		r.resources[region] = nil
+	}
+
+	r.region = region
+	r.sweeper = sweeper
+}
+
+func (r *testSweepDryRun) add(sweepResource *testSweepResource) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.types == nil {
+		r.types = make(map[uintptr]string)
+
+		for name, resource := range Provider().ResourcesMap {
+			if p := testSweepDeleteFuncPointer(resource); p != 0 {
+				r.types[p] = name
+			}
+		}
+	}
+
+	resourceType, ok := r.types[testSweepDeleteFuncPointer(sweepResource.resource)]
+
+	if !ok {
+		resourceType = "unknown resource type"
+	}
+
+	r.resources[r.region] = append(r.resources[r.region], fmt.Sprintf("%s (%s), by Sweeper (%s)", resourceType, sweepResource.d.Id(), r.sweeper))
+}
+
+func testSweepDeleteFuncPointer(resource *schema.Resource) uintptr {
+	switch {
+	case resource.DeleteContext != nil:
+		return reflect.ValueOf(resource.DeleteContext).Pointer()
+	case resource.DeleteWithoutTimeout != nil:
+		return reflect.ValueOf(resource.DeleteWithoutTimeout).Pointer()
+	case resource.Delete != nil:
+		return reflect.ValueOf(resource.Delete).Pointer()
+	}
+
+	return 0
+}
+
+func (r *testSweepDryRun) print() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, region := range r.regions {
+		resources := r.resources[region]
+		sort.Strings(resources)
+
+		fmt.Printf("Sweeper dry run for region (%s) would delete %d resources:\n", region, len(resources))
+
+		for _, resource := range resources {
+			fmt.Printf("\t- %s\n", resource)
+		}
+	}
+
+	fmt.Println("Resources deleted by Sweepers without testSweepResourceOrchestrator are not listed, and their deletion was blocked.")
+}
+
 // Check sweeper API call error for reasons to skip sweeping
 // These include missing API endpoints and unsupported API calls
 func testSweepSkipSweepError(err error) bool {
@@ -0,0 +1,132 @@
+package aws
+
+import (
+	"context"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/apimetrics"
+)
+
+// apiMetricsOutput is an API metrics collector and the file, and format, to which its summary is written.
+type apiMetricsOutput struct {
+	collector *apimetrics.Collector
+	format    string
+}
+
+var (
+	apiMetricsOutputs      = make(map[string]*apiMetricsOutput)
+	apiMetricsOutputsMutex sync.Mutex
+)
+
+// apiMetricsCollector returns the API metrics collector whose summary is written to the named file
+// when the provider process stops. Provider configurations sharing a file share a collector.
+func apiMetricsCollector(filename, format string) *apimetrics.Collector {
+	apiMetricsOutputsMutex.Lock()
+	defer apiMetricsOutputsMutex.Unlock()
+
+	if output, ok := apiMetricsOutputs[filename]; ok {
+		return output.collector
+	}
+
+	output := &apiMetricsOutput{
+		collector: apimetrics.NewCollector(),
+		format:    format,
+	}
+	apiMetricsOutputs[filename] = output
+
+	return output.collector
+}
+
+// WriteAPIMetrics writes the API metrics summaries of all provider configurations with api_metrics_path set.
+// It must be called when the provider process stops serving requests.
+func WriteAPIMetrics() {
+	apiMetricsOutputsMutex.Lock()
+	defer apiMetricsOutputsMutex.Unlock()
+
+	filenames := make([]string, 0, len(apiMetricsOutputs))
+
+	for filename := range apiMetricsOutputs {
+		filenames = append(filenames, filename)
+	}
+
+	sort.Strings(filenames)
+
+	for _, filename := range filenames {
+		output := apiMetricsOutputs[filename]
+
+		log.Printf("[DEBUG] Writing API metrics (%s) to %s", output.format, filename)
+
+		if err := output.collector.WriteFile(filename, output.format); err != nil {
+			log.Printf("[ERROR] %s", err)
+		}
+	}
+}
+
+// configureAPIMetrics wraps the CRUD functions of resources and data sources to record their
+// latency and to attribute their API calls to the resource type in API metrics.
+// Data source types are recorded with a "data." prefix, e.g. "data.aws_vpc".
+func configureAPIMetrics(resources, dataSources map[string]*schema.Resource) {
+	for resourceType, resource := range resources {
+		wrapResourceFuncs(resource, apiMetricsWrappers(resourceType))
+	}
+
+	for dataSourceType, dataSource := range dataSources {
+		wrapResourceFuncs(dataSource, apiMetricsWrappers("data."+dataSourceType))
+	}
+}
+
+func apiMetricsWrappers(resourceType string) resourceFuncWrappers {
+	return resourceFuncWrappers{
+		CRUD: func(operation string, f resourceCRUDFunc) resourceCRUDFunc {
+			return apiMetricsCRUDFunc(resourceType, operation, f)
+		},
+		CRUDContext: func(operation string, f resourceCRUDContextFunc) resourceCRUDContextFunc {
+			return apiMetricsCRUDContextFunc(resourceType, operation, f)
+		},
+	}
+}
+
+// apiMetricsResourceClient returns a client, sharing the provider client's configuration,
+// whose service clients attribute their API calls to the resource type.
+func (client *AWSClient) apiMetricsResourceClient(resourceType string) *AWSClient {
+	return client.resourceClient("apimetrics/"+resourceType, func(v *AWSClient) {
+		v.apiMetricsResourceType = resourceType
+	})
+}
+
+func apiMetricsCRUDFunc(resourceType, operation string, f resourceCRUDFunc) resourceCRUDFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		client, ok := meta.(*AWSClient)
+
+		if !ok || client.apiMetrics == nil {
+			return f(d, meta)
+		}
+
+		start := time.Now()
+		err := f(d, client.apiMetricsResourceClient(resourceType))
+		client.apiMetrics.ObserveResourceOperation(resourceType, operation, time.Since(start), err != nil)
+
+		return err
+	}
+}
+
+func apiMetricsCRUDContextFunc(resourceType, operation string, f resourceCRUDContextFunc) resourceCRUDContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		client, ok := meta.(*AWSClient)
+
+		if !ok || client.apiMetrics == nil {
+			return f(ctx, d, meta)
+		}
+
+		start := time.Now()
+		diags := f(ctx, d, client.apiMetricsResourceClient(resourceType))
+		client.apiMetrics.ObserveResourceOperation(resourceType, operation, time.Since(start), diags.HasError())
+
+		return diags
+	}
+}
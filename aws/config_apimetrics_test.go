@@ -0,0 +1,88 @@
+package aws
+
+import (
+	"errors"
+	"testing"
+
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/apimetrics"
+)
+
+func TestConfigureAPIMetrics(t *testing.T) {
+	ec2Endpoints := []*awsbase.MockEndpoint{
+		{
+			Request: &awsbase.MockRequest{
+				Method: "POST",
+				Uri:    "/",
+				Body:   "Action=DescribeAccountAttributes&AttributeName.1=supported-platforms&Version=2016-11-15",
+			},
+			Response: &awsbase.MockResponse{
+				StatusCode:  200,
+				Body:        test_ec2_describeAccountAttributes_response,
+				ContentType: "text/xml",
+			},
+		},
+	}
+	closeFunc, sess, err := awsbase.GetMockedAwsApiSession("EC2", ec2Endpoints)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closeFunc()
+
+	collector := apimetrics.NewCollector()
+	client := &AWSClient{
+		apiMetrics: collector,
+		session:    sess,
+	}
+
+	resources := map[string]*schema.Resource{
+		"aws_test": {
+			Read: func(d *schema.ResourceData, meta interface{}) error {
+				_, err := GetSupportedEC2Platforms(meta.(*AWSClient).ec2conn())
+				return err
+			},
+			Delete: func(d *schema.ResourceData, meta interface{}) error {
+				return errors.New("test")
+			},
+		},
+	}
+
+	configureAPIMetrics(resources, nil)
+
+	if err := resources["aws_test"].Read(nil, client); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := resources["aws_test"].Delete(nil, client); err == nil {
+		t.Fatalf("expected error")
+	}
+
+	summary := collector.Summary()
+
+	if got, expected := len(summary.Resources), 1; got != expected {
+		t.Fatalf("got %d resources, expected %d", got, expected)
+	}
+
+	resource := summary.Resources[0]
+
+	if got, expected := resource.ResourceType, "aws_test"; got != expected {
+		t.Errorf("got resource type %q, expected %q", got, expected)
+	}
+
+	if got, expected := resource.APICalls.Calls, int64(1); got != expected {
+		t.Errorf("got %d API calls, expected %d", got, expected)
+	}
+
+	if v := resource.Operations["read"]; v == nil || v.Calls != 1 || v.Errors != 0 {
+		t.Errorf("unexpected read stats: %+v", v)
+	}
+
+	if v := resource.Operations["delete"]; v == nil || v.Calls != 1 || v.Errors != 1 {
+		t.Errorf("unexpected delete stats: %+v", v)
+	}
+
+	if got, expected := len(summary.Operations), 1; got != expected || summary.Operations[0].Operation != "DescribeAccountAttributes" {
+		t.Errorf("unexpected operations: %+v", summary.Operations)
+	}
+}
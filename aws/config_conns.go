@@ -0,0 +1,1179 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/aws-sdk-go/service/acmpca"
+	"github.com/aws/aws-sdk-go/service/amplify"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go/service/appconfig"
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go/service/applicationinsights"
+	"github.com/aws/aws-sdk-go/service/appmesh"
+	"github.com/aws/aws-sdk-go/service/apprunner"
+	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/aws/aws-sdk-go/service/appsync"
+	"github.com/aws/aws-sdk-go/service/athena"
+	"github.com/aws/aws-sdk-go/service/auditmanager"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/autoscalingplans"
+	"github.com/aws/aws-sdk-go/service/backup"
+	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/aws/aws-sdk-go/service/budgets"
+	"github.com/aws/aws-sdk-go/service/chime"
+	"github.com/aws/aws-sdk-go/service/cloud9"
+	"github.com/aws/aws-sdk-go/service/cloudcontrolapi"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/aws/aws-sdk-go/service/cloudhsmv2"
+	"github.com/aws/aws-sdk-go/service/cloudsearch"
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/codeartifact"
+	"github.com/aws/aws-sdk-go/service/codebuild"
+	"github.com/aws/aws-sdk-go/service/codecommit"
+	"github.com/aws/aws-sdk-go/service/codedeploy"
+	"github.com/aws/aws-sdk-go/service/codepipeline"
+	"github.com/aws/aws-sdk-go/service/codestarconnections"
+	"github.com/aws/aws-sdk-go/service/codestarnotifications"
+	"github.com/aws/aws-sdk-go/service/cognitoidentity"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/aws/aws-sdk-go/service/costandusagereportservice"
+	"github.com/aws/aws-sdk-go/service/databasemigrationservice"
+	"github.com/aws/aws-sdk-go/service/dataexchange"
+	"github.com/aws/aws-sdk-go/service/datapipeline"
+	"github.com/aws/aws-sdk-go/service/datasync"
+	"github.com/aws/aws-sdk-go/service/dax"
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/aws/aws-sdk-go/service/devicefarm"
+	"github.com/aws/aws-sdk-go/service/directconnect"
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/aws/aws-sdk-go/service/dlm"
+	"github.com/aws/aws-sdk-go/service/docdb"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/aws/aws-sdk-go/service/ecrpublic"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	elasticsearch "github.com/aws/aws-sdk-go/service/elasticsearchservice"
+	"github.com/aws/aws-sdk-go/service/elastictranscoder"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/emr"
+	"github.com/aws/aws-sdk-go/service/emrcontainers"
+	"github.com/aws/aws-sdk-go/service/firehose"
+	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/aws/aws-sdk-go/service/forecastservice"
+	"github.com/aws/aws-sdk-go/service/fsx"
+	"github.com/aws/aws-sdk-go/service/gamelift"
+	"github.com/aws/aws-sdk-go/service/glacier"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/aws/aws-sdk-go/service/greengrass"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/identitystore"
+	"github.com/aws/aws-sdk-go/service/imagebuilder"
+	"github.com/aws/aws-sdk-go/service/inspector"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	"github.com/aws/aws-sdk-go/service/kafka"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/aws/aws-sdk-go/service/kinesisanalytics"
+	"github.com/aws/aws-sdk-go/service/kinesisanalyticsv2"
+	"github.com/aws/aws-sdk-go/service/kinesisvideo"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/aws/aws-sdk-go/service/licensemanager"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/aws/aws-sdk-go/service/locationservice"
+	"github.com/aws/aws-sdk-go/service/macie"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/aws/aws-sdk-go/service/managedblockchain"
+	"github.com/aws/aws-sdk-go/service/marketplacecatalog"
+	"github.com/aws/aws-sdk-go/service/mediaconnect"
+	"github.com/aws/aws-sdk-go/service/mediaconvert"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/aws/aws-sdk-go/service/mediapackage"
+	"github.com/aws/aws-sdk-go/service/mediastore"
+	"github.com/aws/aws-sdk-go/service/mediastoredata"
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	"github.com/aws/aws-sdk-go/service/mq"
+	"github.com/aws/aws-sdk-go/service/mwaa"
+	"github.com/aws/aws-sdk-go/service/neptune"
+	"github.com/aws/aws-sdk-go/service/networkfirewall"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/aws/aws-sdk-go/service/opsworks"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/aws/aws-sdk-go/service/outposts"
+	"github.com/aws/aws-sdk-go/service/personalize"
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	"github.com/aws/aws-sdk-go/service/pricing"
+	"github.com/aws/aws-sdk-go/service/prometheusservice"
+	"github.com/aws/aws-sdk-go/service/qldb"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/aws/aws-sdk-go/service/resourcegroups"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/route53domains"
+	"github.com/aws/aws-sdk-go/service/route53recoverycontrolconfig"
+	"github.com/aws/aws-sdk-go/service/route53recoveryreadiness"
+	"github.com/aws/aws-sdk-go/service/route53resolver"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/aws/aws-sdk-go/service/s3outposts"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/aws/aws-sdk-go/service/schemas"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/aws/aws-sdk-go/service/serverlessapplicationrepository"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/aws/aws-sdk-go/service/ses"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/aws/aws-sdk-go/service/signer"
+	"github.com/aws/aws-sdk-go/service/simpledb"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/aws/aws-sdk-go/service/storagegateway"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/aws/aws-sdk-go/service/swf"
+	"github.com/aws/aws-sdk-go/service/synthetics"
+	"github.com/aws/aws-sdk-go/service/timestreamwrite"
+	"github.com/aws/aws-sdk-go/service/transfer"
+	"github.com/aws/aws-sdk-go/service/waf"
+	"github.com/aws/aws-sdk-go/service/wafregional"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/aws/aws-sdk-go/service/worklink"
+	"github.com/aws/aws-sdk-go/service/workmail"
+	"github.com/aws/aws-sdk-go/service/workspaces"
+	"github.com/aws/aws-sdk-go/service/xray"
+)
+
+// Service clients are created on first use via serviceConn and cached on the
+// AWSClient for the lifetime of the provider, so that configuring the provider
+// only pays for the services that a configuration actually touches.
+// Service specific retryable errors are registered in defaultRetryableErrors.
+
+func (client *AWSClient) accessanalyzerconn() *accessanalyzer.AccessAnalyzer {
+	return client.serviceConn("accessanalyzerconn", "accessanalyzer", func(sess *session.Session) interface{} {
+		return accessanalyzer.New(sess)
+	}).(*accessanalyzer.AccessAnalyzer)
+}
+
+func (client *AWSClient) acmconn() *acm.ACM {
+	return client.serviceConn("acmconn", "acm", func(sess *session.Session) interface{} {
+		return acm.New(sess)
+	}).(*acm.ACM)
+}
+
+func (client *AWSClient) acmpcaconn() *acmpca.ACMPCA {
+	return client.serviceConn("acmpcaconn", "acmpca", func(sess *session.Session) interface{} {
+		return acmpca.New(sess)
+	}).(*acmpca.ACMPCA)
+}
+
+func (client *AWSClient) amplifyconn() *amplify.Amplify {
+	return client.serviceConn("amplifyconn", "amplify", func(sess *session.Session) interface{} {
+		return amplify.New(sess)
+	}).(*amplify.Amplify)
+}
+
+func (client *AWSClient) apigatewayconn() *apigateway.APIGateway {
+	return client.serviceConn("apigatewayconn", "apigateway", func(sess *session.Session) interface{} {
+		return apigateway.New(sess)
+	}).(*apigateway.APIGateway)
+}
+
+func (client *AWSClient) apigatewayv2conn() *apigatewayv2.ApiGatewayV2 {
+	return client.serviceConn("apigatewayv2conn", "apigateway", func(sess *session.Session) interface{} {
+		return apigatewayv2.New(sess)
+	}).(*apigatewayv2.ApiGatewayV2)
+}
+
+func (client *AWSClient) appautoscalingconn() *applicationautoscaling.ApplicationAutoScaling {
+	return client.serviceConn("appautoscalingconn", "applicationautoscaling", func(sess *session.Session) interface{} {
+		return applicationautoscaling.New(sess)
+	}).(*applicationautoscaling.ApplicationAutoScaling)
+}
+
+func (client *AWSClient) appconfigconn() *appconfig.AppConfig {
+	return client.serviceConn("appconfigconn", "appconfig", func(sess *session.Session) interface{} {
+		return appconfig.New(sess)
+	}).(*appconfig.AppConfig)
+}
+
+func (client *AWSClient) applicationinsightsconn() *applicationinsights.ApplicationInsights {
+	return client.serviceConn("applicationinsightsconn", "applicationinsights", func(sess *session.Session) interface{} {
+		return applicationinsights.New(sess)
+	}).(*applicationinsights.ApplicationInsights)
+}
+
+func (client *AWSClient) appmeshconn() *appmesh.AppMesh {
+	return client.serviceConn("appmeshconn", "appmesh", func(sess *session.Session) interface{} {
+		return appmesh.New(sess)
+	}).(*appmesh.AppMesh)
+}
+
+func (client *AWSClient) apprunnerconn() *apprunner.AppRunner {
+	return client.serviceConn("apprunnerconn", "apprunner", func(sess *session.Session) interface{} {
+		return apprunner.New(sess)
+	}).(*apprunner.AppRunner)
+}
+
+func (client *AWSClient) appstreamconn() *appstream.AppStream {
+	return client.serviceConn("appstreamconn", "appstream", func(sess *session.Session) interface{} {
+		return appstream.New(sess)
+	}).(*appstream.AppStream)
+}
+
+func (client *AWSClient) appsyncconn() *appsync.AppSync {
+	return client.serviceConn("appsyncconn", "appsync", func(sess *session.Session) interface{} {
+		return appsync.New(sess)
+	}).(*appsync.AppSync)
+}
+
+func (client *AWSClient) athenaconn() *athena.Athena {
+	return client.serviceConn("athenaconn", "athena", func(sess *session.Session) interface{} {
+		return athena.New(sess)
+	}).(*athena.Athena)
+}
+
+func (client *AWSClient) auditmanagerconn() *auditmanager.AuditManager {
+	return client.serviceConn("auditmanagerconn", "auditmanager", func(sess *session.Session) interface{} {
+		return auditmanager.New(sess)
+	}).(*auditmanager.AuditManager)
+}
+
+func (client *AWSClient) autoscalingconn() *autoscaling.AutoScaling {
+	return client.serviceConn("autoscalingconn", "autoscaling", func(sess *session.Session) interface{} {
+		return autoscaling.New(sess)
+	}).(*autoscaling.AutoScaling)
+}
+
+func (client *AWSClient) autoscalingplansconn() *autoscalingplans.AutoScalingPlans {
+	return client.serviceConn("autoscalingplansconn", "autoscalingplans", func(sess *session.Session) interface{} {
+		return autoscalingplans.New(sess)
+	}).(*autoscalingplans.AutoScalingPlans)
+}
+
+func (client *AWSClient) backupconn() *backup.Backup {
+	return client.serviceConn("backupconn", "backup", func(sess *session.Session) interface{} {
+		return backup.New(sess)
+	}).(*backup.Backup)
+}
+
+func (client *AWSClient) batchconn() *batch.Batch {
+	return client.serviceConn("batchconn", "batch", func(sess *session.Session) interface{} {
+		return batch.New(sess)
+	}).(*batch.Batch)
+}
+
+func (client *AWSClient) budgetconn() *budgets.Budgets {
+	return client.serviceConn("budgetconn", "budgets", func(sess *session.Session) interface{} {
+		return budgets.New(sess)
+	}).(*budgets.Budgets)
+}
+
+func (client *AWSClient) cfconn() *cloudformation.CloudFormation {
+	return client.serviceConn("cfconn", "cloudformation", func(sess *session.Session) interface{} {
+		return cloudformation.New(sess)
+	}).(*cloudformation.CloudFormation)
+}
+
+func (client *AWSClient) chimeconn() *chime.Chime {
+	return client.serviceConn("chimeconn", "chime", func(sess *session.Session) interface{} {
+		return chime.New(sess)
+	}).(*chime.Chime)
+}
+
+func (client *AWSClient) cloud9conn() *cloud9.Cloud9 {
+	return client.serviceConn("cloud9conn", "cloud9", func(sess *session.Session) interface{} {
+		return cloud9.New(sess)
+	}).(*cloud9.Cloud9)
+}
+
+func (client *AWSClient) cloudcontrolapiconn() *cloudcontrolapi.CloudControlApi {
+	return client.serviceConn("cloudcontrolapiconn", "cloudcontrolapi", func(sess *session.Session) interface{} {
+		return cloudcontrolapi.New(sess)
+	}).(*cloudcontrolapi.CloudControlApi)
+}
+
+func (client *AWSClient) cloudfrontconn() *cloudfront.CloudFront {
+	return client.serviceConn("cloudfrontconn", "cloudfront", func(sess *session.Session) interface{} {
+		return cloudfront.New(sess)
+	}).(*cloudfront.CloudFront)
+}
+
+func (client *AWSClient) cloudhsmv2conn() *cloudhsmv2.CloudHSMV2 {
+	return client.serviceConn("cloudhsmv2conn", "cloudhsm", func(sess *session.Session) interface{} {
+		return cloudhsmv2.New(sess)
+	}).(*cloudhsmv2.CloudHSMV2)
+}
+
+func (client *AWSClient) cloudsearchconn() *cloudsearch.CloudSearch {
+	return client.serviceConn("cloudsearchconn", "cloudsearch", func(sess *session.Session) interface{} {
+		return cloudsearch.New(sess)
+	}).(*cloudsearch.CloudSearch)
+}
+
+func (client *AWSClient) cloudtrailconn() *cloudtrail.CloudTrail {
+	return client.serviceConn("cloudtrailconn", "cloudtrail", func(sess *session.Session) interface{} {
+		return cloudtrail.New(sess)
+	}).(*cloudtrail.CloudTrail)
+}
+
+func (client *AWSClient) cloudwatchconn() *cloudwatch.CloudWatch {
+	return client.serviceConn("cloudwatchconn", "cloudwatch", func(sess *session.Session) interface{} {
+		return cloudwatch.New(sess)
+	}).(*cloudwatch.CloudWatch)
+}
+
+func (client *AWSClient) cloudwatcheventsconn() *cloudwatchevents.CloudWatchEvents {
+	return client.serviceConn("cloudwatcheventsconn", "cloudwatchevents", func(sess *session.Session) interface{} {
+		return cloudwatchevents.New(sess)
+	}).(*cloudwatchevents.CloudWatchEvents)
+}
+
+func (client *AWSClient) cloudwatchlogsconn() *cloudwatchlogs.CloudWatchLogs {
+	return client.serviceConn("cloudwatchlogsconn", "cloudwatchlogs", func(sess *session.Session) interface{} {
+		return cloudwatchlogs.New(sess)
+	}).(*cloudwatchlogs.CloudWatchLogs)
+}
+
+func (client *AWSClient) codeartifactconn() *codeartifact.CodeArtifact {
+	return client.serviceConn("codeartifactconn", "codeartifact", func(sess *session.Session) interface{} {
+		return codeartifact.New(sess)
+	}).(*codeartifact.CodeArtifact)
+}
+
+func (client *AWSClient) codebuildconn() *codebuild.CodeBuild {
+	return client.serviceConn("codebuildconn", "codebuild", func(sess *session.Session) interface{} {
+		return codebuild.New(sess)
+	}).(*codebuild.CodeBuild)
+}
+
+func (client *AWSClient) codecommitconn() *codecommit.CodeCommit {
+	return client.serviceConn("codecommitconn", "codecommit", func(sess *session.Session) interface{} {
+		return codecommit.New(sess)
+	}).(*codecommit.CodeCommit)
+}
+
+func (client *AWSClient) codedeployconn() *codedeploy.CodeDeploy {
+	return client.serviceConn("codedeployconn", "codedeploy", func(sess *session.Session) interface{} {
+		return codedeploy.New(sess)
+	}).(*codedeploy.CodeDeploy)
+}
+
+func (client *AWSClient) codepipelineconn() *codepipeline.CodePipeline {
+	return client.serviceConn("codepipelineconn", "codepipeline", func(sess *session.Session) interface{} {
+		return codepipeline.New(sess)
+	}).(*codepipeline.CodePipeline)
+}
+
+func (client *AWSClient) codestarconnectionsconn() *codestarconnections.CodeStarConnections {
+	return client.serviceConn("codestarconnectionsconn", "codestarconnections", func(sess *session.Session) interface{} {
+		return codestarconnections.New(sess)
+	}).(*codestarconnections.CodeStarConnections)
+}
+
+func (client *AWSClient) codestarnotificationsconn() *codestarnotifications.CodeStarNotifications {
+	return client.serviceConn("codestarnotificationsconn", "codestarnotifications", func(sess *session.Session) interface{} {
+		return codestarnotifications.New(sess)
+	}).(*codestarnotifications.CodeStarNotifications)
+}
+
+func (client *AWSClient) cognitoconn() *cognitoidentity.CognitoIdentity {
+	return client.serviceConn("cognitoconn", "cognitoidentity", func(sess *session.Session) interface{} {
+		return cognitoidentity.New(sess)
+	}).(*cognitoidentity.CognitoIdentity)
+}
+
+func (client *AWSClient) cognitoidpconn() *cognitoidentityprovider.CognitoIdentityProvider {
+	return client.serviceConn("cognitoidpconn", "cognitoidp", func(sess *session.Session) interface{} {
+		return cognitoidentityprovider.New(sess)
+	}).(*cognitoidentityprovider.CognitoIdentityProvider)
+}
+
+func (client *AWSClient) configconn() *configservice.ConfigService {
+	return client.serviceConn("configconn", "configservice", func(sess *session.Session) interface{} {
+		return configservice.New(sess)
+	}).(*configservice.ConfigService)
+}
+
+func (client *AWSClient) connectconn() *connect.Connect {
+	return client.serviceConn("connectconn", "connect", func(sess *session.Session) interface{} {
+		return connect.New(sess)
+	}).(*connect.Connect)
+}
+
+func (client *AWSClient) costandusagereportconn() *costandusagereportservice.CostandUsageReportService {
+	return client.serviceConn("costandusagereportconn", "cur", func(sess *session.Session) interface{} {
+		return costandusagereportservice.New(sess)
+	}).(*costandusagereportservice.CostandUsageReportService)
+}
+
+func (client *AWSClient) dataexchangeconn() *dataexchange.DataExchange {
+	return client.serviceConn("dataexchangeconn", "dataexchange", func(sess *session.Session) interface{} {
+		return dataexchange.New(sess)
+	}).(*dataexchange.DataExchange)
+}
+
+func (client *AWSClient) datapipelineconn() *datapipeline.DataPipeline {
+	return client.serviceConn("datapipelineconn", "datapipeline", func(sess *session.Session) interface{} {
+		return datapipeline.New(sess)
+	}).(*datapipeline.DataPipeline)
+}
+
+func (client *AWSClient) datasyncconn() *datasync.DataSync {
+	return client.serviceConn("datasyncconn", "datasync", func(sess *session.Session) interface{} {
+		return datasync.New(sess)
+	}).(*datasync.DataSync)
+}
+
+func (client *AWSClient) daxconn() *dax.DAX {
+	return client.serviceConn("daxconn", "dax", func(sess *session.Session) interface{} {
+		return dax.New(sess)
+	}).(*dax.DAX)
+}
+
+func (client *AWSClient) detectiveconn() *detective.Detective {
+	return client.serviceConn("detectiveconn", "detective", func(sess *session.Session) interface{} {
+		return detective.New(sess)
+	}).(*detective.Detective)
+}
+
+func (client *AWSClient) devicefarmconn() *devicefarm.DeviceFarm {
+	return client.serviceConn("devicefarmconn", "devicefarm", func(sess *session.Session) interface{} {
+		return devicefarm.New(sess)
+	}).(*devicefarm.DeviceFarm)
+}
+
+func (client *AWSClient) dlmconn() *dlm.DLM {
+	return client.serviceConn("dlmconn", "dlm", func(sess *session.Session) interface{} {
+		return dlm.New(sess)
+	}).(*dlm.DLM)
+}
+
+func (client *AWSClient) dmsconn() *databasemigrationservice.DatabaseMigrationService {
+	return client.serviceConn("dmsconn", "dms", func(sess *session.Session) interface{} {
+		return databasemigrationservice.New(sess)
+	}).(*databasemigrationservice.DatabaseMigrationService)
+}
+
+func (client *AWSClient) docdbconn() *docdb.DocDB {
+	return client.serviceConn("docdbconn", "docdb", func(sess *session.Session) interface{} {
+		return docdb.New(sess)
+	}).(*docdb.DocDB)
+}
+
+func (client *AWSClient) dsconn() *directoryservice.DirectoryService {
+	return client.serviceConn("dsconn", "ds", func(sess *session.Session) interface{} {
+		return directoryservice.New(sess)
+	}).(*directoryservice.DirectoryService)
+}
+
+func (client *AWSClient) dxconn() *directconnect.DirectConnect {
+	return client.serviceConn("dxconn", "directconnect", func(sess *session.Session) interface{} {
+		return directconnect.New(sess)
+	}).(*directconnect.DirectConnect)
+}
+
+func (client *AWSClient) dynamodbconn() *dynamodb.DynamoDB {
+	return client.serviceConn("dynamodbconn", "dynamodb", func(sess *session.Session) interface{} {
+		return dynamodb.New(sess)
+	}).(*dynamodb.DynamoDB)
+}
+
+func (client *AWSClient) ec2conn() *ec2.EC2 {
+	return client.serviceConn("ec2conn", "ec2", func(sess *session.Session) interface{} {
+		return ec2.New(sess)
+	}).(*ec2.EC2)
+}
+
+func (client *AWSClient) ecrconn() *ecr.ECR {
+	return client.serviceConn("ecrconn", "ecr", func(sess *session.Session) interface{} {
+		return ecr.New(sess)
+	}).(*ecr.ECR)
+}
+
+func (client *AWSClient) ecrpublicconn() *ecrpublic.ECRPublic {
+	return client.serviceConn("ecrpublicconn", "ecrpublic", func(sess *session.Session) interface{} {
+		return ecrpublic.New(sess)
+	}).(*ecrpublic.ECRPublic)
+}
+
+func (client *AWSClient) ecsconn() *ecs.ECS {
+	return client.serviceConn("ecsconn", "ecs", func(sess *session.Session) interface{} {
+		return ecs.New(sess)
+	}).(*ecs.ECS)
+}
+
+func (client *AWSClient) efsconn() *efs.EFS {
+	return client.serviceConn("efsconn", "efs", func(sess *session.Session) interface{} {
+		return efs.New(sess)
+	}).(*efs.EFS)
+}
+
+func (client *AWSClient) eksconn() *eks.EKS {
+	return client.serviceConn("eksconn", "eks", func(sess *session.Session) interface{} {
+		return eks.New(sess)
+	}).(*eks.EKS)
+}
+
+func (client *AWSClient) elasticacheconn() *elasticache.ElastiCache {
+	return client.serviceConn("elasticacheconn", "elasticache", func(sess *session.Session) interface{} {
+		return elasticache.New(sess)
+	}).(*elasticache.ElastiCache)
+}
+
+func (client *AWSClient) elasticbeanstalkconn() *elasticbeanstalk.ElasticBeanstalk {
+	return client.serviceConn("elasticbeanstalkconn", "elasticbeanstalk", func(sess *session.Session) interface{} {
+		return elasticbeanstalk.New(sess)
+	}).(*elasticbeanstalk.ElasticBeanstalk)
+}
+
+func (client *AWSClient) elastictranscoderconn() *elastictranscoder.ElasticTranscoder {
+	return client.serviceConn("elastictranscoderconn", "elastictranscoder", func(sess *session.Session) interface{} {
+		return elastictranscoder.New(sess)
+	}).(*elastictranscoder.ElasticTranscoder)
+}
+
+func (client *AWSClient) elbconn() *elb.ELB {
+	return client.serviceConn("elbconn", "elb", func(sess *session.Session) interface{} {
+		return elb.New(sess)
+	}).(*elb.ELB)
+}
+
+func (client *AWSClient) elbv2conn() *elbv2.ELBV2 {
+	return client.serviceConn("elbv2conn", "elb", func(sess *session.Session) interface{} {
+		return elbv2.New(sess)
+	}).(*elbv2.ELBV2)
+}
+
+func (client *AWSClient) emrconn() *emr.EMR {
+	return client.serviceConn("emrconn", "emr", func(sess *session.Session) interface{} {
+		return emr.New(sess)
+	}).(*emr.EMR)
+}
+
+func (client *AWSClient) emrcontainersconn() *emrcontainers.EMRContainers {
+	return client.serviceConn("emrcontainersconn", "emrcontainers", func(sess *session.Session) interface{} {
+		return emrcontainers.New(sess)
+	}).(*emrcontainers.EMRContainers)
+}
+
+func (client *AWSClient) esconn() *elasticsearch.ElasticsearchService {
+	return client.serviceConn("esconn", "es", func(sess *session.Session) interface{} {
+		return elasticsearch.New(sess)
+	}).(*elasticsearch.ElasticsearchService)
+}
+
+func (client *AWSClient) firehoseconn() *firehose.Firehose {
+	return client.serviceConn("firehoseconn", "firehose", func(sess *session.Session) interface{} {
+		return firehose.New(sess)
+	}).(*firehose.Firehose)
+}
+
+func (client *AWSClient) fmsconn() *fms.FMS {
+	return client.serviceConn("fmsconn", "fms", func(sess *session.Session) interface{} {
+		return fms.New(sess)
+	}).(*fms.FMS)
+}
+
+func (client *AWSClient) forecastconn() *forecastservice.ForecastService {
+	return client.serviceConn("forecastconn", "forecast", func(sess *session.Session) interface{} {
+		return forecastservice.New(sess)
+	}).(*forecastservice.ForecastService)
+}
+
+func (client *AWSClient) fsxconn() *fsx.FSx {
+	return client.serviceConn("fsxconn", "fsx", func(sess *session.Session) interface{} {
+		return fsx.New(sess)
+	}).(*fsx.FSx)
+}
+
+func (client *AWSClient) gameliftconn() *gamelift.GameLift {
+	return client.serviceConn("gameliftconn", "gamelift", func(sess *session.Session) interface{} {
+		return gamelift.New(sess)
+	}).(*gamelift.GameLift)
+}
+
+func (client *AWSClient) glacierconn() *glacier.Glacier {
+	return client.serviceConn("glacierconn", "glacier", func(sess *session.Session) interface{} {
+		return glacier.New(sess)
+	}).(*glacier.Glacier)
+}
+
+func (client *AWSClient) globalacceleratorconn() *globalaccelerator.GlobalAccelerator {
+	return client.serviceConn("globalacceleratorconn", "globalaccelerator", func(sess *session.Session) interface{} {
+		return globalaccelerator.New(sess)
+	}).(*globalaccelerator.GlobalAccelerator)
+}
+
+func (client *AWSClient) glueconn() *glue.Glue {
+	return client.serviceConn("glueconn", "glue", func(sess *session.Session) interface{} {
+		return glue.New(sess)
+	}).(*glue.Glue)
+}
+
+func (client *AWSClient) greengrassconn() *greengrass.Greengrass {
+	return client.serviceConn("greengrassconn", "greengrass", func(sess *session.Session) interface{} {
+		return greengrass.New(sess)
+	}).(*greengrass.Greengrass)
+}
+
+func (client *AWSClient) guarddutyconn() *guardduty.GuardDuty {
+	return client.serviceConn("guarddutyconn", "guardduty", func(sess *session.Session) interface{} {
+		return guardduty.New(sess)
+	}).(*guardduty.GuardDuty)
+}
+
+func (client *AWSClient) iamconn() *iam.IAM {
+	return client.serviceConn("iamconn", "iam", func(sess *session.Session) interface{} {
+		return iam.New(sess)
+	}).(*iam.IAM)
+}
+
+func (client *AWSClient) identitystoreconn() *identitystore.IdentityStore {
+	return client.serviceConn("identitystoreconn", "identitystore", func(sess *session.Session) interface{} {
+		return identitystore.New(sess)
+	}).(*identitystore.IdentityStore)
+}
+
+func (client *AWSClient) imagebuilderconn() *imagebuilder.Imagebuilder {
+	return client.serviceConn("imagebuilderconn", "imagebuilder", func(sess *session.Session) interface{} {
+		return imagebuilder.New(sess)
+	}).(*imagebuilder.Imagebuilder)
+}
+
+func (client *AWSClient) inspectorconn() *inspector.Inspector {
+	return client.serviceConn("inspectorconn", "inspector", func(sess *session.Session) interface{} {
+		return inspector.New(sess)
+	}).(*inspector.Inspector)
+}
+
+func (client *AWSClient) iotanalyticsconn() *iotanalytics.IoTAnalytics {
+	return client.serviceConn("iotanalyticsconn", "iotanalytics", func(sess *session.Session) interface{} {
+		return iotanalytics.New(sess)
+	}).(*iotanalytics.IoTAnalytics)
+}
+
+func (client *AWSClient) iotconn() *iot.IoT {
+	return client.serviceConn("iotconn", "iot", func(sess *session.Session) interface{} {
+		return iot.New(sess)
+	}).(*iot.IoT)
+}
+
+func (client *AWSClient) ioteventsconn() *iotevents.IoTEvents {
+	return client.serviceConn("ioteventsconn", "iotevents", func(sess *session.Session) interface{} {
+		return iotevents.New(sess)
+	}).(*iotevents.IoTEvents)
+}
+
+func (client *AWSClient) kafkaconn() *kafka.Kafka {
+	return client.serviceConn("kafkaconn", "kafka", func(sess *session.Session) interface{} {
+		return kafka.New(sess)
+	}).(*kafka.Kafka)
+}
+
+func (client *AWSClient) kinesisanalyticsconn() *kinesisanalytics.KinesisAnalytics {
+	return client.serviceConn("kinesisanalyticsconn", "kinesisanalytics", func(sess *session.Session) interface{} {
+		return kinesisanalytics.New(sess)
+	}).(*kinesisanalytics.KinesisAnalytics)
+}
+
+func (client *AWSClient) kinesisanalyticsv2conn() *kinesisanalyticsv2.KinesisAnalyticsV2 {
+	return client.serviceConn("kinesisanalyticsv2conn", "kinesisanalyticsv2", func(sess *session.Session) interface{} {
+		return kinesisanalyticsv2.New(sess)
+	}).(*kinesisanalyticsv2.KinesisAnalyticsV2)
+}
+
+func (client *AWSClient) kinesisconn() *kinesis.Kinesis {
+	return client.serviceConn("kinesisconn", "kinesis", func(sess *session.Session) interface{} {
+		return kinesis.New(sess)
+	}).(*kinesis.Kinesis)
+}
+
+func (client *AWSClient) kinesisvideoconn() *kinesisvideo.KinesisVideo {
+	return client.serviceConn("kinesisvideoconn", "kinesisvideo", func(sess *session.Session) interface{} {
+		return kinesisvideo.New(sess)
+	}).(*kinesisvideo.KinesisVideo)
+}
+
+func (client *AWSClient) kmsconn() *kms.KMS {
+	return client.serviceConn("kmsconn", "kms", func(sess *session.Session) interface{} {
+		return kms.New(sess)
+	}).(*kms.KMS)
+}
+
+func (client *AWSClient) lakeformationconn() *lakeformation.LakeFormation {
+	return client.serviceConn("lakeformationconn", "lakeformation", func(sess *session.Session) interface{} {
+		return lakeformation.New(sess)
+	}).(*lakeformation.LakeFormation)
+}
+
+func (client *AWSClient) lambdaconn() *lambda.Lambda {
+	return client.serviceConn("lambdaconn", "lambda", func(sess *session.Session) interface{} {
+		return lambda.New(sess)
+	}).(*lambda.Lambda)
+}
+
+func (client *AWSClient) lexmodelconn() *lexmodelbuildingservice.LexModelBuildingService {
+	return client.serviceConn("lexmodelconn", "lexmodels", func(sess *session.Session) interface{} {
+		return lexmodelbuildingservice.New(sess)
+	}).(*lexmodelbuildingservice.LexModelBuildingService)
+}
+
+func (client *AWSClient) licensemanagerconn() *licensemanager.LicenseManager {
+	return client.serviceConn("licensemanagerconn", "licensemanager", func(sess *session.Session) interface{} {
+		return licensemanager.New(sess)
+	}).(*licensemanager.LicenseManager)
+}
+
+func (client *AWSClient) lightsailconn() *lightsail.Lightsail {
+	return client.serviceConn("lightsailconn", "lightsail", func(sess *session.Session) interface{} {
+		return lightsail.New(sess)
+	}).(*lightsail.Lightsail)
+}
+
+func (client *AWSClient) locationconn() *locationservice.LocationService {
+	return client.serviceConn("locationconn", "location", func(sess *session.Session) interface{} {
+		return locationservice.New(sess)
+	}).(*locationservice.LocationService)
+}
+
+func (client *AWSClient) macie2conn() *macie2.Macie2 {
+	return client.serviceConn("macie2conn", "macie2", func(sess *session.Session) interface{} {
+		return macie2.New(sess)
+	}).(*macie2.Macie2)
+}
+
+func (client *AWSClient) macieconn() *macie.Macie {
+	return client.serviceConn("macieconn", "macie", func(sess *session.Session) interface{} {
+		return macie.New(sess)
+	}).(*macie.Macie)
+}
+
+func (client *AWSClient) managedblockchainconn() *managedblockchain.ManagedBlockchain {
+	return client.serviceConn("managedblockchainconn", "managedblockchain", func(sess *session.Session) interface{} {
+		return managedblockchain.New(sess)
+	}).(*managedblockchain.ManagedBlockchain)
+}
+
+func (client *AWSClient) marketplacecatalogconn() *marketplacecatalog.MarketplaceCatalog {
+	return client.serviceConn("marketplacecatalogconn", "marketplacecatalog", func(sess *session.Session) interface{} {
+		return marketplacecatalog.New(sess)
+	}).(*marketplacecatalog.MarketplaceCatalog)
+}
+
+func (client *AWSClient) mediaconnectconn() *mediaconnect.MediaConnect {
+	return client.serviceConn("mediaconnectconn", "mediaconnect", func(sess *session.Session) interface{} {
+		return mediaconnect.New(sess)
+	}).(*mediaconnect.MediaConnect)
+}
+
+func (client *AWSClient) mediaconvertconn() *mediaconvert.MediaConvert {
+	return client.serviceConn("mediaconvertconn", "mediaconvert", func(sess *session.Session) interface{} {
+		return mediaconvert.New(sess)
+	}).(*mediaconvert.MediaConvert)
+}
+
+func (client *AWSClient) medialiveconn() *medialive.MediaLive {
+	return client.serviceConn("medialiveconn", "medialive", func(sess *session.Session) interface{} {
+		return medialive.New(sess)
+	}).(*medialive.MediaLive)
+}
+
+func (client *AWSClient) mediapackageconn() *mediapackage.MediaPackage {
+	return client.serviceConn("mediapackageconn", "mediapackage", func(sess *session.Session) interface{} {
+		return mediapackage.New(sess)
+	}).(*mediapackage.MediaPackage)
+}
+
+func (client *AWSClient) mediastoreconn() *mediastore.MediaStore {
+	return client.serviceConn("mediastoreconn", "mediastore", func(sess *session.Session) interface{} {
+		return mediastore.New(sess)
+	}).(*mediastore.MediaStore)
+}
+
+func (client *AWSClient) mediastoredataconn() *mediastoredata.MediaStoreData {
+	return client.serviceConn("mediastoredataconn", "mediastoredata", func(sess *session.Session) interface{} {
+		return mediastoredata.New(sess)
+	}).(*mediastoredata.MediaStoreData)
+}
+
+func (client *AWSClient) memorydbconn() *memorydb.MemoryDB {
+	return client.serviceConn("memorydbconn", "memorydb", func(sess *session.Session) interface{} {
+		return memorydb.New(sess)
+	}).(*memorydb.MemoryDB)
+}
+
+func (client *AWSClient) mqconn() *mq.MQ {
+	return client.serviceConn("mqconn", "mq", func(sess *session.Session) interface{} {
+		return mq.New(sess)
+	}).(*mq.MQ)
+}
+
+func (client *AWSClient) mwaaconn() *mwaa.MWAA {
+	return client.serviceConn("mwaaconn", "mwaa", func(sess *session.Session) interface{} {
+		return mwaa.New(sess)
+	}).(*mwaa.MWAA)
+}
+
+func (client *AWSClient) neptuneconn() *neptune.Neptune {
+	return client.serviceConn("neptuneconn", "neptune", func(sess *session.Session) interface{} {
+		return neptune.New(sess)
+	}).(*neptune.Neptune)
+}
+
+func (client *AWSClient) networkfirewallconn() *networkfirewall.NetworkFirewall {
+	return client.serviceConn("networkfirewallconn", "networkfirewall", func(sess *session.Session) interface{} {
+		return networkfirewall.New(sess)
+	}).(*networkfirewall.NetworkFirewall)
+}
+
+func (client *AWSClient) networkmanagerconn() *networkmanager.NetworkManager {
+	return client.serviceConn("networkmanagerconn", "networkmanager", func(sess *session.Session) interface{} {
+		return networkmanager.New(sess)
+	}).(*networkmanager.NetworkManager)
+}
+
+func (client *AWSClient) opsworksconn() *opsworks.OpsWorks {
+	return client.serviceConn("opsworksconn", "opsworks", func(sess *session.Session) interface{} {
+		return opsworks.New(sess)
+	}).(*opsworks.OpsWorks)
+}
+
+func (client *AWSClient) organizationsconn() *organizations.Organizations {
+	return client.serviceConn("organizationsconn", "organizations", func(sess *session.Session) interface{} {
+		return organizations.New(sess)
+	}).(*organizations.Organizations)
+}
+
+func (client *AWSClient) outpostsconn() *outposts.Outposts {
+	return client.serviceConn("outpostsconn", "outposts", func(sess *session.Session) interface{} {
+		return outposts.New(sess)
+	}).(*outposts.Outposts)
+}
+
+func (client *AWSClient) personalizeconn() *personalize.Personalize {
+	return client.serviceConn("personalizeconn", "personalize", func(sess *session.Session) interface{} {
+		return personalize.New(sess)
+	}).(*personalize.Personalize)
+}
+
+func (client *AWSClient) pinpointconn() *pinpoint.Pinpoint {
+	return client.serviceConn("pinpointconn", "pinpoint", func(sess *session.Session) interface{} {
+		return pinpoint.New(sess)
+	}).(*pinpoint.Pinpoint)
+}
+
+func (client *AWSClient) pricingconn() *pricing.Pricing {
+	return client.serviceConn("pricingconn", "pricing", func(sess *session.Session) interface{} {
+		return pricing.New(sess)
+	}).(*pricing.Pricing)
+}
+
+func (client *AWSClient) prometheusserviceconn() *prometheusservice.PrometheusService {
+	return client.serviceConn("prometheusserviceconn", "prometheusservice", func(sess *session.Session) interface{} {
+		return prometheusservice.New(sess)
+	}).(*prometheusservice.PrometheusService)
+}
+
+func (client *AWSClient) qldbconn() *qldb.QLDB {
+	return client.serviceConn("qldbconn", "qldb", func(sess *session.Session) interface{} {
+		return qldb.New(sess)
+	}).(*qldb.QLDB)
+}
+
+func (client *AWSClient) quicksightconn() *quicksight.QuickSight {
+	return client.serviceConn("quicksightconn", "quicksight", func(sess *session.Session) interface{} {
+		return quicksight.New(sess)
+	}).(*quicksight.QuickSight)
+}
+
+func (client *AWSClient) r53conn() *route53.Route53 {
+	return client.serviceConn("r53conn", "route53", func(sess *session.Session) interface{} {
+		return route53.New(sess)
+	}).(*route53.Route53)
+}
+
+func (client *AWSClient) ramconn() *ram.RAM {
+	return client.serviceConn("ramconn", "ram", func(sess *session.Session) interface{} {
+		return ram.New(sess)
+	}).(*ram.RAM)
+}
+
+func (client *AWSClient) rdsconn() *rds.RDS {
+	return client.serviceConn("rdsconn", "rds", func(sess *session.Session) interface{} {
+		return rds.New(sess)
+	}).(*rds.RDS)
+}
+
+func (client *AWSClient) redshiftconn() *redshift.Redshift {
+	return client.serviceConn("redshiftconn", "redshift", func(sess *session.Session) interface{} {
+		return redshift.New(sess)
+	}).(*redshift.Redshift)
+}
+
+func (client *AWSClient) resourcegroupsconn() *resourcegroups.ResourceGroups {
+	return client.serviceConn("resourcegroupsconn", "resourcegroups", func(sess *session.Session) interface{} {
+		return resourcegroups.New(sess)
+	}).(*resourcegroups.ResourceGroups)
+}
+
+func (client *AWSClient) resourcegroupstaggingapiconn() *resourcegroupstaggingapi.ResourceGroupsTaggingAPI {
+	return client.serviceConn("resourcegroupstaggingapiconn", "resourcegroupstaggingapi", func(sess *session.Session) interface{} {
+		return resourcegroupstaggingapi.New(sess)
+	}).(*resourcegroupstaggingapi.ResourceGroupsTaggingAPI)
+}
+
+func (client *AWSClient) route53domainsconn() *route53domains.Route53Domains {
+	return client.serviceConn("route53domainsconn", "route53domains", func(sess *session.Session) interface{} {
+		return route53domains.New(sess)
+	}).(*route53domains.Route53Domains)
+}
+
+func (client *AWSClient) route53recoverycontrolconfigconn() *route53recoverycontrolconfig.Route53RecoveryControlConfig {
+	return client.serviceConn("route53recoverycontrolconfigconn", "route53recoverycontrolconfig", func(sess *session.Session) interface{} {
+		return route53recoverycontrolconfig.New(sess)
+	}).(*route53recoverycontrolconfig.Route53RecoveryControlConfig)
+}
+
+func (client *AWSClient) route53recoveryreadinessconn() *route53recoveryreadiness.Route53RecoveryReadiness {
+	return client.serviceConn("route53recoveryreadinessconn", "route53recoveryreadiness", func(sess *session.Session) interface{} {
+		return route53recoveryreadiness.New(sess)
+	}).(*route53recoveryreadiness.Route53RecoveryReadiness)
+}
+
+func (client *AWSClient) route53resolverconn() *route53resolver.Route53Resolver {
+	return client.serviceConn("route53resolverconn", "route53resolver", func(sess *session.Session) interface{} {
+		return route53resolver.New(sess)
+	}).(*route53resolver.Route53Resolver)
+}
+
+func (client *AWSClient) s3conn() *s3.S3 {
+	return client.serviceConn("s3conn", "s3", func(sess *session.Session) interface{} {
+		return s3.New(sess)
+	}).(*s3.S3)
+}
+
+func (client *AWSClient) s3connUriCleaningDisabled() *s3.S3 {
+	return client.serviceConn("s3connUriCleaningDisabled", "s3", func(sess *session.Session) interface{} {
+		return s3.New(sess, &aws.Config{DisableRestProtocolURICleaning: aws.Bool(true)})
+	}).(*s3.S3)
+}
+
+func (client *AWSClient) s3controlconn() *s3control.S3Control {
+	return client.serviceConn("s3controlconn", "s3control", func(sess *session.Session) interface{} {
+		return s3control.New(sess)
+	}).(*s3control.S3Control)
+}
+
+func (client *AWSClient) s3outpostsconn() *s3outposts.S3Outposts {
+	return client.serviceConn("s3outpostsconn", "s3outposts", func(sess *session.Session) interface{} {
+		return s3outposts.New(sess)
+	}).(*s3outposts.S3Outposts)
+}
+
+func (client *AWSClient) sagemakerconn() *sagemaker.SageMaker {
+	return client.serviceConn("sagemakerconn", "sagemaker", func(sess *session.Session) interface{} {
+		return sagemaker.New(sess)
+	}).(*sagemaker.SageMaker)
+}
+
+func (client *AWSClient) scconn() *servicecatalog.ServiceCatalog {
+	return client.serviceConn("scconn", "servicecatalog", func(sess *session.Session) interface{} {
+		return servicecatalog.New(sess)
+	}).(*servicecatalog.ServiceCatalog)
+}
+
+func (client *AWSClient) schemasconn() *schemas.Schemas {
+	return client.serviceConn("schemasconn", "schemas", func(sess *session.Session) interface{} {
+		return schemas.New(sess)
+	}).(*schemas.Schemas)
+}
+
+func (client *AWSClient) sdconn() *servicediscovery.ServiceDiscovery {
+	return client.serviceConn("sdconn", "servicediscovery", func(sess *session.Session) interface{} {
+		return servicediscovery.New(sess)
+	}).(*servicediscovery.ServiceDiscovery)
+}
+
+func (client *AWSClient) secretsmanagerconn() *secretsmanager.SecretsManager {
+	return client.serviceConn("secretsmanagerconn", "secretsmanager", func(sess *session.Session) interface{} {
+		return secretsmanager.New(sess)
+	}).(*secretsmanager.SecretsManager)
+}
+
+func (client *AWSClient) securityhubconn() *securityhub.SecurityHub {
+	return client.serviceConn("securityhubconn", "securityhub", func(sess *session.Session) interface{} {
+		return securityhub.New(sess)
+	}).(*securityhub.SecurityHub)
+}
+
+func (client *AWSClient) serverlessapplicationrepositoryconn() *serverlessapplicationrepository.ServerlessApplicationRepository {
+	return client.serviceConn("serverlessapplicationrepositoryconn", "serverlessrepo", func(sess *session.Session) interface{} {
+		return serverlessapplicationrepository.New(sess)
+	}).(*serverlessapplicationrepository.ServerlessApplicationRepository)
+}
+
+func (client *AWSClient) servicequotasconn() *servicequotas.ServiceQuotas {
+	return client.serviceConn("servicequotasconn", "servicequotas", func(sess *session.Session) interface{} {
+		return servicequotas.New(sess)
+	}).(*servicequotas.ServiceQuotas)
+}
+
+func (client *AWSClient) sesconn() *ses.SES {
+	return client.serviceConn("sesconn", "ses", func(sess *session.Session) interface{} {
+		return ses.New(sess)
+	}).(*ses.SES)
+}
+
+func (client *AWSClient) sfnconn() *sfn.SFN {
+	return client.serviceConn("sfnconn", "stepfunctions", func(sess *session.Session) interface{} {
+		return sfn.New(sess)
+	}).(*sfn.SFN)
+}
+
+func (client *AWSClient) shieldconn() *shield.Shield {
+	return client.serviceConn("shieldconn", "shield", func(sess *session.Session) interface{} {
+		return shield.New(sess)
+	}).(*shield.Shield)
+}
+
+func (client *AWSClient) signerconn() *signer.Signer {
+	return client.serviceConn("signerconn", "signer", func(sess *session.Session) interface{} {
+		return signer.New(sess)
+	}).(*signer.Signer)
+}
+
+func (client *AWSClient) simpledbconn() *simpledb.SimpleDB {
+	return client.serviceConn("simpledbconn", "sdb", func(sess *session.Session) interface{} {
+		return simpledb.New(sess)
+	}).(*simpledb.SimpleDB)
+}
+
+func (client *AWSClient) snsconn() *sns.SNS {
+	return client.serviceConn("snsconn", "sns", func(sess *session.Session) interface{} {
+		return sns.New(sess)
+	}).(*sns.SNS)
+}
+
+func (client *AWSClient) sqsconn() *sqs.SQS {
+	return client.serviceConn("sqsconn", "sqs", func(sess *session.Session) interface{} {
+		return sqs.New(sess)
+	}).(*sqs.SQS)
+}
+
+func (client *AWSClient) ssmconn() *ssm.SSM {
+	return client.serviceConn("ssmconn", "ssm", func(sess *session.Session) interface{} {
+		return ssm.New(sess)
+	}).(*ssm.SSM)
+}
+
+func (client *AWSClient) ssoadminconn() *ssoadmin.SSOAdmin {
+	return client.serviceConn("ssoadminconn", "ssoadmin", func(sess *session.Session) interface{} {
+		return ssoadmin.New(sess)
+	}).(*ssoadmin.SSOAdmin)
+}
+
+func (client *AWSClient) storagegatewayconn() *storagegateway.StorageGateway {
+	return client.serviceConn("storagegatewayconn", "storagegateway", func(sess *session.Session) interface{} {
+		return storagegateway.New(sess)
+	}).(*storagegateway.StorageGateway)
+}
+
+func (client *AWSClient) stsconn() *sts.STS {
+	return client.serviceConn("stsconn", "sts", func(sess *session.Session) interface{} {
+		return sts.New(sess)
+	}).(*sts.STS)
+}
+
+func (client *AWSClient) swfconn() *swf.SWF {
+	return client.serviceConn("swfconn", "swf", func(sess *session.Session) interface{} {
+		return swf.New(sess)
+	}).(*swf.SWF)
+}
+
+func (client *AWSClient) syntheticsconn() *synthetics.Synthetics {
+	return client.serviceConn("syntheticsconn", "synthetics", func(sess *session.Session) interface{} {
+		return synthetics.New(sess)
+	}).(*synthetics.Synthetics)
+}
+
+func (client *AWSClient) timestreamwriteconn() *timestreamwrite.TimestreamWrite {
+	return client.serviceConn("timestreamwriteconn", "timestreamwrite", func(sess *session.Session) interface{} {
+		return timestreamwrite.New(sess)
+	}).(*timestreamwrite.TimestreamWrite)
+}
+
+func (client *AWSClient) transferconn() *transfer.Transfer {
+	return client.serviceConn("transferconn", "transfer", func(sess *session.Session) interface{} {
+		return transfer.New(sess)
+	}).(*transfer.Transfer)
+}
+
+func (client *AWSClient) wafconn() *waf.WAF {
+	return client.serviceConn("wafconn", "waf", func(sess *session.Session) interface{} {
+		return waf.New(sess)
+	}).(*waf.WAF)
+}
+
+func (client *AWSClient) wafregionalconn() *wafregional.WAFRegional {
+	return client.serviceConn("wafregionalconn", "wafregional", func(sess *session.Session) interface{} {
+		return wafregional.New(sess)
+	}).(*wafregional.WAFRegional)
+}
+
+func (client *AWSClient) wafv2conn() *wafv2.WAFV2 {
+	return client.serviceConn("wafv2conn", "wafv2", func(sess *session.Session) interface{} {
+		return wafv2.New(sess)
+	}).(*wafv2.WAFV2)
+}
+
+func (client *AWSClient) worklinkconn() *worklink.WorkLink {
+	return client.serviceConn("worklinkconn", "worklink", func(sess *session.Session) interface{} {
+		return worklink.New(sess)
+	}).(*worklink.WorkLink)
+}
+
+func (client *AWSClient) workmailconn() *workmail.WorkMail {
+	return client.serviceConn("workmailconn", "workmail", func(sess *session.Session) interface{} {
+		return workmail.New(sess)
+	}).(*workmail.WorkMail)
+}
+
+func (client *AWSClient) workspacesconn() *workspaces.WorkSpaces {
+	return client.serviceConn("workspacesconn", "workspaces", func(sess *session.Session) interface{} {
+		return workspaces.New(sess)
+	}).(*workspaces.WorkSpaces)
+}
+
+func (client *AWSClient) xrayconn() *xray.XRay {
+	return client.serviceConn("xrayconn", "xray", func(sess *session.Session) interface{} {
+		return xray.New(sess)
+	}).(*xray.XRay)
+}
@@ -0,0 +1,214 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/ec2rolecreds"
+	"github.com/aws/aws-sdk-go/aws/credentials/endpointcreds"
+	"github.com/aws/aws-sdk-go/aws/credentials/processcreds"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/defaults"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+// credentialsSources describes the credential source of each AWS Go SDK credentials provider.
+var credentialsSources = map[string]string{
+	credentials.EnvProviderName:         "environment variables",
+	credentials.SharedCredsProviderName: "shared credentials file",
+	credentials.StaticProviderName:      "provider configuration",
+	processcreds.ProviderName:           "credential_process",
+	ssoProviderName:                     "AWS SSO",
+	ec2rolecreds.ProviderName:           "EC2 Instance Metadata Service",
+	endpointcreds.ProviderName:          "container credentials endpoint",
+	stscreds.ProviderName:               "assumed IAM Role",
+	stscreds.WebIdentityProviderName:    "web identity",
+}
+
+// getProcessCredentials returns validated credentials obtained by running the configured credential_process command.
+func (c *Config) getProcessCredentials() (*credentials.Credentials, error) {
+	log.Printf("[INFO] Attempting to use credential_process credentials")
+
+	creds := processcreds.NewCredentials(c.CredentialProcess)
+
+	if _, err := creds.Get(); err != nil {
+		return nil, fmt.Errorf("error getting credentials from credential_process: %w", err)
+	}
+
+	return creds, nil
+}
+
+// getChainCredentials returns validated credentials from the first source of the standard
+// credential chain that supplies them: static credentials, environment variables, the shared
+// credentials files, and then the sources supported by AWS Go SDK sessions, including the
+// shared config files and the EC2 Instance Metadata Service.
+func (c *Config) getChainCredentials(awsbaseConfig *awsbase.Config) (*credentials.Credentials, error) {
+	credsFilenames, err := c.sharedCredentialsFilenames()
+
+	if err != nil {
+		return nil, err
+	}
+
+	providers := []credentials.Provider{
+		&credentials.StaticProvider{Value: credentials.Value{
+			AccessKeyID:     c.AccessKey,
+			SecretAccessKey: c.SecretKey,
+			SessionToken:    c.Token,
+		}},
+		&credentials.EnvProvider{},
+	}
+
+	// Later shared credentials files take precedence, so are tried first.
+	for i := len(credsFilenames) - 1; i >= 0; i-- {
+		providers = append(providers, &credentials.SharedCredentialsProvider{
+			Filename: credsFilenames[i],
+			Profile:  c.Profile,
+		})
+	}
+
+	creds := credentials.NewChainCredentials(providers)
+
+	_, err = creds.Get()
+
+	if tfawserr.ErrCodeEquals(err, "NoCredentialProviders") {
+		return c.getSessionCredentials(awsbaseConfig)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("Error loading credentials for AWS Provider: %w", err)
+	}
+
+	return creds, nil
+}
+
+// getSessionCredentials returns validated credentials derived by an AWS Go SDK session
+// from the shared config and credentials files, container credentials or the EC2 Instance Metadata Service.
+func (c *Config) getSessionCredentials(awsbaseConfig *awsbase.Config) (*credentials.Credentials, error) {
+	log.Printf("[INFO] Attempting to use session-derived credentials")
+
+	sharedConfigFiles, err := c.sharedConfigFiles()
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Avoid setting HTTPClient here as it will prevent the ec2metadata
+	// client from automatically lowering the timeout to 1 second.
+	sess, err := session.NewSessionWithOptions(session.Options{
+		Config: aws.Config{
+			CredentialsChainVerboseErrors: aws.Bool(true),
+			EndpointResolver:              c.endpointResolver(awsbaseConfig),
+			MaxRetries:                    aws.Int(0),
+			Region:                        aws.String(c.Region),
+		},
+		Profile:           c.Profile,
+		SharedConfigFiles: sharedConfigFiles,
+		SharedConfigState: session.SharedConfigEnable,
+	})
+
+	if tfawserr.ErrCodeEquals(err, "NoCredentialProviders") {
+		return nil, awsbaseConfig.NewNoValidCredentialSourcesError(err)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("Error creating AWS session: %w", err)
+	}
+
+	if _, err := sess.Config.Credentials.Get(); err != nil {
+		return nil, awsbaseConfig.NewNoValidCredentialSourcesError(err)
+	}
+
+	return sess.Config.Credentials, nil
+}
+
+// logCredentialsSource logs the source that supplied the credentials.
+func logCredentialsSource(creds *credentials.Credentials) {
+	v, err := creds.Get()
+
+	if err != nil {
+		return
+	}
+
+	source, ok := credentialsSources[v.ProviderName]
+
+	if !ok {
+		source = "unknown source"
+	}
+
+	log.Printf("[DEBUG] Using AWS credentials from %s (%s)", source, v.ProviderName)
+}
+
+// sharedConfigFiles returns the shared config and credentials files to be loaded by AWS Go SDK
+// sessions, in increasing order of precedence.
+func (c *Config) sharedConfigFiles() ([]string, error) {
+	configFilenames, err := c.sharedConfigFilenames()
+
+	if err != nil {
+		return nil, err
+	}
+
+	credsFilenames, err := c.sharedCredentialsFilenames()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return append(configFilenames, credsFilenames...), nil
+}
+
+// sharedConfigFilenames returns the expanded names of the AWS shared config files, in increasing order of precedence.
+func (c *Config) sharedConfigFilenames() ([]string, error) {
+	filenames := c.SharedConfigFiles
+
+	if len(filenames) == 0 {
+		if v := os.Getenv("AWS_CONFIG_FILE"); v != "" {
+			filenames = []string{v}
+		} else {
+			filenames = []string{defaults.SharedConfigFilename()}
+		}
+	}
+
+	return expandFilenames(filenames, "shared config")
+}
+
+// sharedCredentialsFilenames returns the expanded names of the AWS shared credentials files, in increasing order of precedence.
+func (c *Config) sharedCredentialsFilenames() ([]string, error) {
+	filenames := c.SharedCredentialsFiles
+
+	if len(filenames) == 0 && c.CredsFilename != "" {
+		filenames = []string{c.CredsFilename}
+	}
+
+	if len(filenames) == 0 {
+		if v := os.Getenv("AWS_SHARED_CREDENTIALS_FILE"); v != "" {
+			filenames = []string{v}
+		} else {
+			filenames = []string{defaults.SharedCredentialsFilename()}
+		}
+	}
+
+	return expandFilenames(filenames, "shared credentials")
+}
+
+// expandFilenames expands a leading ~ in each filename to the user's home directory.
+func expandFilenames(filenames []string, kind string) ([]string, error) {
+	expanded := make([]string, 0, len(filenames))
+
+	for _, filename := range filenames {
+		v, err := homedir.Expand(filename)
+
+		if err != nil {
+			return nil, fmt.Errorf("error expanding %s filename: %w", kind, err)
+		}
+
+		expanded = append(expanded, v)
+	}
+
+	return expanded, nil
+}
@@ -0,0 +1,193 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/processcreds"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+)
+
+// testCredentialProcess returns a credential_process command that outputs the specified credentials.
+func testCredentialProcess(accessKeyID, secretAccessKey string) string {
+	return fmt.Sprintf(`echo '{"Version": 1, "AccessKeyId": %q, "SecretAccessKey": %q}'`, accessKeyID, secretAccessKey)
+}
+
+// testWriteFile writes a file with the specified contents to a temporary directory and returns its path.
+func testWriteFile(t *testing.T, name, contents string) string {
+	filename := filepath.Join(t.TempDir(), name)
+
+	if err := os.WriteFile(filename, []byte(contents), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	return filename
+}
+
+func TestConfigSharedCredentialsFilenames(t *testing.T) {
+	testCases := []struct {
+		Name              string
+		Config            *Config
+		EnvVar            string
+		ExpectedFilenames []string
+	}{
+		{
+			Name:              "shared_credentials_files",
+			Config:            &Config{SharedCredentialsFiles: []string{"/first", "/second"}},
+			EnvVar:            "/env",
+			ExpectedFilenames: []string{"/first", "/second"},
+		},
+		{
+			Name:              "shared_credentials_file",
+			Config:            &Config{CredsFilename: "/single"},
+			EnvVar:            "/env",
+			ExpectedFilenames: []string{"/single"},
+		},
+		{
+			Name:              "environment variable",
+			Config:            &Config{},
+			EnvVar:            "/env",
+			ExpectedFilenames: []string{"/env"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Setenv("AWS_SHARED_CREDENTIALS_FILE", testCase.EnvVar)
+
+			got, err := testCase.Config.sharedCredentialsFilenames()
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.ExpectedFilenames) {
+				t.Errorf("got filenames %v, expected %v", got, testCase.ExpectedFilenames)
+			}
+		})
+	}
+}
+
+func TestConfigGetCredentials_SharedFiles(t *testing.T) {
+	firstCredsFile := testWriteFile(t, "credentials", `
+[default]
+aws_access_key_id = FirstDefaultAccessKey
+aws_secret_access_key = FirstDefaultSecretKey
+
+[first-only]
+aws_access_key_id = FirstOnlyAccessKey
+aws_secret_access_key = FirstOnlySecretKey
+`)
+
+	secondCredsFile := testWriteFile(t, "credentials", `
+[default]
+aws_access_key_id = SecondDefaultAccessKey
+aws_secret_access_key = SecondDefaultSecretKey
+`)
+
+	configFile := testWriteFile(t, "config", fmt.Sprintf(`
+[profile process]
+credential_process = %s
+`, testCredentialProcess("ProfileProcessAccessKey", "ProfileProcessSecretKey")))
+
+	testCases := []struct {
+		Name                string
+		Config              *Config
+		ExpectedCredentials credentials.Value
+	}{
+		{
+			Name: "later shared credentials file takes precedence",
+			Config: &Config{
+				Profile:                "default",
+				SharedCredentialsFiles: []string{firstCredsFile, secondCredsFile},
+			},
+			ExpectedCredentials: credentials.Value{
+				AccessKeyID:     "SecondDefaultAccessKey",
+				SecretAccessKey: "SecondDefaultSecretKey",
+				ProviderName:    credentials.SharedCredsProviderName,
+			},
+		},
+		{
+			Name: "profile only in earlier shared credentials file",
+			Config: &Config{
+				Profile:                "first-only",
+				SharedCredentialsFiles: []string{firstCredsFile, secondCredsFile},
+			},
+			ExpectedCredentials: credentials.Value{
+				AccessKeyID:     "FirstOnlyAccessKey",
+				SecretAccessKey: "FirstOnlySecretKey",
+				ProviderName:    credentials.SharedCredsProviderName,
+			},
+		},
+		{
+			Name: "shared config file credential_process",
+			Config: &Config{
+				Profile:                "process",
+				SharedConfigFiles:      []string{configFile},
+				SharedCredentialsFiles: []string{firstCredsFile},
+			},
+			ExpectedCredentials: credentials.Value{
+				AccessKeyID:     "ProfileProcessAccessKey",
+				SecretAccessKey: "ProfileProcessSecretKey",
+				ProviderName:    processcreds.ProviderName,
+			},
+		},
+		{
+			Name: "credential_process",
+			Config: &Config{
+				CredentialProcess:      testCredentialProcess("ProcessAccessKey", "ProcessSecretKey"),
+				Profile:                "default",
+				SharedCredentialsFiles: []string{firstCredsFile},
+			},
+			ExpectedCredentials: credentials.Value{
+				AccessKeyID:     "ProcessAccessKey",
+				SecretAccessKey: "ProcessSecretKey",
+				ProviderName:    processcreds.ProviderName,
+			},
+		},
+		{
+			Name: "static credentials take precedence over shared credentials files",
+			Config: &Config{
+				AccessKey:              awsbase.MockStaticAccessKey,
+				Profile:                "default",
+				SecretKey:              awsbase.MockStaticSecretKey,
+				SharedCredentialsFiles: []string{firstCredsFile},
+			},
+			ExpectedCredentials: credentials.Value{
+				AccessKeyID:     awsbase.MockStaticAccessKey,
+				SecretAccessKey: awsbase.MockStaticSecretKey,
+				ProviderName:    credentials.StaticProviderName,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			testUnsetCredentialsEnv(t)
+
+			testCase.Config.Region = endpoints.UsEast1RegionID
+			testCase.Config.SkipMetadataApiCheck = true
+
+			creds, err := testCase.Config.getCredentials(&awsbase.Config{Region: testCase.Config.Region})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got, err := creds.Get()
+
+			if err != nil {
+				t.Fatalf("unexpected error getting credentials: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.ExpectedCredentials) {
+				t.Errorf("got credentials %#v, expected %#v", got, testCase.ExpectedCredentials)
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/ec2rolecreds"
+	"github.com/aws/aws-sdk-go/aws/ec2metadata"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+)
+
+const (
+	// Environment variables read by the AWS Go SDK when configuring the EC2 Instance Metadata Service client.
+	ec2MetadataDisabledEnvVar            = "AWS_EC2_METADATA_DISABLED"
+	ec2MetadataServiceEndpointEnvVar     = "AWS_EC2_METADATA_SERVICE_ENDPOINT"
+	ec2MetadataServiceEndpointModeEnvVar = "AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE"
+
+	// HTTP header carrying the IMDSv2 session token.
+	ec2MetadataTokenHeader = "X-Aws-Ec2-Metadata-Token"
+
+	errCodeEC2MetadataTokenRequired = "EC2MetadataTokenRequired"
+)
+
+// configureEC2MetadataEnvironment propagates the provider's EC2 Instance Metadata Service
+// settings to the environment, where they are read by every EC2 Instance Metadata Service
+// client the AWS Go SDK creates, including those used by the awsbase credential chain.
+func (c *Config) configureEC2MetadataEnvironment() {
+	if c.SkipMetadataApiCheck {
+		os.Setenv(ec2MetadataDisabledEnvVar, "true")
+	}
+
+	if c.EC2MetadataServiceEndpoint != "" {
+		os.Setenv(ec2MetadataServiceEndpointEnvVar, c.EC2MetadataServiceEndpoint)
+	}
+
+	if c.EC2MetadataServiceEndpointMode != "" {
+		os.Setenv(ec2MetadataServiceEndpointModeEnvVar, c.EC2MetadataServiceEndpointMode)
+	}
+}
+
+// getBaseCredentials returns validated credentials from the standard credential chain.
+// When IMDSv2 session tokens are required, the AWS Go SDK's EC2 Role provider is excluded
+// from that chain as it silently falls back to IMDSv1, and a token-enforcing EC2 Role provider
+// is tried once all other credential sources have been exhausted.
+func (c *Config) getBaseCredentials(awsbaseConfig *awsbase.Config) (*credentials.Credentials, error) {
+	if !c.EC2MetadataServiceRequireToken || c.SkipMetadataApiCheck {
+		return c.getChainCredentials(awsbaseConfig)
+	}
+
+	creds, err := c.getChainCredentialsWithEC2MetadataDisabled(awsbaseConfig)
+
+	if err == nil || !awsbase.IsNoValidCredentialSourcesError(err) {
+		return creds, err
+	}
+
+	log.Printf("[INFO] Attempting to use EC2 Role credentials with IMDSv2")
+
+	client, err := c.getEC2MetadataClient()
+
+	if err != nil {
+		return nil, err
+	}
+
+	creds = ec2rolecreds.NewCredentialsWithClient(client)
+
+	if _, err := creds.Get(); err != nil {
+		return nil, awsbaseConfig.NewNoValidCredentialSourcesError(err)
+	}
+
+	return creds, nil
+}
+
+// getChainCredentialsWithEC2MetadataDisabled returns credentials from the standard
+// credential chain without consulting the EC2 Instance Metadata Service.
+func (c *Config) getChainCredentialsWithEC2MetadataDisabled(awsbaseConfig *awsbase.Config) (*credentials.Credentials, error) {
+	if v, ok := os.LookupEnv(ec2MetadataDisabledEnvVar); ok {
+		defer os.Setenv(ec2MetadataDisabledEnvVar, v)
+	} else {
+		defer os.Unsetenv(ec2MetadataDisabledEnvVar)
+	}
+
+	os.Setenv(ec2MetadataDisabledEnvVar, "true")
+
+	return c.getChainCredentials(awsbaseConfig)
+}
+
+// getEC2MetadataClient returns an EC2 Instance Metadata Service client for the configured
+// endpoint. If IMDSv2 session tokens are required, requests made without one fail.
+func (c *Config) getEC2MetadataClient() (*ec2metadata.EC2Metadata, error) {
+	var endpointMode endpoints.EC2IMDSEndpointModeState
+
+	if err := endpointMode.SetFromString(c.EC2MetadataServiceEndpointMode); err != nil {
+		return nil, err
+	}
+
+	sess, err := session.NewSessionWithOptions(session.Options{
+		Config: aws.Config{
+			// The EC2 Instance Metadata Service does not require signed requests.
+			Credentials: credentials.AnonymousCredentials,
+		},
+		EC2IMDSEndpoint:     c.EC2MetadataServiceEndpoint,
+		EC2IMDSEndpointMode: endpointMode,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("error creating EC2 Instance Metadata Service session: %w", err)
+	}
+
+	client := ec2metadata.New(sess)
+
+	if c.EC2MetadataServiceRequireToken {
+		client.Handlers.Sign.PushBack(requireEC2MetadataTokenHandler)
+	}
+
+	return client, nil
+}
+
+// requireEC2MetadataTokenHandler fails EC2 Instance Metadata Service requests that would otherwise
+// be sent without an IMDSv2 session token.
+func requireEC2MetadataTokenHandler(r *request.Request) {
+	if r.Operation.Name == "GetToken" || r.Error != nil {
+		return
+	}
+
+	if r.HTTPRequest.Header.Get(ec2MetadataTokenHeader) == "" {
+		r.Error = awserr.New(errCodeEC2MetadataTokenRequired, "EC2 Instance Metadata Service session token (IMDSv2) could not be obtained and IMDSv1 fallback is disabled", nil)
+	}
+}
@@ -0,0 +1,240 @@
+package aws
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/ec2rolecreds"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+)
+
+const (
+	testEC2MetadataRoleName = "test-role"
+	testEC2MetadataToken    = "test-token"
+)
+
+var testEC2MetadataCredentials = credentials.Value{
+	AccessKeyID:     "Ec2MetadataAccessKey",
+	ProviderName:    ec2rolecreds.ProviderName,
+	SecretAccessKey: "Ec2MetadataSecretKey",
+	SessionToken:    "Ec2MetadataSessionToken",
+}
+
+// testEC2MetadataServer returns a local server standing in for the EC2 Instance Metadata Service.
+// If tokens is false, the server behaves like an instance on which IMDSv2 is unavailable.
+func testEC2MetadataServer(t *testing.T, tokens bool) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/latest/api/token" {
+			if !tokens || r.Method != http.MethodPut {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+
+			w.Header().Set("X-Aws-Ec2-Metadata-Token-Ttl-Seconds", "21600")
+			fmt.Fprint(w, testEC2MetadataToken)
+			return
+		}
+
+		if tokens && r.Header.Get(ec2MetadataTokenHeader) != testEC2MetadataToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		switch r.URL.Path {
+		case "/latest/meta-data/iam/security-credentials/":
+			fmt.Fprint(w, testEC2MetadataRoleName)
+		case "/latest/meta-data/iam/security-credentials/" + testEC2MetadataRoleName:
+			fmt.Fprintf(w, `{
+  "Code": "Success",
+  "LastUpdated": %[1]q,
+  "Type": "AWS-HMAC",
+  "AccessKeyId": %[2]q,
+  "SecretAccessKey": %[3]q,
+  "Token": %[4]q,
+  "Expiration": %[5]q
+}`, time.Now().UTC().Format(time.RFC3339),
+				testEC2MetadataCredentials.AccessKeyID,
+				testEC2MetadataCredentials.SecretAccessKey,
+				testEC2MetadataCredentials.SessionToken,
+				time.Now().UTC().Add(1*time.Hour).Format(time.RFC3339))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+// testUnsetCredentialsEnv clears credential sources other than the EC2 Instance Metadata Service.
+func testUnsetCredentialsEnv(t *testing.T) {
+	missingFile := filepath.Join(t.TempDir(), "missing")
+
+	for _, k := range []string{
+		"AWS_ACCESS_KEY_ID",
+		"AWS_CONTAINER_CREDENTIALS_FULL_URI",
+		"AWS_CONTAINER_CREDENTIALS_RELATIVE_URI",
+		"AWS_PROFILE",
+		"AWS_ROLE_ARN",
+		"AWS_SECRET_ACCESS_KEY",
+		"AWS_SESSION_TOKEN",
+		"AWS_WEB_IDENTITY_TOKEN_FILE",
+		ec2MetadataDisabledEnvVar,
+		ec2MetadataServiceEndpointEnvVar,
+		ec2MetadataServiceEndpointModeEnvVar,
+	} {
+		t.Setenv(k, "")
+		os.Unsetenv(k)
+	}
+
+	t.Setenv("AWS_CONFIG_FILE", missingFile)
+	t.Setenv("AWS_SHARED_CREDENTIALS_FILE", missingFile)
+}
+
+func TestConfigGetSession_EC2Metadata(t *testing.T) {
+	testCases := []struct {
+		Name                string
+		Config              *Config
+		Tokens              bool
+		ExpectedCredentials credentials.Value
+		ExpectedError       bool
+	}{
+		{
+			Name:                "IMDSv2",
+			Config:              &Config{},
+			Tokens:              true,
+			ExpectedCredentials: testEC2MetadataCredentials,
+		},
+		{
+			Name:                "IMDSv1 fallback",
+			Config:              &Config{},
+			ExpectedCredentials: testEC2MetadataCredentials,
+		},
+		{
+			Name: "IMDSv2 require token",
+			Config: &Config{
+				EC2MetadataServiceRequireToken: true,
+			},
+			Tokens:              true,
+			ExpectedCredentials: testEC2MetadataCredentials,
+		},
+		{
+			Name: "IMDSv1 require token",
+			Config: &Config{
+				EC2MetadataServiceRequireToken: true,
+			},
+			ExpectedError: true,
+		},
+		{
+			Name: "skip metadata API check",
+			Config: &Config{
+				SkipMetadataApiCheck: true,
+			},
+			Tokens:        true,
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			testUnsetCredentialsEnv(t)
+
+			ts := testEC2MetadataServer(t, testCase.Tokens)
+			defer ts.Close()
+
+			testCase.Config.EC2MetadataServiceEndpoint = ts.URL
+			testCase.Config.Region = endpoints.UsEast1RegionID
+			testCase.Config.SkipCredsValidation = true
+
+			awsbaseConfig := &awsbase.Config{
+				Region: testCase.Config.Region,
+			}
+
+			sess, err := testCase.Config.getSession(awsbaseConfig)
+
+			if testCase.ExpectedError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got, err := sess.Config.Credentials.Get()
+
+			if err != nil {
+				t.Fatalf("unexpected error getting credentials: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.ExpectedCredentials) {
+				t.Errorf("got credentials %#v, expected %#v", got, testCase.ExpectedCredentials)
+			}
+		})
+	}
+}
+
+func TestConfigGetEC2MetadataClient_EndpointMode(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		EndpointMode     string
+		ExpectedEndpoint string
+		ExpectedError    bool
+	}{
+		{
+			Name:             "default",
+			ExpectedEndpoint: "http://169.254.169.254",
+		},
+		{
+			Name:             "IPv4",
+			EndpointMode:     "IPv4",
+			ExpectedEndpoint: "http://169.254.169.254",
+		},
+		{
+			Name:             "IPv6",
+			EndpointMode:     "IPv6",
+			ExpectedEndpoint: "http://[fd00:ec2::254]",
+		},
+		{
+			Name:          "invalid",
+			EndpointMode:  "IPv5",
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			testUnsetCredentialsEnv(t)
+
+			config := &Config{
+				EC2MetadataServiceEndpointMode: testCase.EndpointMode,
+			}
+
+			client, err := config.getEC2MetadataClient()
+
+			if testCase.ExpectedError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := client.Endpoint, testCase.ExpectedEndpoint; got != want {
+				t.Errorf("got endpoint %s, expected %s", got, want)
+			}
+		})
+	}
+}
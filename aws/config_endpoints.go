@@ -0,0 +1,210 @@
+package aws
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/ec2metadata"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+)
+
+// dualStackDNSSuffixes are the DNS suffixes of dual-stack (IPv4 and IPv6) service endpoints, by partition.
+// Partitions without an entry do not support dual-stack service endpoints.
+var dualStackDNSSuffixes = map[string]string{
+	endpoints.AwsPartitionID:      "api.aws",
+	endpoints.AwsCnPartitionID:    "api.amazonwebservices.com.cn",
+	endpoints.AwsUsGovPartitionID: "api.aws",
+}
+
+const (
+	// endpointURLTemplateRegion and endpointURLTemplateService are the placeholders substituted in endpoint URL templates.
+	endpointURLTemplateRegion  = "{region}"
+	endpointURLTemplateService = "{service}"
+
+	// localEmulatorEndpointURLTemplate is the endpoint URL template used in local emulator mode when none is configured.
+	localEmulatorEndpointURLTemplate = "http://localhost:4566"
+)
+
+// applyLocalEmulatorDefaults configures the provider for use with a local AWS emulator, which
+// serves all AWS services from a single endpoint and does not implement all of the AWS APIs used
+// to validate credentials and to obtain account and platform information.
+func (c *Config) applyLocalEmulatorDefaults() {
+	if !c.LocalEmulator {
+		return
+	}
+
+	if c.EndpointURLTemplate == "" {
+		c.EndpointURLTemplate = localEmulatorEndpointURLTemplate
+	}
+
+	c.S3ForcePathStyle = true
+	c.SkipCredsValidation = true
+	c.SkipGetEC2Platforms = true
+	c.SkipMetadataApiCheck = true
+	c.SkipRequestingAccountId = true
+}
+
+// applyEndpointURLTemplate sets the endpoint of each service without an explicitly configured
+// endpoint from the endpoint URL template, if any.
+func (c *Config) applyEndpointURLTemplate() {
+	if c.EndpointURLTemplate == "" {
+		return
+	}
+
+	if c.Endpoints == nil {
+		c.Endpoints = make(map[string]string)
+	}
+
+	for _, service := range endpointServiceNames {
+		if c.Endpoints[service] != "" {
+			continue
+		}
+
+		c.Endpoints[service] = expandEndpointURLTemplate(c.EndpointURLTemplate, service, c.Region)
+	}
+}
+
+// expandEndpointURLTemplate returns the endpoint URL for a service and region from an endpoint URL template.
+func expandEndpointURLTemplate(template, service, region string) string {
+	return strings.NewReplacer(
+		endpointURLTemplateRegion, region,
+		endpointURLTemplateService, service,
+	).Replace(template)
+}
+
+// endpointResolver returns the AWS Go SDK endpoint resolver for the provider.
+// The EC2 Instance Metadata Service and custom IAM and STS endpoints are resolved by awsbase,
+// other custom endpoints are set on each service client's configuration and bypass the resolver.
+func (c *Config) endpointResolver(awsbaseConfig *awsbase.Config) endpoints.Resolver {
+	resolver := awsbaseConfig.EndpointResolver()
+
+	if !c.UseFIPSEndpoint && !c.UseDualStackEndpoint {
+		return resolver
+	}
+
+	return endpoints.ResolverFunc(func(service, region string, opts ...func(*endpoints.Options)) (endpoints.ResolvedEndpoint, error) {
+		switch {
+		case service == ec2metadata.ServiceName:
+			return resolver.EndpointFor(service, region, opts...)
+		case service == endpoints.IamServiceID && awsbaseConfig.IamEndpoint != "":
+			return resolver.EndpointFor(service, region, opts...)
+		case service == endpoints.StsServiceID && awsbaseConfig.StsEndpoint != "":
+			return resolver.EndpointFor(service, region, opts...)
+		}
+
+		return resolveEndpointVariant(resolver, service, region, c.UseFIPSEndpoint, c.UseDualStackEndpoint, opts...)
+	})
+}
+
+// resolveEndpointVariant resolves the FIPS and/or dual-stack variant of a service endpoint.
+// Variants defined in the AWS Go SDK endpoints model are used where available, otherwise the
+// variant hostname is derived from the standard endpoint hostname, e.g.
+// SERVICE-fips.REGION.DNS_SUFFIX or SERVICE.REGION.DUAL_STACK_DNS_SUFFIX.
+func resolveEndpointVariant(resolver endpoints.Resolver, service, region string, useFIPS, useDualStack bool, opts ...func(*endpoints.Options)) (endpoints.ResolvedEndpoint, error) {
+	resolved, err := resolver.EndpointFor(service, region, opts...)
+
+	if err != nil {
+		return resolved, err
+	}
+
+	partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region)
+
+	if !ok {
+		return resolved, fmt.Errorf("error resolving %s endpoint variant: unknown partition for region (%s)", service, region)
+	}
+
+	if useFIPS && !useDualStack {
+		if fipsResolved, ok := modeledFIPSEndpoint(partition, service, region); ok {
+			// Requests to the FIPS endpoint are signed for the actual region.
+			fipsResolved.SigningRegion = resolved.SigningRegion
+
+			return fipsResolved, nil
+		}
+	}
+
+	u, err := url.Parse(resolved.URL)
+
+	if err != nil {
+		return resolved, fmt.Errorf("error parsing %s endpoint URL (%s): %w", service, resolved.URL, err)
+	}
+
+	hostname := u.Hostname()
+
+	if useDualStack {
+		dualStackResolved, err := resolver.EndpointFor(service, region, append(opts, endpoints.UseDualStackOption)...)
+
+		if err != nil {
+			return resolved, err
+		}
+
+		if dualStackResolved.URL != resolved.URL {
+			// Dual-stack endpoint defined in the AWS Go SDK endpoints model, e.g. for S3.
+			resolved = dualStackResolved
+
+			if u, err = url.Parse(resolved.URL); err != nil {
+				return resolved, fmt.Errorf("error parsing %s endpoint URL (%s): %w", service, resolved.URL, err)
+			}
+
+			hostname = u.Hostname()
+		} else {
+			dualStackDNSSuffix, ok := dualStackDNSSuffixes[partition.ID()]
+
+			if !ok {
+				return resolved, fmt.Errorf("dual-stack endpoints are not supported in partition (%s)", partition.ID())
+			}
+
+			if !strings.HasSuffix(hostname, "."+partition.DNSSuffix()) {
+				return resolved, fmt.Errorf("error resolving %s dual-stack endpoint: unexpected hostname (%s)", service, hostname)
+			}
+
+			hostname = strings.TrimSuffix(hostname, partition.DNSSuffix()) + dualStackDNSSuffix
+		}
+	}
+
+	if useFIPS {
+		parts := strings.SplitN(hostname, ".", 2)
+
+		if len(parts) != 2 {
+			return resolved, fmt.Errorf("error resolving %s FIPS endpoint: unexpected hostname (%s)", service, hostname)
+		}
+
+		hostname = parts[0] + "-fips." + parts[1]
+	}
+
+	u.Host = hostname
+	resolved.URL = u.String()
+
+	return resolved, nil
+}
+
+// modeledFIPSEndpoint returns the FIPS endpoint for a service and region defined in the
+// AWS Go SDK endpoints model, where FIPS endpoints are modeled as pseudo-regions.
+func modeledFIPSEndpoint(partition endpoints.Partition, service, region string) (endpoints.ResolvedEndpoint, bool) {
+	s, ok := partition.Services()[service]
+
+	if !ok {
+		return endpoints.ResolvedEndpoint{}, false
+	}
+
+	serviceEndpoints := s.Endpoints()
+
+	for _, id := range []string{"fips-" + region, region + "-fips"} {
+		e, ok := serviceEndpoints[id]
+
+		if !ok {
+			continue
+		}
+
+		resolved, err := e.ResolveEndpoint()
+
+		if err != nil {
+			continue
+		}
+
+		return resolved, true
+	}
+
+	return endpoints.ResolvedEndpoint{}, false
+}
@@ -0,0 +1,257 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/ec2metadata"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+)
+
+func TestConfigEndpointResolver(t *testing.T) {
+	testCases := []struct {
+		Name                  string
+		Config                *Config
+		AwsbaseConfig         *awsbase.Config
+		Service               string
+		Region                string
+		ExpectedURL           string
+		ExpectedSigningRegion string
+		ExpectedError         bool
+	}{
+		{
+			Name:                  "default",
+			Config:                &Config{},
+			Service:               "ec2",
+			Region:                endpoints.UsWest2RegionID,
+			ExpectedURL:           "https://ec2.us-west-2.amazonaws.com",
+			ExpectedSigningRegion: endpoints.UsWest2RegionID,
+		},
+		{
+			Name: "FIPS modeled",
+			Config: &Config{
+				UseFIPSEndpoint: true,
+			},
+			Service:               "ec2",
+			Region:                endpoints.UsWest2RegionID,
+			ExpectedURL:           "https://ec2-fips.us-west-2.amazonaws.com",
+			ExpectedSigningRegion: endpoints.UsWest2RegionID,
+		},
+		{
+			Name: "FIPS derived",
+			Config: &Config{
+				UseFIPSEndpoint: true,
+			},
+			Service:               "ssm",
+			Region:                endpoints.EuWest1RegionID,
+			ExpectedURL:           "https://ssm-fips.eu-west-1.amazonaws.com",
+			ExpectedSigningRegion: endpoints.EuWest1RegionID,
+		},
+		{
+			Name: "FIPS global",
+			Config: &Config{
+				UseFIPSEndpoint: true,
+			},
+			Service:               "iam",
+			Region:                endpoints.UsWest2RegionID,
+			ExpectedURL:           "https://iam-fips.amazonaws.com",
+			ExpectedSigningRegion: endpoints.UsEast1RegionID,
+		},
+		{
+			Name: "dual-stack modeled",
+			Config: &Config{
+				UseDualStackEndpoint: true,
+			},
+			Service:               "s3",
+			Region:                endpoints.UsWest2RegionID,
+			ExpectedURL:           "https://s3.dualstack.us-west-2.amazonaws.com",
+			ExpectedSigningRegion: endpoints.UsWest2RegionID,
+		},
+		{
+			Name: "dual-stack derived",
+			Config: &Config{
+				UseDualStackEndpoint: true,
+			},
+			Service:               "ec2",
+			Region:                endpoints.UsWest2RegionID,
+			ExpectedURL:           "https://ec2.us-west-2.api.aws",
+			ExpectedSigningRegion: endpoints.UsWest2RegionID,
+		},
+		{
+			Name: "dual-stack derived China",
+			Config: &Config{
+				UseDualStackEndpoint: true,
+			},
+			Service:               "ec2",
+			Region:                endpoints.CnNorth1RegionID,
+			ExpectedURL:           "https://ec2.cn-north-1.api.amazonwebservices.com.cn",
+			ExpectedSigningRegion: endpoints.CnNorth1RegionID,
+		},
+		{
+			Name: "dual-stack unsupported partition",
+			Config: &Config{
+				UseDualStackEndpoint: true,
+			},
+			Service:       "ec2",
+			Region:        endpoints.UsIsoEast1RegionID,
+			ExpectedError: true,
+		},
+		{
+			Name: "FIPS and dual-stack",
+			Config: &Config{
+				UseDualStackEndpoint: true,
+				UseFIPSEndpoint:      true,
+			},
+			Service:               "ec2",
+			Region:                endpoints.UsWest2RegionID,
+			ExpectedURL:           "https://ec2-fips.us-west-2.api.aws",
+			ExpectedSigningRegion: endpoints.UsWest2RegionID,
+		},
+		{
+			Name: "FIPS and dual-stack modeled",
+			Config: &Config{
+				UseDualStackEndpoint: true,
+				UseFIPSEndpoint:      true,
+			},
+			Service:               "s3",
+			Region:                endpoints.UsWest2RegionID,
+			ExpectedURL:           "https://s3-fips.dualstack.us-west-2.amazonaws.com",
+			ExpectedSigningRegion: endpoints.UsWest2RegionID,
+		},
+		{
+			Name: "FIPS custom STS endpoint",
+			Config: &Config{
+				UseFIPSEndpoint: true,
+			},
+			AwsbaseConfig: &awsbase.Config{
+				StsEndpoint: "https://sts.example.com",
+			},
+			Service:               "sts",
+			Region:                endpoints.UsWest2RegionID,
+			ExpectedURL:           "https://sts.example.com",
+			ExpectedSigningRegion: endpoints.UsEast1RegionID,
+		},
+		{
+			Name: "FIPS EC2 metadata",
+			Config: &Config{
+				UseFIPSEndpoint: true,
+			},
+			Service:               ec2metadata.ServiceName,
+			Region:                endpoints.UsWest2RegionID,
+			ExpectedURL:           "http://169.254.169.254/latest",
+			ExpectedSigningRegion: "aws-global",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			awsbaseConfig := testCase.AwsbaseConfig
+
+			if awsbaseConfig == nil {
+				awsbaseConfig = &awsbase.Config{}
+			}
+
+			resolved, err := testCase.Config.endpointResolver(awsbaseConfig).EndpointFor(testCase.Service, testCase.Region)
+
+			if testCase.ExpectedError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := resolved.URL, testCase.ExpectedURL; got != want {
+				t.Errorf("got URL %s, expected %s", got, want)
+			}
+
+			if got, want := resolved.SigningRegion, testCase.ExpectedSigningRegion; got != want {
+				t.Errorf("got signing region %s, expected %s", got, want)
+			}
+		})
+	}
+}
+
+func TestConfigApplyEndpointURLTemplate(t *testing.T) {
+	testCases := []struct {
+		Name              string
+		Config            *Config
+		ExpectedEndpoints map[string]string
+	}{
+		{
+			Name: "no template",
+			Config: &Config{
+				Endpoints: map[string]string{"s3": "http://s3.example.com"},
+				Region:    endpoints.UsWest2RegionID,
+			},
+			ExpectedEndpoints: map[string]string{
+				"ec2": "",
+				"s3":  "http://s3.example.com",
+				"sts": "",
+			},
+		},
+		{
+			Name: "template",
+			Config: &Config{
+				Endpoints:           map[string]string{"s3": "http://s3.example.com"},
+				EndpointURLTemplate: "https://{service}.{region}.internal",
+				Region:              endpoints.UsWest2RegionID,
+			},
+			ExpectedEndpoints: map[string]string{
+				"ec2": "https://ec2.us-west-2.internal",
+				"s3":  "http://s3.example.com",
+				"sts": "https://sts.us-west-2.internal",
+			},
+		},
+		{
+			Name: "local emulator",
+			Config: &Config{
+				LocalEmulator: true,
+				Region:        endpoints.UsEast1RegionID,
+			},
+			ExpectedEndpoints: map[string]string{
+				"ec2": "http://localhost:4566",
+				"s3":  "http://localhost:4566",
+				"sts": "http://localhost:4566",
+			},
+		},
+		{
+			Name: "local emulator with template",
+			Config: &Config{
+				EndpointURLTemplate: "http://localhost:4566/{service}",
+				LocalEmulator:       true,
+				Region:              endpoints.UsEast1RegionID,
+			},
+			ExpectedEndpoints: map[string]string{
+				"ec2": "http://localhost:4566/ec2",
+				"s3":  "http://localhost:4566/s3",
+				"sts": "http://localhost:4566/sts",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			testCase.Config.applyLocalEmulatorDefaults()
+			testCase.Config.applyEndpointURLTemplate()
+
+			for service, expected := range testCase.ExpectedEndpoints {
+				if got := testCase.Config.Endpoints[service]; got != expected {
+					t.Errorf("got %s endpoint %q, expected %q", service, got, expected)
+				}
+			}
+
+			if testCase.Config.LocalEmulator {
+				c := testCase.Config
+
+				if !c.S3ForcePathStyle || !c.SkipCredsValidation || !c.SkipGetEC2Platforms || !c.SkipMetadataApiCheck || !c.SkipRequestingAccountId {
+					t.Errorf("expected local emulator to set S3 path-style addressing and skip flags, got %#v", c)
+				}
+			}
+		})
+	}
+}
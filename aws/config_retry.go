@@ -0,0 +1,97 @@
+package aws
+
+import (
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/ratelimit"
+)
+
+const (
+	// retryModeStandard retries failed requests with the AWS Go SDK's exponential backoff.
+	retryModeStandard = "standard"
+
+	// retryModeAdaptive additionally limits the rate of requests to each service on the client
+	// when the service responds with throttling errors.
+	retryModeAdaptive = "adaptive"
+)
+
+func retryMode_Values() []string {
+	return []string{
+		retryModeAdaptive,
+		retryModeStandard,
+	}
+}
+
+// maxRetryDurationHandler returns a request handler which stops a request from being retried
+// once the given time has elapsed since the request was created.
+// The handler must run after any handlers which mark a request as retryable.
+func maxRetryDurationHandler(maxRetryDuration time.Duration) request.NamedHandler {
+	return request.NamedHandler{
+		Name: "terraform-provider-aws.MaxRetryDuration",
+		Fn: func(r *request.Request) {
+			if r.Retryable != nil && !aws.BoolValue(r.Retryable) {
+				return
+			}
+
+			if elapsed := time.Since(r.Time); elapsed >= maxRetryDuration {
+				log.Printf("[WARN] Disabling retries after %s (max_retry_duration %s): %s", elapsed.Round(time.Millisecond), maxRetryDuration, r.Error)
+				r.Retryable = aws.Bool(false)
+			}
+		},
+	}
+}
+
+// addAdaptiveRateLimitHandlers adds request handlers which wait for the limiter before each
+// request attempt is sent and update the limiter with the outcome of each attempt.
+func addAdaptiveRateLimitHandlers(handlers *request.Handlers, limiter *ratelimit.AdaptiveLimiter) {
+	handlers.Sign.PushFrontNamed(request.NamedHandler{
+		Name: "terraform-provider-aws.AdaptiveRateLimitAcquire",
+		Fn: func(r *request.Request) {
+			if err := limiter.Acquire(r.Context()); err != nil {
+				r.Error = awserr.New(request.CanceledErrorCode, "request context canceled", err)
+			}
+		},
+	})
+
+	handlers.CompleteAttempt.PushBackNamed(request.NamedHandler{
+		Name: "terraform-provider-aws.AdaptiveRateLimitUpdate",
+		Fn: func(r *request.Request) {
+			switch {
+			case r.Error == nil:
+				limiter.Update(false)
+			case r.IsErrorThrottle():
+				log.Printf("[DEBUG] Throttled by %s: reducing request rate", r.ClientInfo.ServiceName)
+				limiter.Update(true)
+			}
+		},
+	})
+}
+
+// rateLimiter returns the adaptive rate limiter shared by all clients of the named service.
+// The caller must hold connsMutex.
+func (client *AWSClient) rateLimiter(name string) *ratelimit.AdaptiveLimiter {
+	// Resource clients share the provider client's rate limiters.
+	if parent := client.parent; parent != nil {
+		parent.connsMutex.Lock()
+		defer parent.connsMutex.Unlock()
+
+		return parent.rateLimiter(name)
+	}
+
+	if limiter, ok := client.rateLimiters[name]; ok {
+		return limiter
+	}
+
+	if client.rateLimiters == nil {
+		client.rateLimiters = make(map[string]*ratelimit.AdaptiveLimiter)
+	}
+
+	limiter := ratelimit.NewAdaptiveLimiter()
+	client.rateLimiters[name] = limiter
+
+	return limiter
+}
@@ -0,0 +1,111 @@
+package aws
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/ratelimit"
+)
+
+const testThrottlingResponse = `<ErrorResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <Error>
+    <Type>Sender</Type>
+    <Code>Throttling</Code>
+    <Message>Rate exceeded</Message>
+  </Error>
+  <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+</ErrorResponse>`
+
+func testThrottlingServer(t *testing.T, requests *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(requests, 1)
+		w.Header().Set("Content-Type", "text/xml")
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(testThrottlingResponse)) //nolint:errcheck
+	}))
+}
+
+func testThrottlingSession(t *testing.T, endpoint string, maxRetries int) *session.Session {
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("MockAccessKey", "MockSecretKey", ""),
+		Endpoint:    aws.String(endpoint),
+		MaxRetries:  aws.Int(maxRetries),
+		Region:      aws.String("us-east-1"),
+		SleepDelay:  func(time.Duration) {},
+	})
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	return sess
+}
+
+func TestAddAdaptiveRateLimitHandlers(t *testing.T) {
+	var requests int32
+	server := testThrottlingServer(t, &requests)
+	defer server.Close()
+
+	sess := testThrottlingSession(t, server.URL, 0)
+	limiter := ratelimit.NewAdaptiveLimiter()
+	addAdaptiveRateLimitHandlers(&sess.Handlers, limiter)
+
+	_, err := sts.New(sess).GetCallerIdentity(&sts.GetCallerIdentityInput{})
+
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	if !limiter.Enabled() {
+		t.Error("expected rate limiter to be enabled after throttling response")
+	}
+}
+
+func TestMaxRetryDurationHandler(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		MaxRetryDuration time.Duration
+		ExpectedRequests int32
+	}{
+		{
+			Name:             "not elapsed",
+			MaxRetryDuration: time.Hour,
+			ExpectedRequests: 4,
+		},
+		{
+			Name:             "elapsed",
+			MaxRetryDuration: time.Nanosecond,
+			ExpectedRequests: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			var requests int32
+			server := testThrottlingServer(t, &requests)
+			defer server.Close()
+
+			sess := testThrottlingSession(t, server.URL, 3)
+			sess.Handlers.AfterRetry.PushFrontNamed(maxRetryDurationHandler(testCase.MaxRetryDuration))
+
+			_, err := sts.New(sess).GetCallerIdentity(&sts.GetCallerIdentityInput{})
+
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if got, want := atomic.LoadInt32(&requests), testCase.ExpectedRequests; got != want {
+				t.Errorf("expected %d requests, got %d", want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,291 @@
+package aws
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go/service/appconfig"
+	"github.com/aws/aws-sdk-go/service/appsync"
+	"github.com/aws/aws-sdk-go/service/chime"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/cloudhsmv2"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/aws/aws-sdk-go/service/kafka"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/aws/aws-sdk-go/service/storagegateway"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+)
+
+// RetryableError describes an AWS API error which the AWS Go SDK does not retry,
+// but which is known to be transient and is retried by the provider.
+type RetryableError struct {
+	// Service is the service name, as used in the endpoints configuration block.
+	Service string
+
+	// Operations limits the error to the named API operations.
+	// A name ending in "*" matches all operations with that prefix.
+	// If empty, the error is retried for all operations.
+	Operations []string
+
+	// Code is the error code.
+	Code string
+
+	// Message, if set, must be contained in the error message.
+	Message string
+
+	// MaxAttempts, if set, limits the number of attempts made when the error is returned,
+	// where the default maximum number of retries would excessively retry an error that
+	// could be legitimate.
+	MaxAttempts int
+}
+
+// matches returns whether the request failed with the error.
+func (e *RetryableError) matches(r *request.Request) bool {
+	if !tfawserr.ErrMessageContains(r.Error, e.Code, e.Message) {
+		return false
+	}
+
+	if len(e.Operations) == 0 {
+		return true
+	}
+
+	if r.Operation == nil {
+		return false
+	}
+
+	for _, operation := range e.Operations {
+		if prefix := strings.TrimSuffix(operation, "*"); prefix != operation {
+			if strings.HasPrefix(r.Operation.Name, prefix) {
+				return true
+			}
+		} else if r.Operation.Name == operation {
+			return true
+		}
+	}
+
+	return false
+}
+
+// retryableErrorsHandler returns a request handler which marks a failed request as retryable
+// if its error matches one of the given errors. The first matching error is used.
+func retryableErrorsHandler(retryableErrors []*RetryableError) request.NamedHandler {
+	return request.NamedHandler{
+		Name: "terraform-provider-aws.RetryableErrors",
+		Fn: func(r *request.Request) {
+			for _, e := range retryableErrors {
+				if !e.matches(r) {
+					continue
+				}
+
+				r.Retryable = aws.Bool(e.MaxAttempts == 0 || r.RetryCount+1 < e.MaxAttempts)
+
+				return
+			}
+		},
+	}
+}
+
+// retryableErrorsByService groups errors by service name.
+func retryableErrorsByService(retryableErrors ...[]*RetryableError) map[string][]*RetryableError {
+	m := make(map[string][]*RetryableError)
+
+	for _, l := range retryableErrors {
+		for _, e := range l {
+			m[e.Service] = append(m[e.Service], e)
+		}
+	}
+
+	return m
+}
+
+// defaultRetryableErrors are the AWS API errors retried by the provider, in addition to those
+// retried by the AWS Go SDK.
+var defaultRetryableErrors = []*RetryableError{
+	// Many operations can return an error such as:
+	//   ConflictException: Unable to complete operation due to concurrent modification. Please try again later.
+	{
+		Service: "apigateway",
+		Code:    apigateway.ErrCodeConflictException,
+		Message: "try again later",
+	},
+	// Workaround for https://github.com/aws/aws-sdk-go/issues/1472
+	{
+		Service:    "applicationautoscaling",
+		Operations: []string{"Describe*", "List*"},
+		Code:       applicationautoscaling.ErrCodeFailedResourceAccessException,
+	},
+	// StartDeployment operations can return a ConflictException
+	// if ongoing deployments are in-progress.
+	{
+		Service:    "appconfig",
+		Operations: []string{"StartDeployment"},
+		Code:       appconfig.ErrCodeConflictException,
+	},
+	{
+		Service:    "appsync",
+		Operations: []string{"CreateGraphqlApi"},
+		Code:       appsync.ErrCodeConcurrentModificationException,
+		Message:    "a GraphQL API creation is already in progress",
+	},
+	// When calling CreateVoiceConnector across multiple resources,
+	// the API can randomly return a BadRequestException without explanation.
+	{
+		Service:    "chime",
+		Operations: []string{"CreateVoiceConnector"},
+		Code:       chime.ErrCodeBadRequestException,
+		Message:    "Service received a bad request",
+	},
+	{
+		Service: "cloudformation",
+		Code:    cloudformation.ErrCodeOperationInProgressException,
+		Message: "Another Operation on StackSet",
+	},
+	{
+		Service: "cloudhsm",
+		Code:    cloudhsmv2.ErrCodeCloudHsmInternalFailureException,
+		Message: "request was rejected because of an AWS CloudHSM internal failure",
+	},
+	// When calling Config Organization Rules API actions immediately
+	// after Organization creation, the API can randomly return the
+	// OrganizationAccessDeniedException error for a few minutes, even
+	// after succeeding a few requests.
+	// ~10 attempts with the DefaultRetryer exponential backoff gives a fair backoff of a few seconds.
+	{
+		Service:     "configservice",
+		Operations:  []string{"DeleteOrganizationConfigRule", "DescribeOrganizationConfigRules", "DescribeOrganizationConfigRuleStatuses", "PutOrganizationConfigRule"},
+		Code:        configservice.ErrCodeOrganizationAccessDeniedException,
+		Message:     "This action can be only made by AWS Organization's master account.",
+		MaxAttempts: 10,
+	},
+	{
+		Service:     "configservice",
+		Operations:  []string{"DeleteOrganizationConformancePack", "DescribeOrganizationConformancePacks", "DescribeOrganizationConformancePackStatuses", "PutOrganizationConformancePack"},
+		Code:        configservice.ErrCodeOrganizationAccessDeniedException,
+		MaxAttempts: 10,
+	},
+	{
+		Service:    "configservice",
+		Operations: []string{"DeleteOrganizationConformancePack"},
+		Code:       configservice.ErrCodeResourceInUseException,
+	},
+	// See https://github.com/aws/aws-sdk-go/pull/1276
+	{
+		Service:    "dynamodb",
+		Operations: []string{"PutItem", "UpdateItem", "DeleteItem"},
+		Code:       dynamodb.ErrCodeLimitExceededException,
+		Message:    "Subscriber limit exceeded:",
+	},
+	{
+		Service:    "ec2",
+		Operations: []string{"CreateClientVpnEndpoint"},
+		Code:       "OperationNotPermitted",
+		Message:    "Endpoint cannot be created while another endpoint is being created",
+	},
+	{
+		Service:    "ec2",
+		Operations: []string{"CreateVpnConnection"},
+		Code:       "VpnConnectionLimitExceeded",
+		Message:    "maximum number of mutating objects has been reached",
+	},
+	{
+		Service:    "ec2",
+		Operations: []string{"CreateVpnGateway"},
+		Code:       "VpnGatewayLimitExceeded",
+		Message:    "maximum number of mutating objects has been reached",
+	},
+	{
+		Service:    "ec2",
+		Operations: []string{"AttachVpnGateway", "DetachVpnGateway"},
+		Code:       "InvalidParameterValue",
+		Message:    "This call cannot be completed because there are pending VPNs or Virtual Interfaces",
+	},
+	// Acceptance testing creates and deletes resources in quick succession.
+	// The FMS onboarding process into Organizations is opaque to consumers.
+	// Since we cannot reasonably check this status before receiving the error,
+	// set the operation as retryable.
+	{
+		Service:    "fms",
+		Operations: []string{"AssociateAdminAccount"},
+		Code:       fms.ErrCodeInvalidOperationException,
+		Message:    "Your AWS Organization is currently offboarding with AWS Firewall Manager. Please submit onboard request after offboarded.",
+	},
+	{
+		Service:    "fms",
+		Operations: []string{"DisassociateAdminAccount"},
+		Code:       fms.ErrCodeInvalidOperationException,
+		Message:    "Your AWS Organization is currently onboarding with AWS Firewall Manager and cannot be offboarded.",
+	},
+	{
+		Service: "kafka",
+		Code:    kafka.ErrCodeTooManyRequestsException,
+		Message: "Too Many Requests",
+	},
+	{
+		Service:    "kinesis",
+		Operations: []string{"CreateStream"},
+		Code:       kinesis.ErrCodeLimitExceededException,
+		Message:    "simultaneously be in CREATING or DELETING",
+	},
+	{
+		Service:    "kinesis",
+		Operations: []string{"CreateStream", "DeleteStream"},
+		Code:       kinesis.ErrCodeLimitExceededException,
+		Message:    "Rate exceeded for stream",
+	},
+	// ConcurrentModificationException: AWS Organizations can't complete your request because it conflicts with another attempt to modify the same entity. Try again later.
+	{
+		Service: "organizations",
+		Code:    organizations.ErrCodeConcurrentModificationException,
+		Message: "Try again later",
+	},
+	// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/17996
+	{
+		Service:    "securityhub",
+		Operations: []string{"EnableOrganizationAdminAccount"},
+		Code:       securityhub.ErrCodeResourceConflictException,
+	},
+	// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/19215
+	{
+		Service:    "ssoadmin",
+		Operations: []string{"AttachManagedPolicyToPermissionSet", "DetachManagedPolicyFromPermissionSet"},
+		Code:       ssoadmin.ErrCodeConflictException,
+	},
+	// InvalidGatewayRequestException: The specified gateway proxy network connection is busy.
+	{
+		Service: "storagegateway",
+		Code:    storagegateway.ErrCodeInvalidGatewayRequestException,
+		Message: "The specified gateway proxy network connection is busy",
+	},
+	{
+		Service: "wafv2",
+		Code:    wafv2.ErrCodeWAFInternalErrorException,
+		Message: "Retry your request",
+	},
+	{
+		Service: "wafv2",
+		Code:    wafv2.ErrCodeWAFServiceLinkedRoleErrorException,
+		Message: "Retry",
+	},
+	// WAFv2 supports tag on create which can result in the below error codes according to the documentation.
+	{
+		Service:    "wafv2",
+		Operations: []string{"CreateIPSet", "CreateRegexPatternSet", "CreateRuleGroup", "CreateWebACL"},
+		Code:       wafv2.ErrCodeWAFTagOperationException,
+		Message:    "Retry your request",
+	},
+	{
+		Service:    "wafv2",
+		Operations: []string{"CreateIPSet", "CreateRegexPatternSet", "CreateRuleGroup", "CreateWebACL"},
+		Code:       wafv2.ErrCodeWAFTagOperationInternalErrorException,
+		Message:    "Retry your request",
+	},
+}
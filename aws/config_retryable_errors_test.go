@@ -0,0 +1,149 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+func testRetryableErrorRequest(operation, code, message string, retryCount int) *request.Request {
+	return &request.Request{
+		Error:      awserr.New(code, message, nil),
+		Operation:  &request.Operation{Name: operation},
+		RetryCount: retryCount,
+	}
+}
+
+// TestDefaultRetryableErrors verifies that each default retryable error is retried
+// for a matching request and is not retried for a different error code.
+func TestDefaultRetryableErrors(t *testing.T) {
+	for i, e := range defaultRetryableErrors {
+		e := e
+
+		operation := "AnyOperation"
+		if len(e.Operations) > 0 {
+			operation = strings.TrimSuffix(e.Operations[0], "*")
+		}
+
+		t.Run(fmt.Sprintf("%s/%d/%s", e.Service, i, e.Code), func(t *testing.T) {
+			handler := retryableErrorsHandler([]*RetryableError{e})
+
+			r := testRetryableErrorRequest(operation, e.Code, "Error: "+e.Message+".", 0)
+			handler.Fn(r)
+
+			if !aws.BoolValue(r.Retryable) {
+				t.Errorf("expected %s error for %s to be retryable", e.Code, operation)
+			}
+
+			r = testRetryableErrorRequest(operation, "OtherException", e.Message, 0)
+			handler.Fn(r)
+
+			if r.Retryable != nil {
+				t.Errorf("expected OtherException error for %s not to be handled", operation)
+			}
+
+			if e.Message != "" {
+				r = testRetryableErrorRequest(operation, e.Code, "Some other message", 0)
+				handler.Fn(r)
+
+				if r.Retryable != nil {
+					t.Errorf("expected %s error with other message for %s not to be handled", e.Code, operation)
+				}
+			}
+
+			if len(e.Operations) > 0 {
+				r = testRetryableErrorRequest("OtherOperation", e.Code, e.Message, 0)
+				handler.Fn(r)
+
+				if r.Retryable != nil {
+					t.Errorf("expected %s error for OtherOperation not to be handled", e.Code)
+				}
+			}
+		})
+	}
+}
+
+func TestRetryableErrorsHandler(t *testing.T) {
+	retryableErrors := []*RetryableError{
+		{
+			Service:     "configservice",
+			Operations:  []string{"PutOrganizationConfigRule"},
+			Code:        "OrganizationAccessDeniedException",
+			MaxAttempts: 3,
+		},
+		{
+			Service:    "applicationautoscaling",
+			Operations: []string{"Describe*"},
+			Code:       "FailedResourceAccessException",
+		},
+	}
+
+	testCases := []struct {
+		Name              string
+		Request           *request.Request
+		ExpectedRetryable *bool
+	}{
+		{
+			Name:              "max attempts not reached",
+			Request:           testRetryableErrorRequest("PutOrganizationConfigRule", "OrganizationAccessDeniedException", "", 1),
+			ExpectedRetryable: aws.Bool(true),
+		},
+		{
+			Name:              "max attempts reached",
+			Request:           testRetryableErrorRequest("PutOrganizationConfigRule", "OrganizationAccessDeniedException", "", 2),
+			ExpectedRetryable: aws.Bool(false),
+		},
+		{
+			Name:              "operation prefix",
+			Request:           testRetryableErrorRequest("DescribeScalableTargets", "FailedResourceAccessException", "", 0),
+			ExpectedRetryable: aws.Bool(true),
+		},
+		{
+			Name:    "operation prefix not matched",
+			Request: testRetryableErrorRequest("RegisterScalableTarget", "FailedResourceAccessException", "", 0),
+		},
+		{
+			Name: "no error",
+			Request: &request.Request{
+				Operation: &request.Operation{Name: "PutOrganizationConfigRule"},
+			},
+		},
+	}
+
+	handler := retryableErrorsHandler(retryableErrors)
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			handler.Fn(testCase.Request)
+
+			if got, want := testCase.Request.Retryable, testCase.ExpectedRetryable; (got == nil) != (want == nil) || aws.BoolValue(got) != aws.BoolValue(want) {
+				t.Errorf("got retryable %v, expected %v", aws.BoolValue(got), aws.BoolValue(want))
+			}
+		})
+	}
+}
+
+func TestRetryableErrorsByService(t *testing.T) {
+	extra := []*RetryableError{
+		{
+			Service: "ec2",
+			Code:    "InvalidParameterValue",
+		},
+	}
+
+	m := retryableErrorsByService(extra, defaultRetryableErrors)
+
+	if got := m["ec2"][0]; got != extra[0] {
+		t.Errorf("expected extra retryable error to take precedence, got %+v", got)
+	}
+
+	if got, want := len(m["organizations"]), 1; got != want {
+		t.Errorf("got %d Organizations retryable errors, expected %d", got, want)
+	}
+}
@@ -0,0 +1,474 @@
+package aws
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/sts"
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-cleanhttp"
+	homedir "github.com/mitchellh/go-homedir"
+	"golang.org/x/net/http/httpproxy"
+)
+
+// The functions in this file follow the session setup of the
+// github.com/hashicorp/aws-sdk-go-base package, but resolve credentials through
+// (*Config).getCredentials so that credential sources which are not supported
+// by that package, such as assume_role_with_web_identity, can be honored.
+
+// httpTransportWrapper, if set, wraps the transport of the HTTP clients used to call AWS.
+// Acceptance tests set it to record and replay API interactions.
+var httpTransportWrapper func(http.RoundTripper) http.RoundTripper
+
+// getSessionWithAccountIDAndPartition returns a valid AWS Go SDK session
+// along with account ID and partition information if available.
+func (c *Config) getSessionWithAccountIDAndPartition(awsbaseConfig *awsbase.Config) (*session.Session, string, string, error) {
+	sess, err := c.getSession(awsbaseConfig)
+
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	if roleARN := c.assumedRoleARN(); roleARN != "" {
+		if v, err := arn.Parse(roleARN); err == nil {
+			return sess, v.AccountID, v.Partition, nil
+		}
+
+		return sess, "", "", nil
+	}
+
+	iamClient := iam.New(sess)
+	stsClient := sts.New(sess)
+
+	if !c.SkipCredsValidation {
+		accountID, partition, err := awsbase.GetAccountIDAndPartitionFromSTSGetCallerIdentity(stsClient)
+
+		if err != nil {
+			return nil, "", "", fmt.Errorf("error validating provider credentials: %w", err)
+		}
+
+		return sess, accountID, partition, nil
+	}
+
+	if !c.SkipRequestingAccountId {
+		credentialsProviderName := ""
+
+		if credentialsValue, err := sess.Config.Credentials.Get(); err == nil {
+			credentialsProviderName = credentialsValue.ProviderName
+		}
+
+		accountID, partition, err := awsbase.GetAccountIDAndPartition(iamClient, stsClient, credentialsProviderName)
+
+		if err == nil {
+			return sess, accountID, partition, nil
+		}
+
+		return nil, "", "", fmt.Errorf(
+			"AWS account ID not previously found and failed retrieving via all available methods. "+
+				"See https://www.terraform.io/docs/providers/aws/index.html#skip_requesting_account_id for workaround and implications. "+
+				"Errors: %w", err)
+	}
+
+	var partition string
+	if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), c.Region); ok {
+		partition = p.ID()
+	}
+
+	return sess, "", partition, nil
+}
+
+// assumedRoleARN returns the ARN of the last IAM Role assumed by the provider, if any.
+func (c *Config) assumedRoleARN() string {
+	if n := len(c.AssumeRole); n > 0 {
+		return c.AssumeRole[n-1].RoleARN
+	}
+
+	return c.AssumeRoleWithWebIdentityARN
+}
+
+// getSession returns a valid AWS Go SDK session.
+func (c *Config) getSession(awsbaseConfig *awsbase.Config) (*session.Session, error) {
+	c.configureEC2MetadataEnvironment()
+
+	options, err := c.getSessionOptions(awsbaseConfig)
+
+	if err != nil {
+		return nil, err
+	}
+
+	sess, err := session.NewSessionWithOptions(*options)
+	if err != nil {
+		if tfawserr.ErrCodeEquals(err, "NoCredentialProviders") {
+			return nil, awsbaseConfig.NewNoValidCredentialSourcesError(err)
+		}
+		return nil, fmt.Errorf("Error creating AWS session: %w", err)
+	}
+
+	if c.MaxRetries > 0 {
+		sess = sess.Copy(&aws.Config{MaxRetries: aws.Int(c.MaxRetries)})
+	}
+
+	// The AWS Go SDK request package functions only append, so PushFront the
+	// User-Agent products in reverse order to keep the configured order ahead
+	// of the SDK's own product.
+	for i := len(awsbaseConfig.UserAgentProducts) - 1; i >= 0; i-- {
+		product := awsbaseConfig.UserAgentProducts[i]
+		sess.Handlers.Build.PushFront(request.MakeAddToUserAgentHandler(product.Name, product.Version, product.Extra...))
+	}
+
+	// Add custom input from ENV to the User-Agent request header
+	// Reference: https://github.com/terraform-providers/terraform-provider-aws/issues/9149
+	if v := os.Getenv(awsbase.AppendUserAgentEnvVar); v != "" {
+		log.Printf("[DEBUG] Using additional User-Agent Info: %s", v)
+		sess.Handlers.Build.PushBack(request.MakeAddToUserAgentFreeFormHandler(v))
+	}
+
+	// Generally, we want to configure a lower retry theshold for networking issues
+	// as the session retry threshold is very high by default and can mask permanent
+	// networking failures, such as a non-existent service endpoint.
+	// MaxRetries will override this logic if it has a lower retry threshold.
+	sess.Handlers.Retry.PushBack(func(r *request.Request) {
+		if r.RetryCount < awsbase.MaxNetworkRetryCount {
+			return
+		}
+		// RequestError: send request failed
+		// caused by: Post https://FQDN/: dial tcp: lookup FQDN: no such host
+		if tfawserr.ErrMessageAndOrigErrContain(r.Error, request.ErrCodeRequestError, "send request failed", "no such host") {
+			log.Printf("[WARN] Disabling retries after next request due to networking issue")
+			r.Retryable = aws.Bool(false)
+		}
+		// RequestError: send request failed
+		// caused by: Post https://FQDN/: dial tcp IPADDRESS:443: connect: connection refused
+		if tfawserr.ErrMessageAndOrigErrContain(r.Error, request.ErrCodeRequestError, "send request failed", "connection refused") {
+			log.Printf("[WARN] Disabling retries after next request due to networking issue")
+			r.Retryable = aws.Bool(false)
+		}
+	})
+
+	if c.MaxRetryDuration > 0 {
+		sess.Handlers.AfterRetry.PushFrontNamed(maxRetryDurationHandler(c.MaxRetryDuration))
+	}
+
+	if !c.SkipCredsValidation {
+		if _, _, err := awsbase.GetAccountIDAndPartitionFromSTSGetCallerIdentity(sts.New(sess)); err != nil {
+			return nil, fmt.Errorf("error validating provider credentials: %w", err)
+		}
+	}
+
+	return sess, nil
+}
+
+// getSessionOptions returns AWS Go SDK session options, including validated credentials.
+func (c *Config) getSessionOptions(awsbaseConfig *awsbase.Config) (*session.Options, error) {
+	httpClient, err := c.getHTTPClient()
+
+	if err != nil {
+		return nil, err
+	}
+
+	wrapHTTPClientTransport(httpClient)
+
+	sharedConfigFiles, err := c.sharedConfigFiles()
+
+	if err != nil {
+		return nil, err
+	}
+
+	options := &session.Options{
+		Config: aws.Config{
+			CredentialsChainVerboseErrors: aws.Bool(true),
+			EndpointResolver:              c.endpointResolver(awsbaseConfig),
+			HTTPClient:                    httpClient,
+			MaxRetries:                    aws.Int(0),
+			Region:                        aws.String(c.Region),
+		},
+		Profile:           c.Profile,
+		SharedConfigFiles: sharedConfigFiles,
+		SharedConfigState: session.SharedConfigEnable,
+	}
+
+	creds, err := c.getCredentials(awsbaseConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	options.Config.Credentials = creds
+
+	if awsbaseConfig.DebugLogging {
+		options.Config.LogLevel = aws.LogLevel(aws.LogDebugWithHTTPBody | aws.LogDebugWithRequestRetries | aws.LogDebugWithRequestErrors)
+		options.Config.Logger = awsbase.DebugLogger{}
+	}
+
+	return options, nil
+}
+
+// getCredentials returns validated credentials for the provider.
+// The base credentials are obtained, in order of precedence, via AssumeRoleWithWebIdentity
+// or credential_process, if configured, from an AWS SSO profile, or from the standard
+// credential chain, including the EC2 Instance Metadata Service. Each configured IAM Role
+// is then assumed in turn, starting from the base credentials.
+func (c *Config) getCredentials(awsbaseConfig *awsbase.Config) (*credentials.Credentials, error) {
+	var creds *credentials.Credentials
+	var err error
+
+	if c.AssumeRoleWithWebIdentityARN != "" {
+		creds, err = c.getWebIdentityCredentials(awsbaseConfig)
+	} else if c.CredentialProcess != "" {
+		creds, err = c.getProcessCredentials()
+	} else if creds, err = c.getSSOCredentials(awsbaseConfig); err == nil && creds == nil {
+		creds, err = c.getBaseCredentials(awsbaseConfig)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	logCredentialsSource(creds)
+
+	for i, assumeRole := range c.AssumeRole {
+		creds, err = c.getAssumeRoleCredentials(awsbaseConfig, creds, assumeRole)
+
+		if err != nil {
+			return nil, fmt.Errorf("error assuming IAM Role (hop %d of %d): %w", i+1, len(c.AssumeRole), err)
+		}
+	}
+
+	return creds, nil
+}
+
+// getWebIdentityCredentials returns validated credentials obtained via AssumeRoleWithWebIdentity.
+func (c *Config) getWebIdentityCredentials(awsbaseConfig *awsbase.Config) (*credentials.Credentials, error) {
+	log.Printf("[INFO] Attempting to AssumeRoleWithWebIdentity %s (SessionName: %q)",
+		c.AssumeRoleWithWebIdentityARN, c.AssumeRoleWithWebIdentitySessionName)
+
+	var tokenFetcher stscreds.TokenFetcher
+
+	if c.AssumeRoleWithWebIdentityToken != "" {
+		tokenFetcher = webIdentityToken(c.AssumeRoleWithWebIdentityToken)
+	} else {
+		tokenFile, err := homedir.Expand(c.AssumeRoleWithWebIdentityTokenFile)
+
+		if err != nil {
+			return nil, fmt.Errorf("error expanding web identity token filename: %w", err)
+		}
+
+		tokenFetcher = stscreds.FetchTokenPath(tokenFile)
+	}
+
+	// AssumeRoleWithWebIdentity requests are not signed.
+	stsSession, err := c.getSTSSession(awsbaseConfig, credentials.AnonymousCredentials)
+
+	if err != nil {
+		return nil, fmt.Errorf("error creating assume role with web identity session: %w", err)
+	}
+
+	provider := stscreds.NewWebIdentityRoleProviderWithToken(sts.New(stsSession), c.AssumeRoleWithWebIdentityARN, c.AssumeRoleWithWebIdentitySessionName, tokenFetcher)
+
+	if c.AssumeRoleWithWebIdentityDurationSeconds > 0 {
+		provider.Duration = time.Duration(c.AssumeRoleWithWebIdentityDurationSeconds) * time.Second
+	}
+
+	for _, policyARN := range c.AssumeRoleWithWebIdentityPolicyARNs {
+		provider.PolicyArns = append(provider.PolicyArns, &sts.PolicyDescriptorType{
+			Arn: aws.String(policyARN),
+		})
+	}
+
+	creds := credentials.NewCredentials(provider)
+
+	if _, err := creds.Get(); err != nil {
+		return nil, fmt.Errorf("IAM Role (%s) cannot be assumed with web identity: %w", c.AssumeRoleWithWebIdentityARN, err)
+	}
+
+	return creds, nil
+}
+
+// getAssumeRoleCredentials returns validated credentials obtained by assuming
+// the specified IAM Role with the supplied credentials.
+func (c *Config) getAssumeRoleCredentials(awsbaseConfig *awsbase.Config, creds *credentials.Credentials, assumeRole *AssumeRole) (*credentials.Credentials, error) {
+	log.Printf("[INFO] Attempting to AssumeRole %s (SessionName: %q, ExternalId: %q)",
+		assumeRole.RoleARN, assumeRole.SessionName, assumeRole.ExternalID)
+
+	stsSession, err := c.getSTSSession(awsbaseConfig, creds)
+
+	if err != nil {
+		return nil, fmt.Errorf("error creating assume role session: %w", err)
+	}
+
+	provider := &stscreds.AssumeRoleProvider{
+		Client:  sts.New(stsSession),
+		RoleARN: assumeRole.RoleARN,
+	}
+
+	if assumeRole.DurationSeconds > 0 {
+		provider.Duration = time.Duration(assumeRole.DurationSeconds) * time.Second
+	}
+
+	if assumeRole.ExternalID != "" {
+		provider.ExternalID = aws.String(assumeRole.ExternalID)
+	}
+
+	if assumeRole.Policy != "" {
+		provider.Policy = aws.String(assumeRole.Policy)
+	}
+
+	for _, policyARN := range assumeRole.PolicyARNs {
+		provider.PolicyArns = append(provider.PolicyArns, &sts.PolicyDescriptorType{
+			Arn: aws.String(policyARN),
+		})
+	}
+
+	if assumeRole.SessionName != "" {
+		provider.RoleSessionName = assumeRole.SessionName
+	}
+
+	for k, v := range assumeRole.Tags {
+		provider.Tags = append(provider.Tags, &sts.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		})
+	}
+
+	if len(assumeRole.TransitiveTagKeys) > 0 {
+		provider.TransitiveTagKeys = aws.StringSlice(assumeRole.TransitiveTagKeys)
+	}
+
+	assumeRoleCreds := credentials.NewCredentials(provider)
+
+	if _, err := assumeRoleCreds.Get(); err != nil {
+		// Report the role of this hop in the error.
+		hopConfig := *awsbaseConfig
+		hopConfig.AssumeRoleARN = assumeRole.RoleARN
+
+		return nil, hopConfig.NewCannotAssumeRoleError(err)
+	}
+
+	return assumeRoleCreds, nil
+}
+
+// getSTSSession returns an AWS Go SDK session for STS credential provider calls.
+func (c *Config) getSTSSession(awsbaseConfig *awsbase.Config, creds *credentials.Credentials) (*session.Session, error) {
+	httpClient, err := c.getHTTPClient()
+
+	if err != nil {
+		return nil, err
+	}
+
+	wrapHTTPClientTransport(httpClient)
+
+	config := &aws.Config{
+		CredentialsChainVerboseErrors: aws.Bool(true),
+		Credentials:                   creds,
+		EndpointResolver:              c.endpointResolver(awsbaseConfig),
+		Region:                        aws.String(c.Region),
+		MaxRetries:                    aws.Int(c.MaxRetries),
+		HTTPClient:                    httpClient,
+	}
+
+	if awsbaseConfig.DebugLogging {
+		config.LogLevel = aws.LogLevel(aws.LogDebugWithHTTPBody | aws.LogDebugWithRequestRetries | aws.LogDebugWithRequestErrors)
+		config.Logger = awsbase.DebugLogger{}
+	}
+
+	return session.NewSession(config)
+}
+
+// getHTTPClient returns an HTTP client configured with the provider's TLS and proxy settings.
+func (c *Config) getHTTPClient() (*http.Client, error) {
+	client := cleanhttp.DefaultClient()
+	transport := client.Transport.(*http.Transport)
+
+	if c.Insecure || c.CustomCABundle != "" {
+		transport.TLSClientConfig = &tls.Config{
+			InsecureSkipVerify: c.Insecure,
+		}
+	}
+
+	if c.CustomCABundle != "" {
+		pool, err := loadCustomCABundle(c.CustomCABundle)
+
+		if err != nil {
+			return nil, err
+		}
+
+		transport.TLSClientConfig.RootCAs = pool
+	}
+
+	if c.HTTPProxy != "" {
+		if _, err := url.Parse(c.HTTPProxy); err != nil {
+			return nil, fmt.Errorf("error parsing HTTP proxy URL: %w", err)
+		}
+
+		proxyFunc := (&httpproxy.Config{
+			HTTPProxy:  c.HTTPProxy,
+			HTTPSProxy: c.HTTPProxy,
+			NoProxy:    c.NoProxy,
+		}).ProxyFunc()
+
+		transport.Proxy = func(r *http.Request) (*url.URL, error) {
+			return proxyFunc(r.URL)
+		}
+	}
+
+	return client, nil
+}
+
+// wrapHTTPClientTransport wraps the client's transport with httpTransportWrapper, if set.
+func wrapHTTPClientTransport(client *http.Client) {
+	if httpTransportWrapper != nil {
+		client.Transport = httpTransportWrapper(client.Transport)
+	}
+}
+
+// loadCustomCABundle returns a certificate pool containing the certificates of a
+// PEM-encoded CA bundle, which is supplied either inline or as the path to a file.
+func loadCustomCABundle(bundle string) (*x509.CertPool, error) {
+	var pemCerts []byte
+
+	if strings.Contains(bundle, "-----BEGIN") {
+		pemCerts = []byte(bundle)
+	} else {
+		filename, err := homedir.Expand(bundle)
+
+		if err != nil {
+			return nil, fmt.Errorf("error expanding custom CA bundle filename: %w", err)
+		}
+
+		pemCerts, err = os.ReadFile(filename)
+
+		if err != nil {
+			return nil, fmt.Errorf("error reading custom CA bundle: %w", err)
+		}
+	}
+
+	pool := x509.NewCertPool()
+
+	if !pool.AppendCertsFromPEM(pemCerts) {
+		return nil, fmt.Errorf("error loading custom CA bundle: no valid PEM-encoded certificates found")
+	}
+
+	return pool, nil
+}
+
+// webIdentityToken is a stscreds.TokenFetcher for a web identity token supplied inline.
+type webIdentityToken string
+
+func (t webIdentityToken) FetchToken(credentials.Context) ([]byte, error) {
+	return []byte(t), nil
+}
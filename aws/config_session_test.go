@@ -0,0 +1,412 @@
+package aws
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+)
+
+func TestConfigGetCredentials(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+
+	if err := os.WriteFile(tokenFile, []byte(awsbase.MockWebIdentityToken), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		Name                string
+		Config              *Config
+		ExpectedCredentials interface{}
+		ExpectedError       string
+	}{
+		{
+			Name: "web identity token",
+			Config: &Config{
+				AssumeRoleWithWebIdentityARN:         awsbase.MockStsAssumeRoleWithWebIdentityArn,
+				AssumeRoleWithWebIdentitySessionName: awsbase.MockStsAssumeRoleWithWebIdentitySessionName,
+				AssumeRoleWithWebIdentityToken:       awsbase.MockWebIdentityToken,
+			},
+			ExpectedCredentials: awsbase.MockStsAssumeRoleWithWebIdentityCredentials,
+		},
+		{
+			Name: "web identity token file",
+			Config: &Config{
+				AssumeRoleWithWebIdentityARN:         awsbase.MockStsAssumeRoleWithWebIdentityArn,
+				AssumeRoleWithWebIdentitySessionName: awsbase.MockStsAssumeRoleWithWebIdentitySessionName,
+				AssumeRoleWithWebIdentityTokenFile:   tokenFile,
+			},
+			ExpectedCredentials: awsbase.MockStsAssumeRoleWithWebIdentityCredentials,
+		},
+		{
+			Name: "web identity token invalid",
+			Config: &Config{
+				AssumeRoleWithWebIdentityARN:         awsbase.MockStsAssumeRoleWithWebIdentityArn,
+				AssumeRoleWithWebIdentitySessionName: awsbase.MockStsAssumeRoleWithWebIdentitySessionName,
+				AssumeRoleWithWebIdentityToken:       "InvalidWebIdentityToken",
+			},
+			ExpectedError: "cannot be assumed with web identity",
+		},
+		{
+			Name: "web identity token then assume role",
+			Config: &Config{
+				AssumeRole: []*AssumeRole{
+					{
+						RoleARN:     awsbase.MockStsAssumeRoleArn,
+						SessionName: awsbase.MockStsAssumeRoleSessionName,
+					},
+				},
+				AssumeRoleWithWebIdentityARN:         awsbase.MockStsAssumeRoleWithWebIdentityArn,
+				AssumeRoleWithWebIdentitySessionName: awsbase.MockStsAssumeRoleWithWebIdentitySessionName,
+				AssumeRoleWithWebIdentityToken:       awsbase.MockWebIdentityToken,
+			},
+			ExpectedCredentials: awsbase.MockStsAssumeRoleCredentials,
+		},
+		{
+			Name: "static credentials then assume role",
+			Config: &Config{
+				AccessKey: awsbase.MockStaticAccessKey,
+				AssumeRole: []*AssumeRole{
+					{
+						RoleARN:     awsbase.MockStsAssumeRoleArn,
+						SessionName: awsbase.MockStsAssumeRoleSessionName,
+					},
+				},
+				SecretKey: awsbase.MockStaticSecretKey,
+			},
+			ExpectedCredentials: awsbase.MockStsAssumeRoleCredentials,
+		},
+		{
+			Name: "static credentials then chained assume role",
+			Config: &Config{
+				AccessKey: awsbase.MockStaticAccessKey,
+				AssumeRole: []*AssumeRole{
+					{
+						RoleARN:     awsbase.MockStsAssumeRoleArn,
+						SessionName: awsbase.MockStsAssumeRoleSessionName,
+					},
+					{
+						RoleARN:     awsbase.MockStsAssumeRoleArn,
+						SessionName: "SecondHopSessionName",
+					},
+				},
+				SecretKey: awsbase.MockStaticSecretKey,
+			},
+			ExpectedCredentials: awsbase.MockStsAssumeRoleCredentials,
+		},
+		{
+			Name: "static credentials then chained assume role invalid",
+			Config: &Config{
+				AccessKey: awsbase.MockStaticAccessKey,
+				AssumeRole: []*AssumeRole{
+					{
+						RoleARN:     awsbase.MockStsAssumeRoleArn,
+						SessionName: awsbase.MockStsAssumeRoleSessionName,
+					},
+					{
+						RoleARN:     awsbase.MockStsAssumeRoleArn,
+						SessionName: "InvalidSessionName",
+					},
+				},
+				SecretKey: awsbase.MockStaticSecretKey,
+			},
+			ExpectedError: "hop 2 of 2",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			ts := awsbase.MockAwsApiServer("STS", []*awsbase.MockEndpoint{
+				awsbase.MockStsAssumeRoleValidEndpoint,
+				awsbase.MockStsAssumeRoleValidEndpointWithOptions(map[string]string{
+					"RoleSessionName": "SecondHopSessionName",
+				}),
+				awsbase.MockStsAssumeRoleWithWebIdentityValidEndpoint,
+			})
+			defer ts.Close()
+
+			testCase.Config.Region = endpoints.UsEast1RegionID
+
+			awsbaseConfig := &awsbase.Config{
+				AccessKey:   testCase.Config.AccessKey,
+				Region:      testCase.Config.Region,
+				SecretKey:   testCase.Config.SecretKey,
+				StsEndpoint: ts.URL,
+			}
+
+			creds, err := testCase.Config.getCredentials(awsbaseConfig)
+
+			if testCase.ExpectedError != "" {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				if !strings.Contains(err.Error(), testCase.ExpectedError) {
+					t.Fatalf("expected error containing %q, got: %s", testCase.ExpectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got, err := creds.Get()
+
+			if err != nil {
+				t.Fatalf("unexpected error getting credentials: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.ExpectedCredentials) {
+				t.Errorf("got credentials %#v, expected %#v", got, testCase.ExpectedCredentials)
+			}
+		})
+	}
+}
+
+func TestConfigGetSessionWithAccountIDAndPartition_WebIdentity(t *testing.T) {
+	ts := awsbase.MockAwsApiServer("STS", []*awsbase.MockEndpoint{
+		awsbase.MockStsAssumeRoleWithWebIdentityValidEndpoint,
+		awsbase.MockStsGetCallerIdentityValidEndpoint,
+	})
+	defer ts.Close()
+
+	config := &Config{
+		AssumeRoleWithWebIdentityARN:         awsbase.MockStsAssumeRoleWithWebIdentityArn,
+		AssumeRoleWithWebIdentitySessionName: awsbase.MockStsAssumeRoleWithWebIdentitySessionName,
+		AssumeRoleWithWebIdentityToken:       awsbase.MockWebIdentityToken,
+		Region:                               endpoints.UsEast1RegionID,
+	}
+
+	awsbaseConfig := &awsbase.Config{
+		Region:      config.Region,
+		StsEndpoint: ts.URL,
+	}
+
+	_, accountID, partition, err := config.getSessionWithAccountIDAndPartition(awsbaseConfig)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := accountID, "666666666666"; got != want {
+		t.Errorf("got account ID %s, expected %s", got, want)
+	}
+
+	if got, want := partition, endpoints.AwsPartitionID; got != want {
+		t.Errorf("got partition %s, expected %s", got, want)
+	}
+}
+
+func TestConfigGetHTTPClient_CustomCABundle(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	caBundle := string(pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: ts.Certificate().Raw,
+	}))
+
+	caBundleFile := filepath.Join(t.TempDir(), "ca-bundle.pem")
+
+	if err := os.WriteFile(caBundleFile, []byte(caBundle), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	invalidCABundleFile := filepath.Join(t.TempDir(), "invalid-ca-bundle.pem")
+
+	if err := os.WriteFile(invalidCABundleFile, []byte("not a certificate"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		Name                 string
+		Config               *Config
+		ExpectedConfigError  bool
+		ExpectedRequestError bool
+	}{
+		{
+			Name:                 "no custom CA bundle",
+			Config:               &Config{},
+			ExpectedRequestError: true,
+		},
+		{
+			Name: "custom CA bundle file",
+			Config: &Config{
+				CustomCABundle: caBundleFile,
+			},
+		},
+		{
+			Name: "custom CA bundle inline",
+			Config: &Config{
+				CustomCABundle: caBundle,
+			},
+		},
+		{
+			Name: "custom CA bundle file missing",
+			Config: &Config{
+				CustomCABundle: filepath.Join(t.TempDir(), "missing.pem"),
+			},
+			ExpectedConfigError: true,
+		},
+		{
+			Name: "custom CA bundle file invalid",
+			Config: &Config{
+				CustomCABundle: invalidCABundleFile,
+			},
+			ExpectedConfigError: true,
+		},
+		{
+			Name: "insecure",
+			Config: &Config{
+				Insecure: true,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			client, err := testCase.Config.getHTTPClient()
+
+			if testCase.ExpectedConfigError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			resp, err := client.Get(ts.URL)
+
+			if testCase.ExpectedRequestError {
+				if err == nil {
+					t.Fatal("expected request error, got none")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected request error: %s", err)
+			}
+
+			resp.Body.Close()
+		})
+	}
+}
+
+func TestConfigGetHTTPClient_NoProxy(t *testing.T) {
+	config := &Config{
+		HTTPProxy: "http://proxy.example.com:3128",
+		NoProxy:   "internal.example.com,.amazonaws.com",
+	}
+
+	client, err := config.getHTTPClient()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	transport := client.Transport.(*http.Transport)
+
+	testCases := []struct {
+		URL           string
+		ExpectedProxy string
+	}{
+		{
+			URL:           "https://ec2.us-west-2.amazonaws.com/",
+			ExpectedProxy: "",
+		},
+		{
+			URL:           "https://internal.example.com/",
+			ExpectedProxy: "",
+		},
+		{
+			URL:           "https://external.example.com/",
+			ExpectedProxy: "http://proxy.example.com:3128",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.URL, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, testCase.URL, nil)
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			proxyURL, err := transport.Proxy(req)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var got string
+			if proxyURL != nil {
+				got = proxyURL.String()
+			}
+
+			if got != testCase.ExpectedProxy {
+				t.Errorf("got proxy %q, expected %q", got, testCase.ExpectedProxy)
+			}
+		})
+	}
+}
+
+type testRoundTripper struct {
+	transport http.RoundTripper
+}
+
+func (t *testRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return t.transport.RoundTrip(req)
+}
+
+func TestWrapHTTPClientTransport(t *testing.T) {
+	oldHTTPTransportWrapper := httpTransportWrapper
+	defer func() { httpTransportWrapper = oldHTTPTransportWrapper }()
+
+	httpTransportWrapper = nil
+
+	client, err := (&Config{}).getHTTPClient()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	transport := client.Transport
+	wrapHTTPClientTransport(client)
+
+	if client.Transport != transport {
+		t.Errorf("transport wrapped without wrapper")
+	}
+
+	httpTransportWrapper = func(transport http.RoundTripper) http.RoundTripper {
+		return &testRoundTripper{transport: transport}
+	}
+
+	wrapHTTPClientTransport(client)
+
+	wrapped, ok := client.Transport.(*testRoundTripper)
+
+	if !ok {
+		t.Fatalf("got transport %T, expected *testRoundTripper", client.Transport)
+	}
+
+	if wrapped.transport != transport {
+		t.Errorf("wrapped transport is not the client transport")
+	}
+}
@@ -0,0 +1,379 @@
+package aws
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sso"
+	"github.com/aws/aws-sdk-go/service/sso/ssoiface"
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+const (
+	// ssoProviderName is the name of the credentials provider for AWS SSO profiles.
+	ssoProviderName = "SSOProvider"
+
+	// ssoCLITimeFormat is the expiration time format of tokens cached by version 1 of the AWS CLI.
+	ssoCLITimeFormat = "2006-01-02T15:04:05UTC"
+)
+
+// ssoCacheDir is the directory in which the AWS CLI caches SSO access tokens.
+var ssoCacheDir = "~/.aws/sso/cache"
+
+// ssoProfile is the AWS SSO (IAM Identity Center) configuration of a shared config file profile.
+type ssoProfile struct {
+	AccountID   string
+	Profile     string
+	Region      string
+	RoleName    string
+	SessionName string
+	StartURL    string
+}
+
+// cacheKey returns the key under which the AWS CLI caches the profile's SSO access token.
+// Tokens for profiles using an sso-session section are cached under the session name,
+// otherwise under the start URL.
+func (p *ssoProfile) cacheKey() string {
+	if p.SessionName != "" {
+		return p.SessionName
+	}
+
+	return p.StartURL
+}
+
+// ssoToken is an SSO access token cached by the AWS CLI.
+type ssoToken struct {
+	AccessToken string `json:"accessToken"`
+	ExpiresAt   string `json:"expiresAt"`
+	Region      string `json:"region,omitempty"`
+	StartURL    string `json:"startUrl,omitempty"`
+}
+
+// ssoCredentialsProvider retrieves AWS credentials for an SSO profile's role by
+// exchanging the SSO access token cached by `aws sso login`.
+type ssoCredentialsProvider struct {
+	credentials.Expiry
+
+	client   ssoiface.SSOAPI
+	profile  *ssoProfile
+	cacheDir string
+}
+
+// Retrieve implements credentials.Provider.
+func (p *ssoCredentialsProvider) Retrieve() (credentials.Value, error) {
+	token, err := loadSSOToken(p.cacheDir, p.profile)
+
+	if err != nil {
+		return credentials.Value{ProviderName: ssoProviderName}, err
+	}
+
+	output, err := p.client.GetRoleCredentials(&sso.GetRoleCredentialsInput{
+		AccessToken: aws.String(token.AccessToken),
+		AccountId:   aws.String(p.profile.AccountID),
+		RoleName:    aws.String(p.profile.RoleName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, sso.ErrCodeUnauthorizedException) {
+		return credentials.Value{ProviderName: ssoProviderName}, fmt.Errorf("the SSO session for profile (%s) is no longer valid, run \"aws sso login --profile %s\" to refresh it: %w", p.profile.Profile, p.profile.Profile, err)
+	}
+
+	if err != nil {
+		return credentials.Value{ProviderName: ssoProviderName}, fmt.Errorf("error getting SSO role credentials for profile (%s): %w", p.profile.Profile, err)
+	}
+
+	if output == nil || output.RoleCredentials == nil {
+		return credentials.Value{ProviderName: ssoProviderName}, fmt.Errorf("error getting SSO role credentials for profile (%s): empty result", p.profile.Profile)
+	}
+
+	p.SetExpiration(time.Unix(0, aws.Int64Value(output.RoleCredentials.Expiration)*int64(time.Millisecond)), 0)
+
+	return credentials.Value{
+		AccessKeyID:     aws.StringValue(output.RoleCredentials.AccessKeyId),
+		SecretAccessKey: aws.StringValue(output.RoleCredentials.SecretAccessKey),
+		SessionToken:    aws.StringValue(output.RoleCredentials.SessionToken),
+		ProviderName:    ssoProviderName,
+	}, nil
+}
+
+// getSSOCredentials returns validated credentials for the configured profile if it is an
+// AWS SSO profile, or nil if it is not. Static and environment credentials, and
+// credentials for the profile in the shared credentials file, take precedence.
+func (c *Config) getSSOCredentials(awsbaseConfig *awsbase.Config) (*credentials.Credentials, error) {
+	if c.AccessKey != "" || os.Getenv("AWS_ACCESS_KEY_ID") != "" || os.Getenv("AWS_ACCESS_KEY") != "" {
+		return nil, nil
+	}
+
+	profileName := c.profileName()
+
+	credsFilenames, err := c.sharedCredentialsFilenames()
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, credsFilename := range credsFilenames {
+		sharedCredentialsProvider := &credentials.SharedCredentialsProvider{Filename: credsFilename, Profile: profileName}
+
+		if _, err := sharedCredentialsProvider.Retrieve(); err == nil {
+			return nil, nil
+		}
+	}
+
+	configFilenames, err := c.sharedConfigFilenames()
+
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := loadSSOProfile(configFilenames, profileName)
+
+	if err != nil || profile == nil {
+		return nil, err
+	}
+
+	log.Printf("[INFO] Attempting to use AWS SSO credentials for profile (%s)", profileName)
+
+	cacheDir, err := homedir.Expand(ssoCacheDir)
+
+	if err != nil {
+		return nil, fmt.Errorf("error expanding SSO cache directory: %w", err)
+	}
+
+	// Fail early, without a network request, if the cached token is missing or expired.
+	if _, err := loadSSOToken(cacheDir, profile); err != nil {
+		return nil, err
+	}
+
+	httpClient, err := c.getHTTPClient()
+
+	if err != nil {
+		return nil, err
+	}
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials:      credentials.AnonymousCredentials,
+		EndpointResolver: c.endpointResolver(awsbaseConfig),
+		HTTPClient:       httpClient,
+		MaxRetries:       aws.Int(c.MaxRetries),
+		Region:           aws.String(profile.Region),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("error creating AWS SSO session: %w", err)
+	}
+
+	creds := credentials.NewCredentials(&ssoCredentialsProvider{
+		client:   sso.New(sess),
+		profile:  profile,
+		cacheDir: cacheDir,
+	})
+
+	if _, err := creds.Get(); err != nil {
+		return nil, err
+	}
+
+	return creds, nil
+}
+
+// profileName returns the name of the shared configuration profile in use.
+func (c *Config) profileName() string {
+	if c.Profile != "" {
+		return c.Profile
+	}
+
+	if v := os.Getenv("AWS_PROFILE"); v != "" {
+		return v
+	}
+
+	if v := os.Getenv("AWS_DEFAULT_PROFILE"); v != "" {
+		return v
+	}
+
+	return "default"
+}
+
+// loadSSOProfile returns the AWS SSO configuration of a profile in the shared config files,
+// or nil if the profile does not exist or is not an SSO profile.
+func loadSSOProfile(filenames []string, profileName string) (*ssoProfile, error) {
+	sections, err := loadSharedConfigSections(filenames)
+
+	if err != nil {
+		return nil, err
+	}
+
+	files := strings.Join(filenames, ", ")
+
+	section, ok := sections["profile "+profileName]
+
+	if !ok && profileName == "default" {
+		section, ok = sections["default"]
+	}
+
+	if !ok {
+		return nil, nil
+	}
+
+	profile := &ssoProfile{
+		AccountID:   section["sso_account_id"],
+		Profile:     profileName,
+		Region:      section["sso_region"],
+		RoleName:    section["sso_role_name"],
+		SessionName: section["sso_session"],
+		StartURL:    section["sso_start_url"],
+	}
+
+	if profile.SessionName != "" {
+		ssoSession, ok := sections["sso-session "+profile.SessionName]
+
+		if !ok {
+			return nil, fmt.Errorf("profile (%s) in %s refers to sso-session (%s), which does not exist", profileName, files, profile.SessionName)
+		}
+
+		profile.Region = ssoSession["sso_region"]
+		profile.StartURL = ssoSession["sso_start_url"]
+	}
+
+	if profile.StartURL == "" && profile.AccountID == "" && profile.RoleName == "" {
+		return nil, nil
+	}
+
+	var missing []string
+
+	for _, v := range []struct {
+		key   string
+		value string
+	}{
+		{"sso_account_id", profile.AccountID},
+		{"sso_region", profile.Region},
+		{"sso_role_name", profile.RoleName},
+		{"sso_start_url", profile.StartURL},
+	} {
+		if v.value == "" {
+			missing = append(missing, v.key)
+		}
+	}
+
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("SSO profile (%s) in %s is missing required settings: %s", profileName, files, strings.Join(missing, ", "))
+	}
+
+	return profile, nil
+}
+
+// loadSSOToken returns the unexpired SSO access token cached for a profile by `aws sso login`.
+func loadSSOToken(cacheDir string, profile *ssoProfile) (*ssoToken, error) {
+	hash := sha1.Sum([]byte(profile.cacheKey()))
+	filename := filepath.Join(cacheDir, hex.EncodeToString(hash[:])+".json")
+
+	b, err := os.ReadFile(filename)
+
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("no cached SSO token found for profile (%s), run \"aws sso login --profile %s\" to log in", profile.Profile, profile.Profile)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading cached SSO token (%s): %w", filename, err)
+	}
+
+	token := &ssoToken{}
+
+	if err := json.Unmarshal(b, token); err != nil {
+		return nil, fmt.Errorf("error reading cached SSO token (%s): %w", filename, err)
+	}
+
+	if token.AccessToken == "" {
+		return nil, fmt.Errorf("cached SSO token (%s) for profile (%s) has no access token, run \"aws sso login --profile %s\" to log in", filename, profile.Profile, profile.Profile)
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339, token.ExpiresAt)
+
+	if err != nil {
+		expiresAt, err = time.Parse(ssoCLITimeFormat, token.ExpiresAt)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("error parsing cached SSO token (%s) expiration time (%s): %w", filename, token.ExpiresAt, err)
+	}
+
+	if !time.Now().Before(expiresAt) {
+		return nil, fmt.Errorf("the SSO session for profile (%s) expired at %s, run \"aws sso login --profile %s\" to refresh it", profile.Profile, expiresAt.Format(time.RFC3339), profile.Profile)
+	}
+
+	return token, nil
+}
+
+// loadSharedConfigSections returns the key-value pairs of each section of the AWS shared config files.
+// Files which do not exist are skipped and values in later files override those in earlier files.
+func loadSharedConfigSections(filenames []string) (map[string]map[string]string, error) {
+	sections := make(map[string]map[string]string)
+
+	for _, filename := range filenames {
+		if err := loadSharedConfigFileSections(filename, sections); err != nil {
+			return nil, err
+		}
+	}
+
+	return sections, nil
+}
+
+// loadSharedConfigFileSections adds the key-value pairs of each section of an AWS shared config file to sections.
+func loadSharedConfigFileSections(filename string, sections map[string]map[string]string) error {
+	f, err := os.Open(filename)
+
+	if os.IsNotExist(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading shared config file (%s): %w", filename, err)
+	}
+
+	defer f.Close()
+
+	var section map[string]string
+
+	scanner := bufio.NewScanner(f)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		switch {
+		case line == "", strings.HasPrefix(line, "#"), strings.HasPrefix(line, ";"):
+			continue
+		case strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]"):
+			name := strings.Join(strings.Fields(line[1:len(line)-1]), " ")
+
+			if section = sections[name]; section == nil {
+				section = make(map[string]string)
+				sections[name] = section
+			}
+		case section != nil:
+			parts := strings.SplitN(line, "=", 2)
+
+			if len(parts) != 2 {
+				continue
+			}
+
+			section[strings.TrimSpace(parts[0])] = strings.TrimSpace(parts[1])
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("error reading shared config file (%s): %w", filename, err)
+	}
+
+	return nil
+}
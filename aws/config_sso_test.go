@@ -0,0 +1,349 @@
+package aws
+
+import (
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/service/sso"
+	"github.com/aws/aws-sdk-go/service/sso/ssoiface"
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+)
+
+const (
+	testSSOConfigFile = "testdata/sso/config"
+	testSSOCacheDir   = "testdata/sso/cache"
+)
+
+// mockSSOClient returns role credentials for a single valid access token.
+type mockSSOClient struct {
+	ssoiface.SSOAPI
+
+	accessToken string
+	input       *sso.GetRoleCredentialsInput
+}
+
+func (m *mockSSOClient) GetRoleCredentials(input *sso.GetRoleCredentialsInput) (*sso.GetRoleCredentialsOutput, error) {
+	m.input = input
+
+	if aws.StringValue(input.AccessToken) != m.accessToken {
+		return nil, awserr.New(sso.ErrCodeUnauthorizedException, "Session token not found or invalid", nil)
+	}
+
+	return &sso.GetRoleCredentialsOutput{
+		RoleCredentials: &sso.RoleCredentials{
+			AccessKeyId:     aws.String("SSOACCESSKEY"),
+			Expiration:      aws.Int64(32503680000000),
+			SecretAccessKey: aws.String("SSOSECRETKEY"),
+			SessionToken:    aws.String("SSOSESSIONTOKEN"),
+		},
+	}, nil
+}
+
+func TestLoadSSOProfile(t *testing.T) {
+	testCases := []struct {
+		Profile         string
+		ExpectedProfile *ssoProfile
+		ExpectedError   string
+	}{
+		{
+			Profile: "default",
+		},
+		{
+			Profile: "does-not-exist",
+		},
+		{
+			Profile: "sso-legacy",
+			ExpectedProfile: &ssoProfile{
+				AccountID: "111111111111",
+				Profile:   "sso-legacy",
+				Region:    "us-east-1",
+				RoleName:  "ReadOnly",
+				StartURL:  "https://legacy.awsapps.com/start",
+			},
+		},
+		{
+			Profile: "sso-session",
+			ExpectedProfile: &ssoProfile{
+				AccountID:   "222222222222",
+				Profile:     "sso-session",
+				Region:      "eu-west-1",
+				RoleName:    "Admin",
+				SessionName: "my-sso",
+				StartURL:    "https://session.awsapps.com/start",
+			},
+		},
+		{
+			Profile:       "sso-incomplete",
+			ExpectedError: "missing required settings: sso_region, sso_role_name",
+		},
+		{
+			Profile:       "sso-missing-session",
+			ExpectedError: "refers to sso-session (does-not-exist), which does not exist",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Profile, func(t *testing.T) {
+			profile, err := loadSSOProfile([]string{testSSOConfigFile}, testCase.Profile)
+
+			if testCase.ExpectedError != "" {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				if !strings.Contains(err.Error(), testCase.ExpectedError) {
+					t.Fatalf("expected error containing %q, got: %s", testCase.ExpectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(profile, testCase.ExpectedProfile) {
+				t.Errorf("got profile %#v, expected %#v", profile, testCase.ExpectedProfile)
+			}
+		})
+	}
+}
+
+func TestLoadSSOProfile_ConfigFileMissing(t *testing.T) {
+	profile, err := loadSSOProfile([]string{filepath.Join(t.TempDir(), "config")}, "sso-legacy")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if profile != nil {
+		t.Errorf("got profile %#v, expected none", profile)
+	}
+}
+
+func TestLoadSSOToken(t *testing.T) {
+	testCases := []struct {
+		Profile             string
+		ExpectedAccessToken string
+		ExpectedError       string
+	}{
+		{
+			Profile:             "sso-legacy",
+			ExpectedAccessToken: "legacy-access-token",
+		},
+		{
+			Profile:             "sso-session",
+			ExpectedAccessToken: "session-access-token",
+		},
+		{
+			Profile:       "sso-expired",
+			ExpectedError: `expired at 2000-01-01T00:00:00Z, run "aws sso login --profile sso-expired" to refresh it`,
+		},
+		{
+			Profile:       "sso-not-logged-in",
+			ExpectedError: `no cached SSO token found for profile (sso-not-logged-in), run "aws sso login --profile sso-not-logged-in"`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Profile, func(t *testing.T) {
+			profile, err := loadSSOProfile([]string{testSSOConfigFile}, testCase.Profile)
+
+			if err != nil {
+				t.Fatalf("unexpected error loading profile: %s", err)
+			}
+
+			token, err := loadSSOToken(testSSOCacheDir, profile)
+
+			if testCase.ExpectedError != "" {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				if !strings.Contains(err.Error(), testCase.ExpectedError) {
+					t.Fatalf("expected error containing %q, got: %s", testCase.ExpectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := token.AccessToken, testCase.ExpectedAccessToken; got != want {
+				t.Errorf("got access token %q, expected %q", got, want)
+			}
+		})
+	}
+}
+
+func TestSSOCredentialsProviderRetrieve(t *testing.T) {
+	testCases := []struct {
+		Name                string
+		Profile             string
+		ValidAccessToken    string
+		ExpectedCredentials credentials.Value
+		ExpectedError       string
+	}{
+		{
+			Name:             "valid token",
+			Profile:          "sso-session",
+			ValidAccessToken: "session-access-token",
+			ExpectedCredentials: credentials.Value{
+				AccessKeyID:     "SSOACCESSKEY",
+				SecretAccessKey: "SSOSECRETKEY",
+				SessionToken:    "SSOSESSIONTOKEN",
+				ProviderName:    ssoProviderName,
+			},
+		},
+		{
+			Name:             "revoked token",
+			Profile:          "sso-legacy",
+			ValidAccessToken: "another-access-token",
+			ExpectedError:    `run "aws sso login --profile sso-legacy" to refresh it`,
+		},
+		{
+			Name:          "expired token",
+			Profile:       "sso-expired",
+			ExpectedError: "expired at",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			profile, err := loadSSOProfile([]string{testSSOConfigFile}, testCase.Profile)
+
+			if err != nil {
+				t.Fatalf("unexpected error loading profile: %s", err)
+			}
+
+			client := &mockSSOClient{accessToken: testCase.ValidAccessToken}
+
+			creds := credentials.NewCredentials(&ssoCredentialsProvider{
+				client:   client,
+				profile:  profile,
+				cacheDir: testSSOCacheDir,
+			})
+
+			got, err := creds.Get()
+
+			if testCase.ExpectedError != "" {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				if !strings.Contains(err.Error(), testCase.ExpectedError) {
+					t.Fatalf("expected error containing %q, got: %s", testCase.ExpectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.ExpectedCredentials) {
+				t.Errorf("got credentials %#v, expected %#v", got, testCase.ExpectedCredentials)
+			}
+
+			if got, want := aws.StringValue(client.input.AccountId), profile.AccountID; got != want {
+				t.Errorf("got account ID %q, expected %q", got, want)
+			}
+
+			if got, want := aws.StringValue(client.input.RoleName), profile.RoleName; got != want {
+				t.Errorf("got role name %q, expected %q", got, want)
+			}
+
+			if creds.IsExpired() {
+				t.Error("expected credentials not to be expired")
+			}
+		})
+	}
+}
+
+func TestConfigGetSSOCredentials(t *testing.T) {
+	for _, k := range []string{"AWS_ACCESS_KEY_ID", "AWS_ACCESS_KEY", "AWS_PROFILE", "AWS_DEFAULT_PROFILE"} {
+		t.Setenv(k, "")
+	}
+
+	t.Setenv("AWS_CONFIG_FILE", testSSOConfigFile)
+
+	defer func(v string) { ssoCacheDir = v }(ssoCacheDir)
+	ssoCacheDir = testSSOCacheDir
+
+	missingFile := filepath.Join(t.TempDir(), "missing")
+
+	testCases := []struct {
+		Name          string
+		Config        *Config
+		ExpectedError string
+	}{
+		{
+			Name: "static credentials take precedence",
+			Config: &Config{
+				AccessKey:     awsbase.MockStaticAccessKey,
+				CredsFilename: missingFile,
+				Profile:       "sso-expired",
+				SecretKey:     awsbase.MockStaticSecretKey,
+			},
+		},
+		{
+			Name: "not an SSO profile",
+			Config: &Config{
+				CredsFilename: missingFile,
+				Profile:       "default",
+			},
+		},
+		{
+			Name: "expired token",
+			Config: &Config{
+				CredsFilename: missingFile,
+				Profile:       "sso-expired",
+			},
+			ExpectedError: `run "aws sso login --profile sso-expired" to refresh it`,
+		},
+		{
+			Name: "not logged in",
+			Config: &Config{
+				CredsFilename: missingFile,
+				Profile:       "sso-not-logged-in",
+			},
+			ExpectedError: `run "aws sso login --profile sso-not-logged-in" to log in`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			testCase.Config.Region = "us-east-1"
+
+			creds, err := testCase.Config.getSSOCredentials(&awsbase.Config{Region: testCase.Config.Region})
+
+			if testCase.ExpectedError != "" {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				if !strings.Contains(err.Error(), testCase.ExpectedError) {
+					t.Fatalf("expected error containing %q, got: %s", testCase.ExpectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if creds != nil {
+				t.Errorf("got credentials, expected none")
+			}
+		})
+	}
+}
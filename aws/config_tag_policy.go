@@ -0,0 +1,56 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	organizationsfinder "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/organizations/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// tagPolicyCompliance checks resource tags against the AWS Organizations tag policy in effect
+// for the provider's account. The policy is fetched once per provider process.
+type tagPolicyCompliance struct {
+	// EnforcementLevel is the severity of violations, keyvaluetags.RequiredEnforcementLevelError or keyvaluetags.RequiredEnforcementLevelWarn.
+	EnforcementLevel string
+
+	once   sync.Once
+	policy *keyvaluetags.TagPolicy
+	err    error
+}
+
+// effectiveTagPolicy returns the effective tag policy, or nil if no tag policy applies to the account.
+func (c *tagPolicyCompliance) effectiveTagPolicy(conn *organizations.Organizations) (*keyvaluetags.TagPolicy, error) {
+	c.once.Do(func() {
+		c.policy, c.err = findEffectiveTagPolicy(conn)
+	})
+
+	return c.policy, c.err
+}
+
+func findEffectiveTagPolicy(conn *organizations.Organizations) (*keyvaluetags.TagPolicy, error) {
+	effectivePolicy, err := organizationsfinder.EffectivePolicyByType(conn, organizations.EffectivePolicyTypeTagPolicy)
+
+	if tfresource.NotFound(err) {
+		log.Printf("[DEBUG] No AWS Organizations tag policy in effect: %s", err)
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading AWS Organizations effective tag policy: %w", err)
+	}
+
+	policy, err := keyvaluetags.ParseTagPolicy(aws.StringValue(effectivePolicy.PolicyContent))
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading AWS Organizations effective tag policy: %w", err)
+	}
+
+	log.Printf("[DEBUG] Using AWS Organizations effective tag policy (last updated %s)", aws.TimeValue(effectivePolicy.LastUpdatedTimestamp))
+
+	return policy, nil
+}
@@ -1,11 +1,18 @@
 package aws
 
 import (
+	"bytes"
 	"reflect"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	awsclient "github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	awsbase "github.com/hashicorp/aws-sdk-go-base"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/auditlog"
 )
 
 func TestAWSClientPartitionHostname(t *testing.T) {
@@ -82,6 +89,121 @@ func TestAWSClientRegionalHostname(t *testing.T) {
 	}
 }
 
+func TestAWSClientServiceConn(t *testing.T) {
+	sess, err := session.NewSession(&aws.Config{Region: aws.String(endpoints.UsWest2RegionID)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := &AWSClient{
+		endpoints: map[string]string{
+			"apigateway": "http://localhost:4566",
+		},
+		partition:        endpoints.AwsPartitionID,
+		region:           endpoints.UsWest2RegionID,
+		s3ForcePathStyle: true,
+		session:          sess,
+	}
+
+	if len(client.conns) != 0 {
+		t.Fatalf("expected no service clients before first use, got %d", len(client.conns))
+	}
+
+	if got, want := client.ec2conn(), client.ec2conn(); got != want {
+		t.Errorf("expected cached EC2 client to be reused")
+	}
+
+	if got, want := len(client.conns), 1; got != want {
+		t.Errorf("got %d service clients, expected %d", got, want)
+	}
+
+	if got, want := client.apigatewayv2conn().Endpoint, "http://localhost:4566"; got != want {
+		t.Errorf("got API Gateway V2 endpoint %s, expected %s", got, want)
+	}
+
+	if got, want := aws.StringValue(client.r53conn().Config.Region), endpoints.UsEast1RegionID; got != want {
+		t.Errorf("got Route 53 region %s, expected %s", got, want)
+	}
+
+	if !aws.BoolValue(client.s3conn().Config.S3ForcePathStyle) {
+		t.Errorf("expected S3 client to force path style")
+	}
+
+	if client.s3conn() == client.s3connUriCleaningDisabled() {
+		t.Errorf("expected separate S3 client with URI cleaning disabled")
+	}
+
+	if !aws.BoolValue(client.s3connUriCleaningDisabled().Config.DisableRestProtocolURICleaning) {
+		t.Errorf("expected S3 client to disable REST protocol URI cleaning")
+	}
+}
+
+func TestAWSClientServiceConn_ServiceRetry(t *testing.T) {
+	sess, err := session.NewSession(&aws.Config{
+		MaxRetries: aws.Int(25),
+		Region:     aws.String(endpoints.UsWest2RegionID),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := &AWSClient{
+		partition: endpoints.AwsPartitionID,
+		region:    endpoints.UsWest2RegionID,
+		serviceRetries: map[string]*ServiceRetry{
+			"cloudformation": {
+				MaxRetries: 50,
+			},
+			"organizations": {
+				MaxBackoff: time.Minute,
+			},
+		},
+		session: sess,
+	}
+
+	if got, want := client.ec2conn().MaxRetries(), 25; got != want {
+		t.Errorf("got EC2 max retries %d, expected %d", got, want)
+	}
+
+	if got, want := client.cfconn().MaxRetries(), 50; got != want {
+		t.Errorf("got CloudFormation max retries %d, expected %d", got, want)
+	}
+
+	retryer, ok := client.organizationsconn().Retryer.(awsclient.DefaultRetryer)
+
+	if !ok {
+		t.Fatalf("got Organizations retryer %T, expected client.DefaultRetryer", client.organizationsconn().Retryer)
+	}
+
+	if got, want := retryer.MaxRetries(), 25; got != want {
+		t.Errorf("got Organizations max retries %d, expected %d", got, want)
+	}
+
+	if got, want := retryer.MaxRetryDelay, time.Minute; got != want {
+		t.Errorf("got Organizations max retry delay %s, expected %s", got, want)
+	}
+}
+
+func TestAWSClientServiceConn_AuditLog(t *testing.T) {
+	sess, err := session.NewSession(&aws.Config{Region: aws.String(endpoints.UsWest2RegionID)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+
+	client := &AWSClient{
+		auditLog:  auditlog.New(&buf, auditlog.DefaultRedactionRules),
+		partition: endpoints.AwsPartitionID,
+		region:    endpoints.UsWest2RegionID,
+		session:   sess,
+	}
+
+	if got, want := client.ec2conn().Handlers.Complete.Len(), sess.Handlers.Complete.Len()+1; got != want {
+		t.Errorf("got %d EC2 complete handlers, expected %d", got, want)
+	}
+}
+
 func TestGetSupportedEC2Platforms(t *testing.T) {
 	ec2Endpoints := []*awsbase.MockEndpoint{
 		{
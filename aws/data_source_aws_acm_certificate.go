@@ -63,7 +63,7 @@ func dataSourceAwsAcmCertificate() *schema.Resource {
 }
 
 func dataSourceAwsAcmCertificateRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).acmconn
+	conn := meta.(*AWSClient).acmconn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	params := &acm.ListCertificatesInput{}
@@ -73,7 +73,7 @@ func dataSourceAwsAppmeshMesh() *schema.Resource {
 }
 
 func dataSourceAwsAppmeshMeshRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appmeshconn
+	conn := meta.(*AWSClient).appmeshconn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	meshName := d.Get("name").(string)
@@ -68,7 +68,7 @@ func dataSourceAwsBatchJobQueue() *schema.Resource {
 }
 
 func dataSourceAwsBatchJobQueueRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).batchconn
+	conn := meta.(*AWSClient).batchconn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	params := &batch.DescribeJobQueuesInput{
@@ -43,7 +43,7 @@ func dataSourceAwsCloudControlApiResource() *schema.Resource {
 }
 
 func dataSourceAwsCloudControlApiResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).cloudcontrolapiconn
+	conn := meta.(*AWSClient).cloudcontrolapiconn()
 
 	identifier := d.Get("identifier").(string)
 	resourceDescription, err := finder.ResourceByID(ctx, conn,
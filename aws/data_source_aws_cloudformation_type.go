@@ -106,7 +106,7 @@ func dataSourceAwsCloudFormationType() *schema.Resource {
 }
 
 func dataSourceAwsCloudFormationTypeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).cfconn
+	conn := meta.(*AWSClient).cfconn()
 
 	input := &cloudformation.DescribeTypeInput{}
 
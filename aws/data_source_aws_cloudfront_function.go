@@ -66,7 +66,7 @@ func dataSourceAwsCloudFrontFunction() *schema.Resource {
 }
 
 func dataSourceAwsCloudFrontFunctionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudfrontconn
+	conn := meta.(*AWSClient).cloudfrontconn()
 
 	name := d.Get("name").(string)
 	stage := d.Get("stage").(string)
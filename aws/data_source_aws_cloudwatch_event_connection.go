@@ -37,7 +37,7 @@ func dataSourceAwsCloudwatchEventConnection() *schema.Resource {
 func dataSourceAwsCloudwatchEventConnectionRead(d *schema.ResourceData, meta interface{}) error {
 	d.SetId(d.Get("name").(string))
 
-	conn := meta.(*AWSClient).cloudwatcheventsconn
+	conn := meta.(*AWSClient).cloudwatcheventsconn()
 
 	input := &events.DescribeConnectionInput{
 		Name: aws.String(d.Id()),
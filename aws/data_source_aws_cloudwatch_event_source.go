@@ -39,7 +39,7 @@ func dataSourceAwsCloudWatchEventSource() *schema.Resource {
 }
 
 func dataSourceAwsCloudWatchEventSourceRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatcheventsconn
+	conn := meta.(*AWSClient).cloudwatcheventsconn()
 
 	input := &events.ListEventSourcesInput{}
 	if v, ok := d.GetOk("name_prefix"); ok {
@@ -39,7 +39,7 @@ func dataSourceAwsCloudwatchLogGroup() *schema.Resource {
 
 func dataSourceAwsCloudwatchLogGroupRead(d *schema.ResourceData, meta interface{}) error {
 	name := d.Get("name").(string)
-	conn := meta.(*AWSClient).cloudwatchlogsconn
+	conn := meta.(*AWSClient).cloudwatchlogsconn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	logGroup, err := lookupCloudWatchLogGroup(conn, name)
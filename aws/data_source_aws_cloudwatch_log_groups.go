@@ -33,7 +33,7 @@ func dataSourceAwsCloudwatchLogGroups() *schema.Resource {
 }
 
 func dataSourceAwsCloudwatchLogGroupsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatchlogsconn
+	conn := meta.(*AWSClient).cloudwatchlogsconn()
 
 	input := &cloudwatchlogs.DescribeLogGroupsInput{
 		LogGroupNamePrefix: aws.String(d.Get("log_group_name_prefix").(string)),
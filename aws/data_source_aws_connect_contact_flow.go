@@ -54,7 +54,7 @@ func dataSourceAwsConnectContactFlow() *schema.Resource {
 }
 
 func dataSourceAwsConnectContactFlowRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).connectconn
+	conn := meta.(*AWSClient).connectconn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	instanceID := d.Get("instance_id").(string)
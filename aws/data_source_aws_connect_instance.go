@@ -83,7 +83,7 @@ func dataSourceAwsConnectInstance() *schema.Resource {
 }
 
 func dataSourceAwsConnectInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).connectconn
+	conn := meta.(*AWSClient).connectconn()
 
 	var matchedInstance *connect.Instance
 
@@ -66,7 +66,7 @@ func dataSourceAwsCurReportDefinition() *schema.Resource {
 }
 
 func dataSourceAwsCurReportDefinitionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).costandusagereportconn
+	conn := meta.(*AWSClient).costandusagereportconn()
 
 	reportName := d.Get("report_name").(string)
 
@@ -87,7 +87,7 @@ func dataSourceAwsDbProxy() *schema.Resource {
 }
 
 func dataSourceAwsDbProxyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).rdsconn
+	conn := meta.(*AWSClient).rdsconn()
 
 	name := d.Get("name").(string)
 	dbProxy, err := finder.DBProxyByName(conn, name)
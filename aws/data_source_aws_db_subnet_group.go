@@ -44,7 +44,7 @@ func dataSourceAwsDbSubnetGroup() *schema.Resource {
 }
 
 func dataSourceAwsDbSubnetGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).rdsconn
+	conn := meta.(*AWSClient).rdsconn()
 
 	name := d.Get("name").(string)
 
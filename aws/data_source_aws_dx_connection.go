@@ -49,7 +49,7 @@ func dataSourceAwsDxConnection() *schema.Resource {
 }
 
 func dataSourceAwsDxConnectionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).dxconn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	var connections []*directconnect.Connection
@@ -40,7 +40,7 @@ func dataSourceAwsDxLocation() *schema.Resource {
 }
 
 func dataSourceAwsDxLocationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).dxconn()
 	locationCode := d.Get("location_code").(string)
 
 	location, err := finder.LocationByCode(conn, locationCode)
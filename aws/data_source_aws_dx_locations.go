@@ -24,7 +24,7 @@ func dataSourceAwsDxLocations() *schema.Resource {
 }
 
 func dataSourceAwsDxLocationsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).dxconn()
 
 	locations, err := finder.Locations(conn, &directconnect.DescribeLocationsInput{})
 
@@ -28,7 +28,7 @@ func TestAccDataSourceAwsEBSDefaultKmsKey_basic(t *testing.T) {
 
 func testAccCheckDataSourceAwsEBSDefaultKmsKey(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
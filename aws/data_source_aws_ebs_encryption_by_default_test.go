@@ -29,7 +29,7 @@ func TestAccDataSourceAwsEBSEncryptionByDefault_basic(t *testing.T) {
 
 func testAccCheckDataSourceAwsEBSEncryptionByDefault(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
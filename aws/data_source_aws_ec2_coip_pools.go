@@ -28,7 +28,7 @@ func dataSourceAwsEc2CoipPools() *schema.Resource {
 }
 
 func dataSourceAwsEc2CoipPoolsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	req := &ec2.DescribeCoipPoolsInput{}
 
@@ -40,7 +40,7 @@ func dataSourceAwsEc2InstanceTypeOfferings() *schema.Resource {
 }
 
 func dataSourceAwsEc2InstanceTypeOfferingsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	input := &ec2.DescribeInstanceTypeOfferingsInput{}
 
@@ -88,7 +88,7 @@ func testAccCheckEc2InstanceTypeOfferingsLocations(dataSourceName string) resour
 }
 
 func testAccPreCheckAWSEc2InstanceTypeOfferings(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	input := &ec2.DescribeInstanceTypeOfferingsInput{
 		MaxResults: aws.Int64(5),
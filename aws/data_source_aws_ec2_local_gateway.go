@@ -45,7 +45,7 @@ func dataSourceAwsEc2LocalGateway() *schema.Resource {
 }
 
 func dataSourceAwsEc2LocalGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	req := &ec2.DescribeLocalGatewaysInput{}
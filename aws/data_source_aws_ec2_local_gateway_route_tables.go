@@ -28,7 +28,7 @@ func dataSourceAwsEc2LocalGatewayRouteTables() *schema.Resource {
 }
 
 func dataSourceAwsEc2LocalGatewayRouteTablesRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	req := &ec2.DescribeLocalGatewayRouteTablesInput{}
 
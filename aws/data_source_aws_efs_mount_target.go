@@ -75,7 +75,7 @@ func dataSourceAwsEfsMountTarget() *schema.Resource {
 }
 
 func dataSourceAwsEfsMountTargetRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).efsconn
+	conn := meta.(*AWSClient).efsconn()
 
 	input := &efs.DescribeMountTargetsInput{}
 
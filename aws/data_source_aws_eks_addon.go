@@ -54,7 +54,7 @@ func dataSourceAwsEksAddon() *schema.Resource {
 }
 
 func dataSourceAwsEksAddonRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).eksconn
+	conn := meta.(*AWSClient).eksconn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	addonName := d.Get("addon_name").(string)
@@ -23,7 +23,7 @@ func dataSourceAwsEksClusters() *schema.Resource {
 }
 
 func dataSourceAwsEksClustersRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).eksconn
+	conn := meta.(*AWSClient).eksconn()
 
 	var clusters []*string
 
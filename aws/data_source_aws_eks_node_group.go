@@ -137,7 +137,7 @@ func dataSourceAwsEksNodeGroup() *schema.Resource {
 }
 
 func dataSourceAwsEksNodeGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).eksconn
+	conn := meta.(*AWSClient).eksconn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	clusterName := d.Get("cluster_name").(string)
@@ -29,7 +29,7 @@ func dataSourceAwsEksNodeGroups() *schema.Resource {
 }
 
 func dataSourceAwsEksNodeGroupsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).eksconn
+	conn := meta.(*AWSClient).eksconn()
 
 	clusterName := d.Get("cluster_name").(string)
 
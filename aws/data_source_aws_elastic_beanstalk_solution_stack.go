@@ -37,7 +37,7 @@ func dataSourceAwsElasticBeanstalkSolutionStack() *schema.Resource {
 
 // dataSourceAwsElasticBeanstalkSolutionStackRead performs the API lookup.
 func dataSourceAwsElasticBeanstalkSolutionStackRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elasticbeanstalkconn
+	conn := meta.(*AWSClient).elasticbeanstalkconn()
 
 	nameRegex := d.Get("name_regex")
 
@@ -46,7 +46,7 @@ func dataSourceAwsElastiCacheUser() *schema.Resource {
 }
 
 func dataSourceAwsElastiCacheUserRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elasticacheconn
+	conn := meta.(*AWSClient).elasticacheconn()
 
 	params := &elasticache.DescribeUsersInput{
 		UserId: aws.String(d.Get("user_id").(string)),
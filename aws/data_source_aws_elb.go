@@ -197,7 +197,7 @@ func dataSourceAwsElb() *schema.Resource {
 }
 
 func dataSourceAwsElbRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elbconn
+	conn := meta.(*AWSClient).elbconn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	lbName := d.Get("name").(string)
@@ -226,7 +226,7 @@ func dataSourceAwsElbRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("arn", arn.String())
 
 	lb := resp.LoadBalancerDescriptions[0]
-	ec2conn := meta.(*AWSClient).ec2conn
+	ec2conn := meta.(*AWSClient).ec2conn()
 
 	describeAttrsOpts := &elb.DescribeLoadBalancerAttributesInput{
 		LoadBalancerName: aws.String(d.Id()),
@@ -85,7 +85,7 @@ func dataSourceAwsGlobalAcceleratorAccelerator() *schema.Resource {
 }
 
 func dataSourceAwsGlobalAcceleratorAcceleratorRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).globalacceleratorconn
+	conn := meta.(*AWSClient).globalacceleratorconn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	var results []*globalaccelerator.Accelerator
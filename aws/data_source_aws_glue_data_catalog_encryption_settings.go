@@ -62,7 +62,7 @@ func dataSourceAwsGlueDataCatalogEncryptionSettings() *schema.Resource {
 }
 
 func dataSourceAwsGlueDataCatalogEncryptionSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).glueconn
+	conn := meta.(*AWSClient).glueconn()
 	id := d.Get("catalog_id").(string)
 	input := &glue.GetDataCatalogEncryptionSettingsInput{
 		CatalogId: aws.String(id),
@@ -35,7 +35,7 @@ func dataSourceAwsGuarddutyDetector() *schema.Resource {
 }
 
 func dataSourceAwsGuarddutyDetectorRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).guarddutyconn
+	conn := meta.(*AWSClient).guarddutyconn()
 
 	detectorId := d.Get("id").(string)
 
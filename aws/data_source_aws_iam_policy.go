@@ -58,7 +58,7 @@ func dataSourceAwsIAMPolicy() *schema.Resource {
 }
 
 func dataSourceAwsIAMPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).iamconn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	arn := d.Get("arn").(string)
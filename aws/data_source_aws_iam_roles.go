@@ -39,7 +39,7 @@ func dataSourceAwsIAMRoles() *schema.Resource {
 }
 
 func dataSourceAwsIAMRolesRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).iamconn()
 
 	input := &iam.ListRolesInput{}
 
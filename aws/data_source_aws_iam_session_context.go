@@ -45,7 +45,7 @@ func dataSourceAwsIAMSessionContext() *schema.Resource {
 }
 
 func dataSourceAwsIAMSessionContextRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).iamconn()
 
 	arn := d.Get("arn").(string)
 
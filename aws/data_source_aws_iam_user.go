@@ -41,7 +41,7 @@ func dataSourceAwsIAMUser() *schema.Resource {
 }
 
 func dataSourceAwsIAMUserRead(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).iamconn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	userName := d.Get("user_name").(string)
@@ -37,7 +37,7 @@ func dataSourceAwsIAMUsers() *schema.Resource {
 }
 
 func dataSourceAwsIAMUsersRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).iamconn()
 
 	nameRegex := d.Get("name_regex").(string)
 	pathPrefix := d.Get("path_prefix").(string)
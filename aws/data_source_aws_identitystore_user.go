@@ -60,7 +60,7 @@ func dataSourceAwsIdentityStoreUser() *schema.Resource {
 }
 
 func dataSourceAwsIdentityStoreUserRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).identitystoreconn
+	conn := meta.(*AWSClient).identitystoreconn()
 
 	input := &identitystore.ListUsersInput{
 		IdentityStoreId: aws.String(d.Get("identity_store_id").(string)),
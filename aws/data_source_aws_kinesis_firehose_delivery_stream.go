@@ -25,7 +25,7 @@ func dataSourceAwsKinesisFirehoseDeliveryStream() *schema.Resource {
 }
 
 func dataSourceAwsKinesisFirehoseDeliveryStreamRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).firehoseconn
+	conn := meta.(*AWSClient).firehoseconn()
 
 	sn := d.Get("name").(string)
 	output, err := finder.DeliveryStreamByName(conn, sn)
@@ -47,7 +47,7 @@ func dataSourceAwsKinesisStreamConsumer() *schema.Resource {
 }
 
 func dataSourceAwsKinesisStreamConsumerRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kinesisconn
+	conn := meta.(*AWSClient).kinesisconn()
 
 	streamArn := d.Get("stream_arn").(string)
 
@@ -54,7 +54,7 @@ func dataSourceAwsKmsPublicKey() *schema.Resource {
 }
 
 func dataSourceAwsKmsPublicKeyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kmsconn
+	conn := meta.(*AWSClient).kmsconn()
 	keyId := d.Get("key_id").(string)
 
 	input := &kms.GetPublicKeyInput{
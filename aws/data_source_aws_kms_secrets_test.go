@@ -39,7 +39,7 @@ func TestAccAWSKmsSecretsDataSource_basic(t *testing.T) {
 
 func testAccDataSourceAwsKmsSecretsEncrypt(key *kms.KeyMetadata, plaintext string, encryptedPayload *string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		kmsconn := testAccProvider.Meta().(*AWSClient).kmsconn
+		kmsconn := testAccProvider.Meta().(*AWSClient).kmsconn()
 
 		input := &kms.EncryptInput{
 			KeyId:     key.Arn,
@@ -63,7 +63,7 @@ func dataSourceAwsLambdaCodeSigningConfig() *schema.Resource {
 }
 
 func dataSourceAwsLambdaCodeSigningConfigRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lambdaconn
+	conn := meta.(*AWSClient).lambdaconn()
 
 	arn := d.Get("arn").(string)
 
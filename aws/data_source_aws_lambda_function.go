@@ -194,7 +194,7 @@ func dataSourceAwsLambdaFunction() *schema.Resource {
 }
 
 func dataSourceAwsLambdaFunctionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lambdaconn
+	conn := meta.(*AWSClient).lambdaconn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	functionName := d.Get("function_name").(string)
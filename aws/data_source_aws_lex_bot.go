@@ -87,7 +87,7 @@ func dataSourceAwsLexBot() *schema.Resource {
 }
 
 func dataSourceAwsLexBotRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lexmodelconn
+	conn := meta.(*AWSClient).lexmodelconn()
 
 	name := d.Get("name").(string)
 	version := d.Get("version").(string)
@@ -58,7 +58,7 @@ func dataSourceAwsMskBrokerNodes() *schema.Resource {
 }
 
 func dataSourceAwsMskBrokerNodesRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kafkaconn
+	conn := meta.(*AWSClient).kafkaconn()
 
 	clusterARN := d.Get("cluster_arn").(string)
 	input := &kafka.ListNodesInput{
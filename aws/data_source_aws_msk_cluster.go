@@ -59,7 +59,7 @@ func dataSourceAwsMskCluster() *schema.Resource {
 }
 
 func dataSourceAwsMskClusterRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kafkaconn
+	conn := meta.(*AWSClient).kafkaconn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	clusterName := d.Get("cluster_name").(string)
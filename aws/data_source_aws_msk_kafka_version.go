@@ -60,7 +60,7 @@ func findMskKafkaVersion(preferredVersions []interface{}, versions []*kafka.Kafk
 }
 
 func dataSourceAwsMskKafkaVersionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kafkaconn
+	conn := meta.(*AWSClient).kafkaconn()
 
 	var kafkaVersions []*kafka.KafkaVersion
 
@@ -50,7 +50,7 @@ func TestAccAWSMskKafkaVersionDataSource_preferred(t *testing.T) {
 }
 
 func testAccAWSMskKafkaVersionPreCheck(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).kafkaconn
+	conn := testAccProvider.Meta().(*AWSClient).kafkaconn()
 
 	input := &kafka.ListKafkaVersionsInput{}
 
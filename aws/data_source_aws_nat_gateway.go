@@ -62,7 +62,7 @@ func dataSourceAwsNatGateway() *schema.Resource {
 }
 
 func dataSourceAwsNatGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	req := &ec2.DescribeNatGatewaysInput{}
@@ -66,7 +66,7 @@ func dataSourceAwsOrganizationsDelegatedAdministrators() *schema.Resource {
 }
 
 func dataSourceAwsOrganizationsDelegatedAdministratorsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).organizationsconn
+	conn := meta.(*AWSClient).organizationsconn()
 
 	input := &organizations.ListDelegatedAdministratorsInput{}
 
@@ -55,7 +55,7 @@ func dataSourceAwsOutpostsOutpost() *schema.Resource {
 }
 
 func dataSourceAwsOutpostsOutpostRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).outpostsconn
+	conn := meta.(*AWSClient).outpostsconn()
 
 	input := &outposts.ListOutpostsInput{}
 
@@ -48,7 +48,7 @@ func dataSourceAwsOutpostsOutposts() *schema.Resource {
 }
 
 func dataSourceAwsOutpostsOutpostsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).outpostsconn
+	conn := meta.(*AWSClient).outpostsconn()
 
 	input := &outposts.ListOutpostsInput{}
 
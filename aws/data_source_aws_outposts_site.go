@@ -38,7 +38,7 @@ func dataSourceAwsOutpostsSite() *schema.Resource {
 }
 
 func dataSourceAwsOutpostsSiteRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).outpostsconn
+	conn := meta.(*AWSClient).outpostsconn()
 
 	input := &outposts.ListSitesInput{}
 
@@ -164,7 +164,7 @@ func dataSourceAwsRdsCluster() *schema.Resource {
 }
 
 func dataSourceAwsRdsClusterRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).rdsconn
+	conn := meta.(*AWSClient).rdsconn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	dbClusterIdentifier := d.Get("cluster_identifier").(string)
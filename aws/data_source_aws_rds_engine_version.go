@@ -124,7 +124,7 @@ func dataSourceAwsRdsEngineVersion() *schema.Resource {
 }
 
 func dataSourceAwsRdsEngineVersionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).rdsconn
+	conn := meta.(*AWSClient).rdsconn()
 
 	input := &rds.DescribeDBEngineVersionsInput{
 		ListSupportedCharacterSets: aws.Bool(true),
@@ -42,7 +42,7 @@ func dataSourceAwsRedshiftOrderableCluster() *schema.Resource {
 }
 
 func dataSourceAwsRedshiftOrderableClusterRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).redshiftconn
+	conn := meta.(*AWSClient).redshiftconn()
 
 	input := &redshift.DescribeOrderableClusterOptionsInput{}
 
@@ -94,7 +94,7 @@ func dataSourceAwsResourceGroupsTaggingAPIResources() *schema.Resource {
 }
 
 func dataSourceAwsResourceGroupsTaggingAPIResourcesRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).resourcegroupstaggingapiconn
+	conn := meta.(*AWSClient).resourcegroupstaggingapiconn()
 
 	input := &resourcegroupstaggingapi.GetResourcesInput{}
 
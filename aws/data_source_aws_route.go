@@ -94,7 +94,7 @@ func dataSourceAwsRoute() *schema.Resource {
 }
 
 func dataSourceAwsRouteRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	routeTableID := d.Get("route_table_id").(string)
 
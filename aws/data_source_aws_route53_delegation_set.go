@@ -37,7 +37,7 @@ func dataSourceAwsDelegationSet() *schema.Resource {
 }
 
 func dataSourceAwsDelegationSetRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).r53conn
+	conn := meta.(*AWSClient).r53conn()
 
 	dSetID := d.Get("id").(string)
 
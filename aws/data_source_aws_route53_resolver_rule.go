@@ -79,7 +79,7 @@ func dataSourceAwsRoute53ResolverRule() *schema.Resource {
 }
 
 func dataSourceAwsRoute53ResolverRuleRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).route53resolverconn
+	conn := meta.(*AWSClient).route53resolverconn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	var rule *route53resolver.ResolverRule
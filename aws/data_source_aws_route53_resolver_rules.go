@@ -61,7 +61,7 @@ func dataSourceAwsRoute53ResolverRules() *schema.Resource {
 }
 
 func dataSourceAwsRoute53ResolverRulesRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).route53resolverconn
+	conn := meta.(*AWSClient).route53resolverconn()
 
 	req := &route53resolver.ListResolverRulesInput{}
 	resolverRuleIds := []*string{}
@@ -64,7 +64,7 @@ func dataSourceAwsS3BucketObjects() *schema.Resource {
 }
 
 func dataSourceAwsS3BucketObjectsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).s3conn
+	conn := meta.(*AWSClient).s3conn()
 
 	bucket := d.Get("bucket").(string)
 	prefix := d.Get("prefix").(string)
@@ -39,7 +39,7 @@ func dataSourceAwsSecurityGroups() *schema.Resource {
 }
 
 func dataSourceAwsSecurityGroupsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 	req := &ec2.DescribeSecurityGroupsInput{}
 
 	filters, filtersOk := d.GetOk("filter")
@@ -45,7 +45,7 @@ func dataSourceAwsServerlessApplicationRepositoryApplication() *schema.Resource
 }
 
 func dataSourceAwsServerlessRepositoryApplicationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).serverlessapplicationrepositoryconn
+	conn := meta.(*AWSClient).serverlessapplicationrepositoryconn()
 
 	applicationID := d.Get("application_id").(string)
 	semanticVersion := d.Get("semantic_version").(string)
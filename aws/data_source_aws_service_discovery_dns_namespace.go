@@ -45,7 +45,7 @@ func dataSourceServiceDiscoveryDnsNamespace() *schema.Resource {
 }
 
 func dataSourceServiceDiscoveryDnsNamespaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).sdconn
+	conn := meta.(*AWSClient).sdconn()
 
 	name := d.Get("name").(string)
 
@@ -59,7 +59,7 @@ func dataSourceAwsServiceCatalogConstraint() *schema.Resource {
 }
 
 func dataSourceAwsServiceCatalogConstraintRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).scconn
+	conn := meta.(*AWSClient).scconn()
 
 	output, err := waiter.ConstraintReady(conn, d.Get("accept_language").(string), d.Get("id").(string))
 
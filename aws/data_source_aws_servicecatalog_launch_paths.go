@@ -65,7 +65,7 @@ func dataSourceAwsServiceCatalogLaunchPaths() *schema.Resource {
 }
 
 func dataSourceAwsServiceCatalogLaunchPathsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).scconn
+	conn := meta.(*AWSClient).scconn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	summaries, err := waiter.LaunchPathsReady(conn, d.Get("accept_language").(string), d.Get("product_id").(string))
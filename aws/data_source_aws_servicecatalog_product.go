@@ -81,7 +81,7 @@ func dataSourceAwsServiceCatalogProduct() *schema.Resource {
 }
 
 func dataSourceAwsServiceCatalogProductRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).scconn
+	conn := meta.(*AWSClient).scconn()
 
 	output, err := waiter.ProductReady(conn, d.Get("accept_language").(string), d.Get("id").(string))
 
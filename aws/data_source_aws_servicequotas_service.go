@@ -26,7 +26,7 @@ func dataSourceAwsServiceQuotasService() *schema.Resource {
 }
 
 func dataSourceAwsServiceQuotasServiceRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).servicequotasconn
+	conn := meta.(*AWSClient).servicequotasconn()
 
 	serviceName := d.Get("service_name").(string)
 
@@ -43,7 +43,7 @@ func dataSourceAwsSfnStateMachine() *schema.Resource {
 }
 
 func dataSourceAwsSfnStateMachineRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sfnconn
+	conn := meta.(*AWSClient).sfnconn()
 	params := &sfn.ListStateMachinesInput{}
 	log.Printf("[DEBUG] Reading Step Function State Machine: %s", d.Id())
 
@@ -28,7 +28,7 @@ func dataSourceAwsSnsTopic() *schema.Resource {
 }
 
 func dataSourceAwsSnsTopicsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).snsconn
+	conn := meta.(*AWSClient).snsconn()
 
 	resourceArn := ""
 	name := d.Get("name").(string)
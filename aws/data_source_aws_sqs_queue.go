@@ -31,7 +31,7 @@ func dataSourceAwsSqsQueue() *schema.Resource {
 }
 
 func dataSourceAwsSqsQueueRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sqsconn
+	conn := meta.(*AWSClient).sqsconn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	name := d.Get("name").(string)
@@ -48,7 +48,7 @@ func dataSourceAwsSsmParametersByPath() *schema.Resource {
 }
 
 func dataSourceAwsSsmParametersReadByPath(d *schema.ResourceData, meta interface{}) error {
-	ssmconn := meta.(*AWSClient).ssmconn
+	ssmconn := meta.(*AWSClient).ssmconn()
 
 	path := d.Get("path").(string)
 	input := &ssm.GetParametersByPathInput{
@@ -69,7 +69,7 @@ func dataSourceAwsSsoAdminPermissionSet() *schema.Resource {
 }
 
 func dataSourceAwsSsoAdminPermissionSetRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ssoadminconn
+	conn := meta.(*AWSClient).ssoadminconn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	instanceArn := d.Get("instance_arn").(string)
@@ -26,7 +26,7 @@ func dataSourceAwsSubnets() *schema.Resource {
 }
 
 func dataSourceAwsSubnetsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	input := &ec2.DescribeSubnetsInput{}
 
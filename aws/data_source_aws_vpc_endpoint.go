@@ -117,7 +117,7 @@ func dataSourceAwsVpcEndpoint() *schema.Resource {
 }
 
 func dataSourceAwsVpcEndpointRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	req := &ec2.DescribeVpcEndpointsInput{}
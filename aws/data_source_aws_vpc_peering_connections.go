@@ -27,7 +27,7 @@ func dataSourceAwsVpcPeeringConnections() *schema.Resource {
 }
 
 func dataSourceAwsVpcPeeringConnectionsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	req := &ec2.DescribeVpcPeeringConnectionsInput{}
 
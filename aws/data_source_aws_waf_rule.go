@@ -22,7 +22,7 @@ func dataSourceAwsWafRule() *schema.Resource {
 }
 
 func dataSourceAwsWafRuleRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafconn
+	conn := meta.(*AWSClient).wafconn()
 	name := d.Get("name").(string)
 
 	rules := make([]*waf.RuleSummary, 0)
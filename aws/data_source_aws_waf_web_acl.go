@@ -22,7 +22,7 @@ func dataSourceAwsWafWebAcl() *schema.Resource {
 }
 
 func dataSourceAwsWafWebAclRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafconn
+	conn := meta.(*AWSClient).wafconn()
 	name := d.Get("name").(string)
 
 	acls := make([]*waf.WebACLSummary, 0)
@@ -22,7 +22,7 @@ func dataSourceAwsWafRegionalRateBasedRule() *schema.Resource {
 }
 
 func dataSourceAwsWafRegionalRateBasedRuleRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafregionalconn
+	conn := meta.(*AWSClient).wafregionalconn()
 	name := d.Get("name").(string)
 
 	rules := make([]*waf.RuleSummary, 0)
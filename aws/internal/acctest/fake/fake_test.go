@@ -0,0 +1,289 @@
+package fake
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudcontrolapi"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+)
+
+func TestServer_JSON(t *testing.T) {
+	server := NewServer(t)
+	server.Handle("cloudcontrolapi", "GetResource", func(r *Request) (interface{}, error) {
+		input := &cloudcontrolapi.GetResourceInput{}
+
+		if err := r.DecodeJSON(input); err != nil {
+			return nil, err
+		}
+
+		if aws.StringValue(input.Identifier) != "test" {
+			return nil, NewError(http.StatusBadRequest, cloudcontrolapi.ErrCodeResourceNotFoundException, "not found")
+		}
+
+		return &cloudcontrolapi.GetResourceOutput{
+			ResourceDescription: &cloudcontrolapi.ResourceDescription{
+				Identifier: input.Identifier,
+				Properties: aws.String(`{"Name":"test"}`),
+			},
+			TypeName: input.TypeName,
+		}, nil
+	})
+
+	conn := cloudcontrolapi.New(server.Session())
+
+	output, err := conn.GetResource(&cloudcontrolapi.GetResourceInput{
+		Identifier: aws.String("test"),
+		TypeName:   aws.String("AWS::Logs::LogGroup"),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := aws.StringValue(output.ResourceDescription.Properties), `{"Name":"test"}`; got != want {
+		t.Errorf("got properties %s, expected %s", got, want)
+	}
+
+	if got, want := aws.StringValue(output.TypeName), "AWS::Logs::LogGroup"; got != want {
+		t.Errorf("got type name %s, expected %s", got, want)
+	}
+
+	_, err = conn.GetResource(&cloudcontrolapi.GetResourceInput{
+		Identifier: aws.String("other"),
+		TypeName:   aws.String("AWS::Logs::LogGroup"),
+	})
+
+	if !tfawserr.ErrCodeEquals(err, cloudcontrolapi.ErrCodeResourceNotFoundException) {
+		t.Errorf("got error %v, expected %s", err, cloudcontrolapi.ErrCodeResourceNotFoundException)
+	}
+
+	if got, want := server.Calls("cloudcontrolapi", "GetResource"), 2; got != want {
+		t.Errorf("got %d calls, expected %d", got, want)
+	}
+}
+
+func TestServer_Query(t *testing.T) {
+	server := NewServer(t)
+	server.Handle("sts", "GetCallerIdentity", func(r *Request) (interface{}, error) {
+		return &sts.GetCallerIdentityOutput{
+			Account: aws.String("123456789012"),
+			Arn:     aws.String("arn:aws:iam::123456789012:user/test"),
+			UserId:  aws.String("AIDACKCEVSQ6C2EXAMPLE"),
+		}, nil
+	})
+
+	output, err := sts.New(server.Session()).GetCallerIdentity(&sts.GetCallerIdentityInput{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := aws.StringValue(output.Account), "123456789012"; got != want {
+		t.Errorf("got account %s, expected %s", got, want)
+	}
+
+	server.Handle("sts", "GetCallerIdentity", func(r *Request) (interface{}, error) {
+		return nil, NewError(http.StatusForbidden, "AccessDenied", "denied")
+	})
+
+	_, err = sts.New(server.Session()).GetCallerIdentity(&sts.GetCallerIdentityInput{})
+
+	if !tfawserr.ErrMessageContains(err, "AccessDenied", "denied") {
+		t.Errorf("got error %v, expected AccessDenied", err)
+	}
+}
+
+func TestServer_EC2Query(t *testing.T) {
+	server := NewServer(t)
+	server.State.Put("vpc", "vpc-12345678", &ec2.Vpc{
+		CidrBlock: aws.String("10.0.0.0/16"),
+		State:     aws.String(ec2.VpcStateAvailable),
+		Tags:      []*ec2.Tag{{Key: aws.String("Name"), Value: aws.String("test")}},
+		VpcId:     aws.String("vpc-12345678"),
+	})
+	server.Handle("ec2", "DescribeVpcs", func(r *Request) (interface{}, error) {
+		v, ok := r.State.Get("vpc", r.Form.Get("VpcId.1"))
+
+		if !ok {
+			return nil, NewError(http.StatusBadRequest, "InvalidVpcID.NotFound", "not found")
+		}
+
+		return &ec2.DescribeVpcsOutput{Vpcs: []*ec2.Vpc{v.(*ec2.Vpc)}}, nil
+	})
+
+	conn := ec2.New(server.Session())
+
+	output, err := conn.DescribeVpcs(&ec2.DescribeVpcsInput{VpcIds: aws.StringSlice([]string{"vpc-12345678"})})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := len(output.Vpcs), 1; got != want {
+		t.Fatalf("got %d VPCs, expected %d", got, want)
+	}
+
+	vpc := output.Vpcs[0]
+
+	if got, want := aws.StringValue(vpc.CidrBlock), "10.0.0.0/16"; got != want {
+		t.Errorf("got CIDR block %s, expected %s", got, want)
+	}
+
+	if got, want := len(vpc.Tags), 1; got != want {
+		t.Fatalf("got %d tags, expected %d", got, want)
+	}
+
+	if got, want := aws.StringValue(vpc.Tags[0].Value), "test"; got != want {
+		t.Errorf("got tag value %s, expected %s", got, want)
+	}
+
+	_, err = conn.DescribeVpcs(&ec2.DescribeVpcsInput{VpcIds: aws.StringSlice([]string{"vpc-87654321"})})
+
+	if !tfawserr.ErrCodeEquals(err, "InvalidVpcID.NotFound") {
+		t.Errorf("got error %v, expected InvalidVpcID.NotFound", err)
+	}
+}
+
+func TestServer_RESTJSON(t *testing.T) {
+	server := NewServer(t)
+	server.HandleREST(Route{
+		Service:   "lambda",
+		Operation: "GetFunction",
+		Protocol:  ProtocolRESTJSON,
+		Method:    http.MethodGet,
+		Path:      "/2015-03-31/functions/{FunctionName}",
+	}, func(r *Request) (interface{}, error) {
+		return &lambda.GetFunctionOutput{
+			Configuration: &lambda.FunctionConfiguration{
+				FunctionName: aws.String(r.PathParams["FunctionName"]),
+				Runtime:      aws.String(lambda.RuntimeGo1X),
+			},
+			Tags: aws.StringMap(map[string]string{"Name": "test"}),
+		}, nil
+	})
+
+	output, err := lambda.New(server.Session()).GetFunction(&lambda.GetFunctionInput{FunctionName: aws.String("test")})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := aws.StringValue(output.Configuration.FunctionName), "test"; got != want {
+		t.Errorf("got function name %s, expected %s", got, want)
+	}
+
+	if got, want := aws.StringValue(output.Tags["Name"]), "test"; got != want {
+		t.Errorf("got tag value %s, expected %s", got, want)
+	}
+}
+
+func TestServer_RESTXML(t *testing.T) {
+	server := NewServer(t)
+	server.HandleREST(Route{
+		Service:   "route53",
+		Operation: "GetHostedZone",
+		Protocol:  ProtocolRESTXML,
+		Method:    http.MethodGet,
+		Path:      "/2013-04-01/hostedzone/{Id}",
+	}, func(r *Request) (interface{}, error) {
+		return &route53.GetHostedZoneOutput{
+			DelegationSet: &route53.DelegationSet{
+				NameServers: aws.StringSlice([]string{"ns-1.example.com", "ns-2.example.com"}),
+			},
+			HostedZone: &route53.HostedZone{
+				Id:   aws.String("/hostedzone/" + r.PathParams["Id"]),
+				Name: aws.String("example.com."),
+			},
+		}, nil
+	})
+	server.HandleREST(Route{
+		Service:   "s3",
+		Operation: "GetBucketPolicy",
+		Protocol:  ProtocolRESTXML,
+		Method:    http.MethodGet,
+		Path:      "/{Bucket}",
+	}, func(r *Request) (interface{}, error) {
+		return nil, NewError(http.StatusNotFound, "NoSuchBucketPolicy", "The bucket policy does not exist")
+	})
+
+	output, err := route53.New(server.Session()).GetHostedZone(&route53.GetHostedZoneInput{Id: aws.String("Z123456")})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := aws.StringValue(output.HostedZone.Id), "/hostedzone/Z123456"; got != want {
+		t.Errorf("got ID %s, expected %s", got, want)
+	}
+
+	if got, want := len(output.DelegationSet.NameServers), 2; got != want {
+		t.Errorf("got %d name servers, expected %d", got, want)
+	}
+
+	_, err = s3.New(server.Session()).GetBucketPolicy(&s3.GetBucketPolicyInput{Bucket: aws.String("test")})
+
+	if !tfawserr.ErrCodeEquals(err, "NoSuchBucketPolicy") {
+		t.Errorf("got error %v, expected NoSuchBucketPolicy", err)
+	}
+}
+
+func TestSequence(t *testing.T) {
+	state := func(s string) HandlerFunc {
+		return func(r *Request) (interface{}, error) {
+			return s, nil
+		}
+	}
+
+	handler := Sequence(state("pending"), state("pending"), state("available"))
+
+	for i, want := range []string{"pending", "pending", "available", "available"} {
+		got, err := handler(&Request{})
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if got != want {
+			t.Errorf("request %d: got %s, expected %s", i, got, want)
+		}
+	}
+}
+
+func TestStore(t *testing.T) {
+	store := NewStore()
+
+	if _, ok := store.Get("vpc", "vpc-1"); ok {
+		t.Error("expected no resource")
+	}
+
+	store.Put("vpc", "vpc-2", "two")
+	store.Put("vpc", "vpc-1", "one")
+	store.Put("subnet", "subnet-1", "subnet")
+
+	if v, ok := store.Get("vpc", "vpc-1"); !ok || v != "one" {
+		t.Errorf("got %v, %t, expected one, true", v, ok)
+	}
+
+	if got := store.List("vpc"); len(got) != 2 || got[0] != "one" || got[1] != "two" {
+		t.Errorf("got %v, expected [one two]", got)
+	}
+
+	if !store.Delete("vpc", "vpc-1") {
+		t.Error("expected resource deleted")
+	}
+
+	if store.Delete("vpc", "vpc-1") {
+		t.Error("expected resource already deleted")
+	}
+
+	if got := store.List("vpc"); len(got) != 1 {
+		t.Errorf("got %v, expected [two]", got)
+	}
+}
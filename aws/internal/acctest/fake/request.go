@@ -0,0 +1,93 @@
+package fake
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
+)
+
+const (
+	// ProtocolJSON is the JSON protocol, e.g. of cloudcontrolapi and dynamodb.
+	ProtocolJSON = "json"
+
+	// ProtocolQuery is the Query protocol, e.g. of iam and sts.
+	ProtocolQuery = "query"
+
+	// ProtocolEC2Query is the EC2 Query protocol of ec2.
+	ProtocolEC2Query = "ec2"
+
+	// ProtocolRESTJSON is the REST-JSON protocol, e.g. of lambda and apigateway.
+	ProtocolRESTJSON = "rest-json"
+
+	// ProtocolRESTXML is the REST-XML protocol, e.g. of route53 and s3.
+	ProtocolRESTXML = "rest-xml"
+)
+
+// Request is a request received by the fake.
+type Request struct {
+	// Service is the signing name of the service.
+	Service string
+
+	// Operation is the API operation name.
+	Operation string
+
+	// Protocol is the API protocol of the request.
+	Protocol string
+
+	// Region is the signing region of the request.
+	Region string
+
+	// RequestID is the request ID returned to the client.
+	RequestID string
+
+	Method     string
+	Path       string
+	PathParams map[string]string
+	Header     http.Header
+	Query      url.Values
+	Body       []byte
+
+	// Form contains the parameters of Query and EC2 Query protocol requests,
+	// e.g. "VpcId.1" or "Filter.1.Name".
+	Form url.Values
+
+	// State is the server's Store.
+	State *Store
+}
+
+// DecodeJSON decodes the JSON body of a JSON or REST-JSON protocol request into an AWS SDK input shape.
+func (r *Request) DecodeJSON(v interface{}) error {
+	if len(bytes.TrimSpace(r.Body)) == 0 {
+		return nil
+	}
+
+	if err := jsonutil.UnmarshalJSON(v, bytes.NewReader(r.Body)); err != nil {
+		return fmt.Errorf("error decoding %s request body: %w", r.Operation, err)
+	}
+
+	return nil
+}
+
+// Error is an AWS API error returned by a handler.
+type Error struct {
+	StatusCode int
+	Code       string
+	Message    string
+}
+
+// NewError returns an AWS API error with the HTTP status code, error code and message.
+func NewError(statusCode int, code, message string) *Error {
+	return &Error{
+		StatusCode: statusCode,
+		Code:       code,
+		Message:    message,
+	}
+}
+
+// Error implements error.
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
@@ -0,0 +1,241 @@
+package fake
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/private/protocol"
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
+	"github.com/aws/aws-sdk-go/private/protocol/xml/xmlutil"
+)
+
+// xmlNamespace is the namespace of XML responses, which is ignored by the AWS SDK.
+const xmlNamespace = "https://fake.amazonaws.com/doc/"
+
+// writeResponse encodes the handler output or error in the protocol of the request.
+func writeResponse(w http.ResponseWriter, r *Request, output interface{}, err error) {
+	w.Header().Set("X-Amzn-Requestid", r.RequestID)
+
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	var body []byte
+
+	switch r.Protocol {
+	case ProtocolJSON, ProtocolRESTJSON:
+		body, err = encodeJSON(output)
+	default:
+		body, err = encodeXML(r, output)
+	}
+
+	if err != nil {
+		writeError(w, r, fmt.Errorf("error encoding %s output: %w", r.Operation, err))
+		return
+	}
+
+	switch r.Protocol {
+	case ProtocolJSON:
+		w.Header().Set("Content-Type", r.Header.Get("Content-Type"))
+	case ProtocolRESTJSON:
+		w.Header().Set("Content-Type", "application/json")
+	default:
+		w.Header().Set("Content-Type", "text/xml")
+	}
+
+	statusCode := http.StatusOK
+
+	if r.Protocol == ProtocolRESTJSON || r.Protocol == ProtocolRESTXML {
+		statusCode = setRESTHeaders(w.Header(), output)
+	}
+
+	w.WriteHeader(statusCode)
+	w.Write(body)
+}
+
+// writeError encodes the error in the protocol of the request.
+// Errors other than *Error are returned as InternalFailure errors.
+func writeError(w http.ResponseWriter, r *Request, err error) {
+	var apiErr *Error
+
+	if !errors.As(err, &apiErr) {
+		apiErr = NewError(http.StatusInternalServerError, "InternalFailure", err.Error())
+	}
+
+	var body []byte
+
+	switch r.Protocol {
+	case ProtocolJSON, ProtocolRESTJSON:
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Amzn-Errortype", apiErr.Code)
+		body, _ = json.Marshal(map[string]string{
+			"__type":  apiErr.Code,
+			"message": apiErr.Message,
+		})
+	case ProtocolEC2Query:
+		w.Header().Set("Content-Type", "text/xml")
+		body = []byte(fmt.Sprintf("<Response><Errors><Error><Code>%s</Code><Message>%s</Message></Error></Errors><RequestID>%s</RequestID></Response>",
+			xmlEscape(apiErr.Code), xmlEscape(apiErr.Message), xmlEscape(r.RequestID)))
+	default:
+		w.Header().Set("Content-Type", "text/xml")
+
+		if r.Service == "s3" {
+			body = []byte(fmt.Sprintf("<Error><Code>%s</Code><Message>%s</Message><RequestId>%s</RequestId></Error>",
+				xmlEscape(apiErr.Code), xmlEscape(apiErr.Message), xmlEscape(r.RequestID)))
+		} else {
+			body = []byte(fmt.Sprintf("<ErrorResponse><Error><Type>Sender</Type><Code>%s</Code><Message>%s</Message></Error><RequestId>%s</RequestId></ErrorResponse>",
+				xmlEscape(apiErr.Code), xmlEscape(apiErr.Message), xmlEscape(r.RequestID)))
+		}
+	}
+
+	w.WriteHeader(apiErr.StatusCode)
+	w.Write(body)
+}
+
+func encodeJSON(output interface{}) ([]byte, error) {
+	if isNil(output) {
+		return []byte("{}"), nil
+	}
+
+	return jsonutil.BuildJSON(output)
+}
+
+// encodeXML encodes the output as the XML response body of a Query, EC2 Query or REST-XML protocol operation.
+func encodeXML(r *Request, output interface{}) ([]byte, error) {
+	var members bytes.Buffer
+
+	if !isNil(output) {
+		// The members of output shapes are encoded without an enclosing element,
+		// as output shapes have no location name, except REST-XML payloads.
+		if err := xmlutil.BuildXML(output, xml.NewEncoder(&members)); err != nil {
+			return nil, err
+		}
+
+		if r.Protocol == ProtocolRESTXML && hasPayload(output) {
+			return members.Bytes(), nil
+		}
+	}
+
+	var b bytes.Buffer
+
+	switch r.Protocol {
+	case ProtocolQuery:
+		fmt.Fprintf(&b, `<%[1]sResponse xmlns="%[2]s"><%[1]sResult>%[3]s</%[1]sResult><ResponseMetadata><RequestId>%[4]s</RequestId></ResponseMetadata></%[1]sResponse>`,
+			r.Operation, xmlNamespace, members.String(), xmlEscape(r.RequestID))
+	case ProtocolEC2Query:
+		fmt.Fprintf(&b, `<%[1]sResponse xmlns="%[2]s"><requestId>%[4]s</requestId>%[3]s</%[1]sResponse>`,
+			r.Operation, xmlNamespace, members.String(), xmlEscape(r.RequestID))
+	default:
+		fmt.Fprintf(&b, `<%[1]sResponse xmlns="%[2]s">%[3]s</%[1]sResponse>`,
+			r.Operation, xmlNamespace, members.String())
+	}
+
+	return b.Bytes(), nil
+}
+
+// setRESTHeaders sets the header members of a REST protocol output shape, returning its status code member, if any.
+func setRESTHeaders(header http.Header, output interface{}) int {
+	statusCode := http.StatusOK
+
+	if isNil(output) {
+		return statusCode
+	}
+
+	v := reflect.Indirect(reflect.ValueOf(output))
+
+	if v.Kind() != reflect.Struct {
+		return statusCode
+	}
+
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		member := reflect.Indirect(v.Field(i))
+
+		if field.PkgPath != "" || !member.IsValid() {
+			continue
+		}
+
+		switch field.Tag.Get("location") {
+		case "header":
+			var value string
+
+			switch member := member.Interface().(type) {
+			case string:
+				value = member
+			case bool:
+				value = strconv.FormatBool(member)
+			case int64:
+				value = strconv.FormatInt(member, 10)
+			case float64:
+				value = strconv.FormatFloat(member, 'f', -1, 64)
+			case time.Time:
+				format := field.Tag.Get("timestampFormat")
+
+				if format == "" {
+					format = protocol.RFC822TimeFormatName
+				}
+
+				value = protocol.FormatTime(format, member)
+			default:
+				continue
+			}
+
+			header.Set(field.Tag.Get("locationName"), value)
+		case "headers":
+			if member.Kind() != reflect.Map {
+				continue
+			}
+
+			for _, k := range member.MapKeys() {
+				if v := reflect.Indirect(member.MapIndex(k)); v.Kind() == reflect.String {
+					header.Set(field.Tag.Get("locationName")+k.String(), v.String())
+				}
+			}
+		case "statusCode":
+			if member.Kind() == reflect.Int64 {
+				statusCode = int(member.Int())
+			}
+		}
+	}
+
+	return statusCode
+}
+
+// hasPayload returns whether the output shape has a payload member, encoded as the entire response body.
+func hasPayload(output interface{}) bool {
+	t := reflect.Indirect(reflect.ValueOf(output)).Type()
+
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+
+	field, ok := t.FieldByName("_")
+
+	return ok && field.Tag.Get("payload") != ""
+}
+
+func isNil(v interface{}) bool {
+	if v == nil {
+		return true
+	}
+
+	rv := reflect.ValueOf(v)
+
+	return rv.Kind() == reflect.Ptr && rv.IsNil()
+}
+
+func xmlEscape(s string) string {
+	var b bytes.Buffer
+
+	xml.EscapeText(&b, []byte(s))
+
+	return b.String()
+}
@@ -0,0 +1,77 @@
+package fake
+
+import (
+	"sort"
+	"sync"
+)
+
+// Store is a concurrency-safe store of fake resources, keyed by kind and identifier.
+type Store struct {
+	mu    sync.Mutex
+	items map[string]map[string]interface{}
+}
+
+// NewStore returns an empty Store.
+func NewStore() *Store {
+	return &Store{
+		items: make(map[string]map[string]interface{}),
+	}
+}
+
+// Put stores the resource of the kind, e.g. "vpc", with the identifier.
+func (s *Store) Put(kind, id string, v interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.items[kind] == nil {
+		s.items[kind] = make(map[string]interface{})
+	}
+
+	s.items[kind][id] = v
+}
+
+// Get returns the resource of the kind with the identifier, and whether it exists.
+func (s *Store) Get(kind, id string) (interface{}, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	v, ok := s.items[kind][id]
+
+	return v, ok
+}
+
+// Delete removes the resource of the kind with the identifier, returning whether it existed.
+func (s *Store) Delete(kind, id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.items[kind][id]; !ok {
+		return false
+	}
+
+	delete(s.items[kind], id)
+
+	return true
+}
+
+// List returns the resources of the kind, ordered by identifier.
+func (s *Store) List(kind string) []interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ids := make([]string, 0, len(s.items[kind]))
+
+	for id := range s.items[kind] {
+		ids = append(ids, id)
+	}
+
+	sort.Strings(ids)
+
+	result := make([]interface{}, 0, len(ids))
+
+	for _, id := range ids {
+		result = append(result, s.items[kind][id])
+	}
+
+	return result
+}
@@ -0,0 +1,367 @@
+package apimetrics
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+const (
+	// FormatJSON writes the summary as a JSON document.
+	FormatJSON = "json"
+
+	// FormatOpenMetrics writes the summary in the OpenMetrics text exposition format.
+	FormatOpenMetrics = "openmetrics"
+)
+
+// Format_Values returns all summary formats.
+func Format_Values() []string {
+	return []string{
+		FormatJSON,
+		FormatOpenMetrics,
+	}
+}
+
+// Stats are the cumulative metrics of a set of calls.
+type Stats struct {
+	Calls     int64         `json:"calls"`
+	Errors    int64         `json:"errors"`
+	Throttles int64         `json:"throttles"`
+	Retries   int64         `json:"retries"`
+	Latency   time.Duration `json:"-"`
+	LatencyMs int64         `json:"latency_ms"`
+}
+
+// OperationStats are the metrics of calls to an AWS API operation.
+type OperationStats struct {
+	Service   string `json:"service"`
+	Operation string `json:"operation"`
+	Stats
+}
+
+// ResourceStats are the metrics of a Terraform resource type, or data source type,
+// and of the AWS API calls made by its operations.
+type ResourceStats struct {
+	ResourceType string                             `json:"resource_type"`
+	Operations   map[string]*ResourceOperationStats `json:"operations"`
+	APICalls     Stats                              `json:"api_calls"`
+}
+
+// ResourceOperationStats are the cumulative metrics of a resource type operation, e.g. "create".
+type ResourceOperationStats struct {
+	Calls     int64         `json:"calls"`
+	Errors    int64         `json:"errors"`
+	Latency   time.Duration `json:"-"`
+	LatencyMs int64         `json:"latency_ms"`
+}
+
+// Summary is a snapshot of the metrics of a Collector.
+type Summary struct {
+	Operations []*OperationStats `json:"operations"`
+	Resources  []*ResourceStats  `json:"resources"`
+}
+
+type operationKey struct {
+	service   string
+	operation string
+}
+
+// Collector counts AWS API calls, throttling responses, retries and cumulative latency per
+// AWS service operation and per Terraform resource type.
+// A Collector is safe for concurrent use.
+type Collector struct {
+	mu sync.Mutex
+
+	operations map[operationKey]*OperationStats
+	resources  map[string]*ResourceStats
+}
+
+// NewCollector returns a new, empty, Collector.
+func NewCollector() *Collector {
+	return &Collector{
+		operations: make(map[operationKey]*OperationStats),
+		resources:  make(map[string]*ResourceStats),
+	}
+}
+
+// AddHandlers adds request handlers which record each AWS API call to the named service.
+// Calls are also attributed to resourceType, unless it is empty.
+func (c *Collector) AddHandlers(handlers *request.Handlers, service, resourceType string) {
+	handlers.CompleteAttempt.PushBackNamed(request.NamedHandler{
+		Name: "terraform-provider-aws.APIMetricsAttempt",
+		Fn: func(r *request.Request) {
+			if r.IsErrorThrottle() {
+				c.recordThrottle(service, resourceType, r)
+			}
+		},
+	})
+
+	handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: "terraform-provider-aws.APIMetrics",
+		Fn: func(r *request.Request) {
+			c.recordCall(service, resourceType, r, time.Since(r.Time))
+		},
+	})
+}
+
+// ObserveResourceOperation records a completed resource type operation.
+func (c *Collector) ObserveResourceOperation(resourceType, operation string, latency time.Duration, failed bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	resource := c.resourceStats(resourceType)
+	stats, ok := resource.Operations[operation]
+
+	if !ok {
+		stats = &ResourceOperationStats{}
+		resource.Operations[operation] = stats
+	}
+
+	stats.Calls++
+	stats.Latency += latency
+
+	if failed {
+		stats.Errors++
+	}
+}
+
+func (c *Collector) recordThrottle(service, resourceType string, r *request.Request) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.operationStats(service, r).Throttles++
+
+	if resourceType != "" {
+		c.resourceStats(resourceType).APICalls.Throttles++
+	}
+}
+
+func (c *Collector) recordCall(service, resourceType string, r *request.Request, latency time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	all := []*Stats{&c.operationStats(service, r).Stats}
+
+	if resourceType != "" {
+		all = append(all, &c.resourceStats(resourceType).APICalls)
+	}
+
+	for _, stats := range all {
+		stats.Calls++
+		stats.Retries += int64(r.RetryCount)
+		stats.Latency += latency
+
+		if r.Error != nil {
+			stats.Errors++
+		}
+	}
+}
+
+// operationStats returns the stats of a request's operation. The caller must hold mu.
+func (c *Collector) operationStats(service string, r *request.Request) *OperationStats {
+	key := operationKey{service: service}
+
+	if r.Operation != nil {
+		key.operation = r.Operation.Name
+	}
+
+	stats, ok := c.operations[key]
+
+	if !ok {
+		stats = &OperationStats{Service: key.service, Operation: key.operation}
+		c.operations[key] = stats
+	}
+
+	return stats
+}
+
+// resourceStats returns the stats of a resource type. The caller must hold mu.
+func (c *Collector) resourceStats(resourceType string) *ResourceStats {
+	stats, ok := c.resources[resourceType]
+
+	if !ok {
+		stats = &ResourceStats{
+			ResourceType: resourceType,
+			Operations:   make(map[string]*ResourceOperationStats),
+		}
+		c.resources[resourceType] = stats
+	}
+
+	return stats
+}
+
+// Summary returns a snapshot of the collected metrics, sorted by service and operation and by resource type.
+func (c *Collector) Summary() *Summary {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	summary := &Summary{
+		Operations: make([]*OperationStats, 0, len(c.operations)),
+		Resources:  make([]*ResourceStats, 0, len(c.resources)),
+	}
+
+	for _, v := range c.operations {
+		stats := *v
+		stats.LatencyMs = stats.Latency.Milliseconds()
+		summary.Operations = append(summary.Operations, &stats)
+	}
+
+	for _, v := range c.resources {
+		stats := &ResourceStats{
+			ResourceType: v.ResourceType,
+			Operations:   make(map[string]*ResourceOperationStats, len(v.Operations)),
+			APICalls:     v.APICalls,
+		}
+		stats.APICalls.LatencyMs = stats.APICalls.Latency.Milliseconds()
+
+		for operation, v := range v.Operations {
+			operationStats := *v
+			operationStats.LatencyMs = operationStats.Latency.Milliseconds()
+			stats.Operations[operation] = &operationStats
+		}
+
+		summary.Resources = append(summary.Resources, stats)
+	}
+
+	sort.Slice(summary.Operations, func(i, j int) bool {
+		a, b := summary.Operations[i], summary.Operations[j]
+
+		if a.Service != b.Service {
+			return a.Service < b.Service
+		}
+
+		return a.Operation < b.Operation
+	})
+
+	sort.Slice(summary.Resources, func(i, j int) bool {
+		return summary.Resources[i].ResourceType < summary.Resources[j].ResourceType
+	})
+
+	return summary
+}
+
+// WriteFile writes a summary of the collected metrics to the named file in the specified format.
+func (c *Collector) WriteFile(filename, format string) error {
+	f, err := os.Create(filename)
+
+	if err != nil {
+		return fmt.Errorf("error creating API metrics file (%s): %w", filename, err)
+	}
+
+	if err := c.Write(f, format); err != nil {
+		f.Close()
+		return fmt.Errorf("error writing API metrics file (%s): %w", filename, err)
+	}
+
+	return f.Close()
+}
+
+// Write writes a summary of the collected metrics to w in the specified format.
+func (c *Collector) Write(w io.Writer, format string) error {
+	switch format {
+	case FormatJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+
+		return encoder.Encode(c.Summary())
+	case FormatOpenMetrics:
+		return writeOpenMetrics(w, c.Summary())
+	}
+
+	return fmt.Errorf("unsupported API metrics format: %s", format)
+}
+
+// writeOpenMetrics writes a summary in the OpenMetrics text exposition format.
+// All metrics are counters.
+func writeOpenMetrics(w io.Writer, summary *Summary) error {
+	var b strings.Builder
+
+	type sample struct {
+		labels string
+		value  float64
+	}
+
+	counter := func(name, help string, samples []sample) {
+		fmt.Fprintf(&b, "# TYPE %s counter\n", name)
+		fmt.Fprintf(&b, "# HELP %s %s\n", name, help)
+
+		for _, v := range samples {
+			fmt.Fprintf(&b, "%s_total{%s} %g\n", name, v.labels, v.value)
+		}
+	}
+
+	operationSamples := func(value func(*Stats) float64) []sample {
+		samples := make([]sample, 0, len(summary.Operations))
+
+		for _, v := range summary.Operations {
+			samples = append(samples, sample{fmt.Sprintf("service=%q,operation=%q", v.Service, v.Operation), value(&v.Stats)})
+		}
+
+		return samples
+	}
+
+	resourceSamples := func(value func(*Stats) float64) []sample {
+		samples := make([]sample, 0, len(summary.Resources))
+
+		for _, v := range summary.Resources {
+			samples = append(samples, sample{fmt.Sprintf("resource_type=%q", v.ResourceType), value(&v.APICalls)})
+		}
+
+		return samples
+	}
+
+	resourceOperationSamples := func(value func(*ResourceOperationStats) float64) []sample {
+		var samples []sample
+
+		for _, v := range summary.Resources {
+			operations := make([]string, 0, len(v.Operations))
+
+			for operation := range v.Operations {
+				operations = append(operations, operation)
+			}
+
+			sort.Strings(operations)
+
+			for _, operation := range operations {
+				samples = append(samples, sample{fmt.Sprintf("resource_type=%q,operation=%q", v.ResourceType, operation), value(v.Operations[operation])})
+			}
+		}
+
+		return samples
+	}
+
+	calls := func(s *Stats) float64 { return float64(s.Calls) }
+	errors := func(s *Stats) float64 { return float64(s.Errors) }
+	throttles := func(s *Stats) float64 { return float64(s.Throttles) }
+	retries := func(s *Stats) float64 { return float64(s.Retries) }
+	latency := func(s *Stats) float64 { return s.Latency.Seconds() }
+
+	counter("aws_api_calls", "AWS API calls.", operationSamples(calls))
+	counter("aws_api_errors", "AWS API calls which failed after all retries.", operationSamples(errors))
+	counter("aws_api_throttles", "AWS API throttling responses.", operationSamples(throttles))
+	counter("aws_api_retries", "AWS API call retries.", operationSamples(retries))
+	counter("aws_api_latency_seconds", "Cumulative AWS API call latency, including retries.", operationSamples(latency))
+
+	counter("terraform_resource_aws_api_calls", "AWS API calls made by resource type operations.", resourceSamples(calls))
+	counter("terraform_resource_aws_api_errors", "AWS API calls made by resource type operations which failed after all retries.", resourceSamples(errors))
+	counter("terraform_resource_aws_api_throttles", "AWS API throttling responses to resource type operations.", resourceSamples(throttles))
+	counter("terraform_resource_aws_api_retries", "AWS API call retries made by resource type operations.", resourceSamples(retries))
+	counter("terraform_resource_aws_api_latency_seconds", "Cumulative latency of AWS API calls made by resource type operations.", resourceSamples(latency))
+
+	counter("terraform_resource_operations", "Resource type operations.", resourceOperationSamples(func(s *ResourceOperationStats) float64 { return float64(s.Calls) }))
+	counter("terraform_resource_operation_errors", "Resource type operations which failed.", resourceOperationSamples(func(s *ResourceOperationStats) float64 { return float64(s.Errors) }))
+	counter("terraform_resource_operation_latency_seconds", "Cumulative resource type operation latency.", resourceOperationSamples(func(s *ResourceOperationStats) float64 { return s.Latency.Seconds() }))
+
+	b.WriteString("# EOF\n")
+
+	_, err := io.WriteString(w, b.String())
+
+	return err
+}
@@ -0,0 +1,156 @@
+package apimetrics
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+func testRequest(operation string) *request.Request {
+	r := request.New(aws.Config{}, metadata.ClientInfo{}, request.Handlers{}, nil, &request.Operation{Name: operation}, nil, nil)
+	r.Time = time.Now().Add(-time.Second)
+
+	return r
+}
+
+// testSend runs the handlers of a request for each attempt, with the specified attempt errors.
+func testSend(handlers *request.Handlers, r *request.Request, attemptErrors ...error) {
+	for i, err := range attemptErrors {
+		r.RetryCount = i
+		r.Error = err
+		handlers.CompleteAttempt.Run(r)
+	}
+
+	handlers.Complete.Run(r)
+}
+
+func TestCollector(t *testing.T) {
+	collector := NewCollector()
+	throttle := awserr.New("ThrottlingException", "Rate exceeded", nil)
+	notFound := awserr.New("InvalidVpcID.NotFound", "not found", nil)
+
+	var providerHandlers, resourceHandlers request.Handlers
+	collector.AddHandlers(&providerHandlers, "ec2", "")
+	collector.AddHandlers(&resourceHandlers, "ec2", "aws_vpc")
+
+	testSend(&providerHandlers, testRequest("DescribeAccountAttributes"), nil)
+	testSend(&resourceHandlers, testRequest("DescribeVpcs"), throttle, throttle, nil)
+	testSend(&resourceHandlers, testRequest("DescribeVpcs"), notFound)
+
+	collector.ObserveResourceOperation("aws_vpc", "read", 2*time.Second, false)
+	collector.ObserveResourceOperation("aws_vpc", "read", time.Second, true)
+
+	summary := collector.Summary()
+
+	if got, expected := len(summary.Operations), 2; got != expected {
+		t.Fatalf("got %d operations, expected %d", got, expected)
+	}
+
+	describeAccountAttributes, describeVpcs := summary.Operations[0], summary.Operations[1]
+
+	if got, expected := describeAccountAttributes.Operation, "DescribeAccountAttributes"; got != expected {
+		t.Errorf("got operation %q, expected %q", got, expected)
+	}
+
+	if got, expected := describeVpcs.Stats, (Stats{Calls: 2, Errors: 1, Throttles: 2, Retries: 2}); got.Calls != expected.Calls || got.Errors != expected.Errors || got.Throttles != expected.Throttles || got.Retries != expected.Retries {
+		t.Errorf("got DescribeVpcs stats %+v, expected %+v", got, expected)
+	}
+
+	if describeVpcs.LatencyMs < 2000 {
+		t.Errorf("got DescribeVpcs latency %dms, expected at least 2000ms", describeVpcs.LatencyMs)
+	}
+
+	if got, expected := len(summary.Resources), 1; got != expected {
+		t.Fatalf("got %d resources, expected %d", got, expected)
+	}
+
+	vpc := summary.Resources[0]
+
+	if got, expected := vpc.APICalls.Calls, int64(2); got != expected {
+		t.Errorf("got %d aws_vpc API calls, expected %d", got, expected)
+	}
+
+	if got, expected := vpc.APICalls.Throttles, int64(2); got != expected {
+		t.Errorf("got %d aws_vpc API throttles, expected %d", got, expected)
+	}
+
+	read, ok := vpc.Operations["read"]
+
+	if !ok {
+		t.Fatalf("expected aws_vpc read operation stats")
+	}
+
+	if got, expected := *read, (ResourceOperationStats{Calls: 2, Errors: 1, Latency: 3 * time.Second, LatencyMs: 3000}); got != expected {
+		t.Errorf("got aws_vpc read stats %+v, expected %+v", got, expected)
+	}
+}
+
+func TestCollectorWrite(t *testing.T) {
+	collector := NewCollector()
+
+	var handlers request.Handlers
+	collector.AddHandlers(&handlers, "s3", "aws_s3_bucket")
+
+	testSend(&handlers, testRequest("HeadBucket"), nil)
+	collector.ObserveResourceOperation("aws_s3_bucket", "create", time.Second, false)
+
+	t.Run("json", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		if err := collector.Write(&buf, FormatJSON); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		var summary Summary
+
+		if err := json.Unmarshal(buf.Bytes(), &summary); err != nil {
+			t.Fatalf("unexpected error decoding summary: %s", err)
+		}
+
+		if len(summary.Operations) != 1 || summary.Operations[0].Operation != "HeadBucket" || summary.Operations[0].Calls != 1 {
+			t.Errorf("unexpected operations: %s", buf.String())
+		}
+
+		if len(summary.Resources) != 1 || summary.Resources[0].Operations["create"] == nil || summary.Resources[0].Operations["create"].LatencyMs != 1000 {
+			t.Errorf("unexpected resources: %s", buf.String())
+		}
+	})
+
+	t.Run("openmetrics", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		if err := collector.Write(&buf, FormatOpenMetrics); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		got := buf.String()
+
+		for _, expected := range []string{
+			"# TYPE aws_api_calls counter\n",
+			`aws_api_calls_total{service="s3",operation="HeadBucket"} 1` + "\n",
+			`terraform_resource_aws_api_calls_total{resource_type="aws_s3_bucket"} 1` + "\n",
+			`terraform_resource_operation_latency_seconds_total{resource_type="aws_s3_bucket",operation="create"} 1` + "\n",
+		} {
+			if !strings.Contains(got, expected) {
+				t.Errorf("expected output to contain %q, got:\n%s", expected, got)
+			}
+		}
+
+		if !strings.HasSuffix(got, "# EOF\n") {
+			t.Errorf("expected output to end with # EOF, got:\n%s", got)
+		}
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		if err := collector.Write(&bytes.Buffer{}, "csv"); err == nil {
+			t.Errorf("expected error")
+		}
+	})
+}
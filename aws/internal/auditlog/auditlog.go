@@ -0,0 +1,240 @@
+package auditlog
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+const (
+	// RedactedValue replaces the value of each redacted request parameter.
+	RedactedValue = "*** REDACTED ***"
+
+	// unknownErrorCode is recorded for request errors which do not have an AWS error code.
+	unknownErrorCode = "UnknownError"
+)
+
+// RedactionRule redacts a request parameter, at any depth, of requests to an AWS service.
+type RedactionRule struct {
+	// Service is the service name, as used in endpoints configuration, e.g. "ssm".
+	// An empty Service matches requests to all services.
+	Service string
+
+	// Field is the name of the shape member to redact, e.g. "Value".
+	Field string
+}
+
+// DefaultRedactionRules redact known sensitive request parameters which are not
+// marked as sensitive in the AWS Go SDK API models. Parameters marked as sensitive
+// in the API models are always redacted.
+var DefaultRedactionRules = []RedactionRule{
+	{Field: "AuthToken"},
+	{Field: "MasterUserPassword"},
+	{Field: "NewPassword"},
+	{Field: "OldPassword"},
+	{Field: "Password"},
+	{Field: "PrivateKey"},
+	{Field: "SecretAccessKey"},
+	{Field: "SecretBinary"},
+	{Field: "SecretString"},
+	{Field: "SessionToken"},
+	{Service: "ssm", Field: "Value"},
+}
+
+// Entry is the audit log record of a single AWS API call, including all of its retries.
+type Entry struct {
+	Time       string      `json:"time"`
+	Service    string      `json:"service"`
+	Operation  string      `json:"operation"`
+	RequestID  string      `json:"request_id,omitempty"`
+	LatencyMs  int64       `json:"latency_ms"`
+	RetryCount int         `json:"retry_count"`
+	StatusCode int         `json:"status_code,omitempty"`
+	ErrorCode  string      `json:"error_code,omitempty"`
+	Parameters interface{} `json:"parameters,omitempty"`
+}
+
+// Logger writes an Entry, as a line of JSON, for each AWS API call.
+// A Logger is safe for concurrent use.
+type Logger struct {
+	mu sync.Mutex
+
+	encoder *json.Encoder
+	rules   []RedactionRule
+
+	now func() time.Time
+}
+
+// New returns a Logger which writes to w, redacting request parameters matching the rules.
+func New(w io.Writer, rules []RedactionRule) *Logger {
+	return &Logger{
+		encoder: json.NewEncoder(w),
+		rules:   rules,
+		now:     time.Now,
+	}
+}
+
+// Open returns a Logger which appends to the named file, creating it if necessary.
+func Open(filename string, rules []RedactionRule) (*Logger, error) {
+	f, err := os.OpenFile(filename, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+
+	if err != nil {
+		return nil, fmt.Errorf("error opening API audit log (%s): %w", filename, err)
+	}
+
+	return New(f, rules), nil
+}
+
+// Handler returns a request handler which records each completed request.
+// The handler must be added to the Complete handler list.
+func (l *Logger) Handler(service string) request.NamedHandler {
+	return request.NamedHandler{
+		Name: "terraform-provider-aws.AuditLog",
+		Fn: func(r *request.Request) {
+			l.Log(service, r)
+		},
+	}
+}
+
+// Log records a completed request to the named service.
+func (l *Logger) Log(service string, r *request.Request) {
+	now := l.now()
+
+	entry := &Entry{
+		Time:       now.UTC().Format(time.RFC3339Nano),
+		Service:    service,
+		RequestID:  r.RequestID,
+		LatencyMs:  now.Sub(r.Time).Milliseconds(),
+		RetryCount: r.RetryCount,
+		Parameters: l.redact(service, reflect.ValueOf(r.Params)),
+	}
+
+	if r.Operation != nil {
+		entry.Operation = r.Operation.Name
+	}
+
+	if r.HTTPResponse != nil {
+		entry.StatusCode = r.HTTPResponse.StatusCode
+	}
+
+	if r.Error != nil {
+		entry.ErrorCode = unknownErrorCode
+
+		if err, ok := r.Error.(awserr.Error); ok {
+			entry.ErrorCode = err.Code()
+		}
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// Audit logging is best effort and never fails the request.
+	_ = l.encoder.Encode(entry)
+}
+
+var (
+	readerType = reflect.TypeOf((*io.Reader)(nil)).Elem()
+	timeType   = reflect.TypeOf(time.Time{})
+)
+
+// redact returns a JSON-encodable copy of a request parameter value, with redacted fields
+// replaced by RedactedValue and binary values and streams replaced by placeholders.
+func (l *Logger) redact(service string, v reflect.Value) interface{} {
+	if !v.IsValid() {
+		return nil
+	}
+
+	if v.Kind() == reflect.Interface || v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+
+		if v.Type().Implements(readerType) {
+			return "<stream>"
+		}
+
+		return l.redact(service, v.Elem())
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		if v.Type() == timeType {
+			return v.Interface()
+		}
+
+		m := make(map[string]interface{})
+
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+
+			if field.PkgPath != "" || field.Name == "_" {
+				continue
+			}
+
+			value := l.redact(service, v.Field(i))
+
+			if value == nil {
+				continue
+			}
+
+			if field.Tag.Get("sensitive") == "true" || l.isRedacted(service, field.Name) {
+				value = RedactedValue
+			}
+
+			m[field.Name] = value
+		}
+
+		return m
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return nil
+		}
+
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return fmt.Sprintf("<%d bytes>", v.Len())
+		}
+
+		s := make([]interface{}, 0, v.Len())
+
+		for i := 0; i < v.Len(); i++ {
+			s = append(s, l.redact(service, v.Index(i)))
+		}
+
+		return s
+	case reflect.Map:
+		if v.IsNil() {
+			return nil
+		}
+
+		m := make(map[string]interface{}, v.Len())
+
+		iter := v.MapRange()
+		for iter.Next() {
+			m[fmt.Sprint(iter.Key().Interface())] = l.redact(service, iter.Value())
+		}
+
+		return m
+	case reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		return nil
+	}
+
+	return v.Interface()
+}
+
+// isRedacted returns whether the named field of requests to the service is redacted by a rule.
+func (l *Logger) isRedacted(service, field string) bool {
+	for _, rule := range l.rules {
+		if rule.Field == field && (rule.Service == "" || rule.Service == service) {
+			return true
+		}
+	}
+
+	return false
+}
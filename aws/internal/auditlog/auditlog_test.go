@@ -0,0 +1,182 @@
+package auditlog
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/ssm"
+)
+
+func testRequest(operation string, params interface{}) *request.Request {
+	return request.New(aws.Config{}, metadata.ClientInfo{}, request.Handlers{}, nil, &request.Operation{Name: operation}, params, nil)
+}
+
+func TestLoggerLog(t *testing.T) {
+	start := time.Date(2021, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		Name          string
+		Service       string
+		Request       func() *request.Request
+		ExpectedEntry map[string]interface{}
+	}{
+		{
+			Name:    "success",
+			Service: "ssm",
+			Request: func() *request.Request {
+				r := testRequest("PutParameter", &ssm.PutParameterInput{
+					Name:  aws.String("/app/password"),
+					Type:  aws.String(ssm.ParameterTypeSecureString),
+					Value: aws.String("hunter2"),
+				})
+				r.RequestID = "request-1"
+				r.RetryCount = 2
+				r.HTTPResponse = &http.Response{StatusCode: http.StatusOK}
+
+				return r
+			},
+			ExpectedEntry: map[string]interface{}{
+				"time":        "2021-10-01T12:00:01.5Z",
+				"service":     "ssm",
+				"operation":   "PutParameter",
+				"request_id":  "request-1",
+				"latency_ms":  float64(1500),
+				"retry_count": float64(2),
+				"status_code": float64(http.StatusOK),
+				"parameters": map[string]interface{}{
+					"Name":  "/app/password",
+					"Type":  ssm.ParameterTypeSecureString,
+					"Value": RedactedValue,
+				},
+			},
+		},
+		{
+			Name:    "AWS error",
+			Service: "secretsmanager",
+			Request: func() *request.Request {
+				r := testRequest("CreateSecret", &secretsmanager.CreateSecretInput{
+					Name:         aws.String("app"),
+					SecretString: aws.String(`{"password":"hunter2"}`),
+					Tags: []*secretsmanager.Tag{
+						{Key: aws.String("Environment"), Value: aws.String("test")},
+					},
+				})
+				r.Error = awserr.New(secretsmanager.ErrCodeResourceExistsException, "exists", nil)
+				r.HTTPResponse = &http.Response{StatusCode: http.StatusBadRequest}
+
+				return r
+			},
+			ExpectedEntry: map[string]interface{}{
+				"time":        "2021-10-01T12:00:01.5Z",
+				"service":     "secretsmanager",
+				"operation":   "CreateSecret",
+				"latency_ms":  float64(1500),
+				"retry_count": float64(0),
+				"status_code": float64(http.StatusBadRequest),
+				"error_code":  secretsmanager.ErrCodeResourceExistsException,
+				"parameters": map[string]interface{}{
+					"Name":         "app",
+					"SecretString": RedactedValue,
+					"Tags": []interface{}{
+						map[string]interface{}{"Key": "Environment", "Value": "test"},
+					},
+				},
+			},
+		},
+		{
+			Name:    "other error and stream",
+			Service: "s3",
+			Request: func() *request.Request {
+				r := testRequest("PutObject", &s3.PutObjectInput{
+					Body:   bytes.NewReader([]byte("contents")),
+					Bucket: aws.String("bucket"),
+					Key:    aws.String("key"),
+				})
+				r.Error = errors.New("connection reset")
+
+				return r
+			},
+			ExpectedEntry: map[string]interface{}{
+				"time":        "2021-10-01T12:00:01.5Z",
+				"service":     "s3",
+				"operation":   "PutObject",
+				"latency_ms":  float64(1500),
+				"retry_count": float64(0),
+				"error_code":  unknownErrorCode,
+				"parameters": map[string]interface{}{
+					"Body":   "<stream>",
+					"Bucket": "bucket",
+					"Key":    "key",
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var buf bytes.Buffer
+
+			logger := New(&buf, DefaultRedactionRules)
+			logger.now = func() time.Time { return start.Add(1500 * time.Millisecond) }
+
+			r := testCase.Request()
+			r.Time = start
+
+			logger.Handler(testCase.Service).Fn(r)
+
+			if strings.Contains(buf.String(), "hunter2") {
+				t.Fatalf("audit log contains sensitive value: %s", buf.String())
+			}
+
+			var got map[string]interface{}
+
+			if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+				t.Fatalf("error decoding audit log entry (%s): %s", buf.String(), err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.ExpectedEntry) {
+				t.Errorf("got entry %#v, expected %#v", got, testCase.ExpectedEntry)
+			}
+		})
+	}
+}
+
+func TestLoggerRedactionRules(t *testing.T) {
+	var buf bytes.Buffer
+
+	logger := New(&buf, []RedactionRule{{Service: "ssm", Field: "Name"}})
+
+	for _, service := range []string{"ssm", "ssmincidents"} {
+		logger.Log(service, testRequest("GetParameter", &ssm.GetParameterInput{Name: aws.String("/app/password")}))
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 audit log entries, got %d: %s", len(lines), buf.String())
+	}
+
+	for i, expected := range []string{RedactedValue, "/app/password"} {
+		var entry Entry
+
+		if err := json.Unmarshal([]byte(lines[i]), &entry); err != nil {
+			t.Fatalf("error decoding audit log entry (%s): %s", lines[i], err)
+		}
+
+		if got := entry.Parameters.(map[string]interface{})["Name"]; got != expected {
+			t.Errorf("entry %d: got Name %q, expected %q", i, got, expected)
+		}
+	}
+}
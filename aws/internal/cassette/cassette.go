@@ -0,0 +1,344 @@
+// Package cassette records AWS API HTTP interactions into cassette files and replays them,
+// allowing acceptance tests to run without AWS credentials or network access.
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+const (
+	// ModeRecord sends requests to AWS and records the sanitized interactions.
+	ModeRecord = "record"
+
+	// ModeReplay serves responses from recorded interactions, without sending requests.
+	ModeReplay = "replay"
+)
+
+// Mode_Values returns all elements of the Mode enum.
+func Mode_Values() []string {
+	return []string{
+		ModeRecord,
+		ModeReplay,
+	}
+}
+
+// RedactedValue replaces each sensitive value in recorded interactions.
+const RedactedValue = "REDACTED"
+
+var (
+	// redactedHeaders are request and response headers not recorded,
+	// as they contain credentials or vary on every request.
+	redactedHeaders = []string{
+		"Authorization",
+		"Date",
+		"User-Agent",
+		"X-Amz-Content-Sha256",
+		"X-Amz-Date",
+		"X-Amz-Security-Token",
+		"X-Amzn-Trace-Id",
+	}
+
+	// sensitiveFields are request and response body fields whose values are redacted.
+	sensitiveFields = []string{
+		"AccessKeyId",
+		"MasterUserPassword",
+		"NewPassword",
+		"OldPassword",
+		"Password",
+		"SecretAccessKey",
+		"SessionToken",
+	}
+
+	// volatileFields are request body and query string fields ignored when matching requests,
+	// as they are generated for every request.
+	volatileFields = []string{
+		"ClientRequestToken",
+		"ClientToken",
+		"IdempotencyToken",
+		"X-Amz-Algorithm",
+		"X-Amz-Credential",
+		"X-Amz-Date",
+		"X-Amz-Expires",
+		"X-Amz-Security-Token",
+		"X-Amz-Signature",
+		"X-Amz-SignedHeaders",
+	}
+)
+
+// Request is a recorded HTTP request.
+type Request struct {
+	Method  string      `json:"method"`
+	URL     string      `json:"url"`
+	Headers http.Header `json:"headers,omitempty"`
+	Body    string      `json:"body,omitempty"`
+}
+
+// Response is a recorded HTTP response.
+type Response struct {
+	StatusCode int         `json:"status_code"`
+	Headers    http.Header `json:"headers,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// Interaction is a recorded HTTP request and its response.
+type Interaction struct {
+	Request  *Request  `json:"request"`
+	Response *Response `json:"response"`
+}
+
+// Cassette is the sequence of interactions recorded by a single test.
+type Cassette struct {
+	Name         string         `json:"name"`
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Load reads the cassette from a file.
+func Load(filename string) (*Cassette, error) {
+	b, err := ioutil.ReadFile(filename)
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading cassette (%s): %w", filename, err)
+	}
+
+	cassette := &Cassette{}
+
+	if err := json.Unmarshal(b, cassette); err != nil {
+		return nil, fmt.Errorf("error parsing cassette (%s): %w", filename, err)
+	}
+
+	return cassette, nil
+}
+
+// Save writes the cassette to a file, creating its directory if necessary.
+func (c *Cassette) Save(filename string) error {
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		return fmt.Errorf("error creating cassette directory: %w", err)
+	}
+
+	b, err := json.MarshalIndent(c, "", "  ")
+
+	if err != nil {
+		return fmt.Errorf("error encoding cassette (%s): %w", filename, err)
+	}
+
+	if err := ioutil.WriteFile(filename, append(b, '\n'), 0644); err != nil {
+		return fmt.Errorf("error writing cassette (%s): %w", filename, err)
+	}
+
+	return nil
+}
+
+// Filename returns the name of the file, in the directory, of the cassette of the named test.
+func Filename(dir string, testName string) string {
+	return filepath.Join(dir, strings.NewReplacer("/", "_", " ", "_").Replace(testName)+".json")
+}
+
+// Recorder is an http.RoundTripper which records interactions into, or replays them from,
+// the inserted cassette. A Recorder is safe for concurrent use.
+type Recorder struct {
+	mode      string
+	transport http.RoundTripper
+
+	mu           sync.Mutex
+	cassette     *Cassette
+	played       map[int]bool
+	replacements map[string]string
+}
+
+// NewRecorder returns a Recorder in the given mode. In record mode, requests are sent using transport.
+func NewRecorder(mode string, transport http.RoundTripper) (*Recorder, error) {
+	switch mode {
+	case ModeRecord, ModeReplay:
+	default:
+		return nil, fmt.Errorf("invalid cassette mode (%s), expected one of: %s", mode, strings.Join(Mode_Values(), ", "))
+	}
+
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	return &Recorder{
+		mode:      mode,
+		transport: transport,
+	}, nil
+}
+
+// Mode returns the recorder's mode.
+func (r *Recorder) Mode() string {
+	return r.mode
+}
+
+// Insert makes the cassette the target of recorded, or the source of replayed, interactions.
+func (r *Recorder) Insert(cassette *Cassette) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.cassette = cassette
+	r.played = make(map[int]bool)
+	r.replacements = make(map[string]string)
+}
+
+// Eject removes and returns the inserted cassette.
+func (r *Recorder) Eject() *Cassette {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	cassette := r.cassette
+	r.cassette = nil
+	r.played = nil
+	r.replacements = nil
+
+	return cassette
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	return r.roundTrip(req, r.transport)
+}
+
+// Wrap returns an http.RoundTripper which records interactions into, or replays them from,
+// the recorder's inserted cassette. In record mode, requests are sent using transport.
+func (r *Recorder) Wrap(transport http.RoundTripper) http.RoundTripper {
+	if transport == nil {
+		transport = r.transport
+	}
+
+	return &wrappedTransport{
+		recorder:  r,
+		transport: transport,
+	}
+}
+
+type wrappedTransport struct {
+	recorder  *Recorder
+	transport http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *wrappedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return t.recorder.roundTrip(req, t.transport)
+}
+
+func (r *Recorder) roundTrip(req *http.Request, transport http.RoundTripper) (*http.Response, error) {
+	body, err := readRequestBody(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	recorded := newRequest(req, body)
+
+	if r.mode == ModeReplay {
+		return r.replay(req, recorded)
+	}
+
+	resp, err := transport.RoundTrip(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %w", err)
+	}
+
+	resp.Body = ioutil.NopCloser(bytes.NewReader(respBody))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.cassette != nil {
+		r.cassette.Interactions = append(r.cassette.Interactions, &Interaction{
+			Request: recorded,
+			Response: &Response{
+				StatusCode: resp.StatusCode,
+				Headers:    sanitizeHeaders(resp.Header),
+				Body:       redactBody(string(respBody)),
+			},
+		})
+	}
+
+	return resp, nil
+}
+
+// readRequestBody returns the request body, replacing it so that it can be sent.
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+
+	body, err := ioutil.ReadAll(req.Body)
+	req.Body.Close()
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading request body: %w", err)
+	}
+
+	req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(body)), nil
+	}
+
+	return body, nil
+}
+
+func newRequest(req *http.Request, body []byte) *Request {
+	return &Request{
+		Method:  req.Method,
+		URL:     req.URL.String(),
+		Headers: sanitizeHeaders(req.Header),
+		Body:    redactBody(string(body)),
+	}
+}
+
+// sanitizeHeaders returns a copy of the headers without redacted headers.
+func sanitizeHeaders(header http.Header) http.Header {
+	result := http.Header{}
+
+	for k, v := range header {
+		result[k] = append([]string(nil), v...)
+	}
+
+	for _, k := range redactedHeaders {
+		result.Del(k)
+	}
+
+	if len(result) == 0 {
+		return nil
+	}
+
+	return result
+}
+
+var (
+	// sensitiveJSONFieldRegexp matches sensitive fields of JSON documents.
+	sensitiveJSONFieldRegexp = regexp.MustCompile(`("(?:` + strings.Join(sensitiveFields, "|") + `)"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+
+	// sensitiveQueryFieldRegexp matches sensitive fields of URL-encoded forms, as used by the AWS Query protocol.
+	sensitiveQueryFieldRegexp = regexp.MustCompile(`((?:^|&)(?:[^&=]*\.)?(?:` + strings.Join(sensitiveFields, "|") + `)=)[^&]*`)
+
+	// sensitiveXMLFieldRegexp matches sensitive fields of XML documents.
+	sensitiveXMLFieldRegexp = regexp.MustCompile(`(<(` + strings.Join(sensitiveFields, "|") + `)>)[^<]*(</(?:` + strings.Join(sensitiveFields, "|") + `)>)`)
+)
+
+// redactBody replaces the values of sensitive fields in a JSON, URL-encoded or XML body.
+func redactBody(body string) string {
+	body = sensitiveJSONFieldRegexp.ReplaceAllString(body, `${1}"`+RedactedValue+`"`)
+	body = sensitiveQueryFieldRegexp.ReplaceAllString(body, `${1}`+RedactedValue)
+	body = sensitiveXMLFieldRegexp.ReplaceAllString(body, `${1}`+RedactedValue+`${3}`)
+
+	return body
+}
@@ -0,0 +1,270 @@
+package cassette
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestRequestMatchKey(t *testing.T) {
+	testCases := []struct {
+		Name      string
+		Request1  *Request
+		Request2  *Request
+		WantMatch bool
+	}{
+		{
+			Name:      "identical",
+			Request1:  &Request{Method: "POST", URL: "https://ec2.us-west-2.amazonaws.com/", Body: "Action=DescribeVpcs&VpcId.1=vpc-12345678"},
+			Request2:  &Request{Method: "POST", URL: "https://ec2.us-west-2.amazonaws.com/", Body: "Action=DescribeVpcs&VpcId.1=vpc-12345678"},
+			WantMatch: true,
+		},
+		{
+			Name:      "different method",
+			Request1:  &Request{Method: "GET", URL: "https://s3.us-west-2.amazonaws.com/bucket"},
+			Request2:  &Request{Method: "HEAD", URL: "https://s3.us-west-2.amazonaws.com/bucket"},
+			WantMatch: false,
+		},
+		{
+			Name:      "query order and signature",
+			Request1:  &Request{Method: "GET", URL: "https://s3.us-west-2.amazonaws.com/bucket?tagging=&X-Amz-Signature=abc&X-Amz-Date=20210101T000000Z"},
+			Request2:  &Request{Method: "GET", URL: "https://s3.us-west-2.amazonaws.com/bucket?X-Amz-Date=20211231T000000Z&X-Amz-Signature=def&tagging="},
+			WantMatch: true,
+		},
+		{
+			Name:      "query protocol body order and idempotency token",
+			Request1:  &Request{Method: "POST", URL: "https://ec2.us-west-2.amazonaws.com/", Body: "Action=CreateVpc&CidrBlock=10.0.0.0%2F16&ClientToken=aaaa"},
+			Request2:  &Request{Method: "POST", URL: "https://ec2.us-west-2.amazonaws.com/", Body: "ClientToken=bbbb&CidrBlock=10.0.0.0%2F16&Action=CreateVpc"},
+			WantMatch: true,
+		},
+		{
+			Name:      "query protocol different body",
+			Request1:  &Request{Method: "POST", URL: "https://ec2.us-west-2.amazonaws.com/", Body: "Action=CreateVpc&CidrBlock=10.0.0.0%2F16"},
+			Request2:  &Request{Method: "POST", URL: "https://ec2.us-west-2.amazonaws.com/", Body: "Action=CreateVpc&CidrBlock=10.1.0.0%2F16"},
+			WantMatch: false,
+		},
+		{
+			Name:      "json protocol nested idempotency token",
+			Request1:  &Request{Method: "POST", URL: "https://sfn.us-west-2.amazonaws.com/", Headers: http.Header{"X-Amz-Target": {"AWSStepFunctions.StartExecution"}}, Body: `{"name":"test","Input":{"ClientRequestToken":"aaaa"}}`},
+			Request2:  &Request{Method: "POST", URL: "https://sfn.us-west-2.amazonaws.com/", Headers: http.Header{"X-Amz-Target": {"AWSStepFunctions.StartExecution"}}, Body: `{"Input": {"ClientRequestToken": "bbbb"}, "name": "test"}`},
+			WantMatch: true,
+		},
+		{
+			Name:      "json protocol different target",
+			Request1:  &Request{Method: "POST", URL: "https://sfn.us-west-2.amazonaws.com/", Headers: http.Header{"X-Amz-Target": {"AWSStepFunctions.DescribeExecution"}}, Body: `{}`},
+			Request2:  &Request{Method: "POST", URL: "https://sfn.us-west-2.amazonaws.com/", Headers: http.Header{"X-Amz-Target": {"AWSStepFunctions.StopExecution"}}, Body: `{}`},
+			WantMatch: false,
+		},
+		{
+			Name:      "rest-xml protocol caller reference token",
+			Request1:  &Request{Method: "POST", URL: "https://route53.amazonaws.com/2013-04-01/hostedzone", Body: `<CreateHostedZoneRequest><Name>example.com</Name><ClientToken>aaaa</ClientToken></CreateHostedZoneRequest>`},
+			Request2:  &Request{Method: "POST", URL: "https://route53.amazonaws.com/2013-04-01/hostedzone", Body: `<CreateHostedZoneRequest><Name>example.com</Name><ClientToken>bbbb</ClientToken></CreateHostedZoneRequest>`},
+			WantMatch: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := testCase.Request1.matchKey() == testCase.Request2.matchKey()
+
+			if got != testCase.WantMatch {
+				t.Errorf("got match %t, expected %t:\n%s\n\n%s", got, testCase.WantMatch, testCase.Request1.matchKey(), testCase.Request2.matchKey())
+			}
+		})
+	}
+}
+
+func TestRedactBody(t *testing.T) {
+	testCases := []struct {
+		Name string
+		Body string
+		Want string
+	}{
+		{
+			Name: "empty",
+		},
+		{
+			Name: "json",
+			Body: `{"Credentials":{"AccessKeyId":"AKIAEXAMPLE","SecretAccessKey":"se\"cret","SessionToken": "token"},"Name":"test"}`,
+			Want: `{"Credentials":{"AccessKeyId":"REDACTED","SecretAccessKey":"REDACTED","SessionToken": "REDACTED"},"Name":"test"}`,
+		},
+		{
+			Name: "query",
+			Body: "Action=CreateDBInstance&MasterUserPassword=secret&DBInstanceIdentifier=test",
+			Want: "Action=CreateDBInstance&MasterUserPassword=REDACTED&DBInstanceIdentifier=test",
+		},
+		{
+			Name: "query nested",
+			Body: "Action=Test&Settings.Password=secret",
+			Want: "Action=Test&Settings.Password=REDACTED",
+		},
+		{
+			Name: "xml",
+			Body: "<Credentials><AccessKeyId>AKIAEXAMPLE</AccessKeyId><SecretAccessKey>secret</SecretAccessKey><Expiration>2021-01-01T00:00:00Z</Expiration></Credentials>",
+			Want: "<Credentials><AccessKeyId>REDACTED</AccessKeyId><SecretAccessKey>REDACTED</SecretAccessKey><Expiration>2021-01-01T00:00:00Z</Expiration></Credentials>",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := redactBody(testCase.Body); got != testCase.Want {
+				t.Errorf("got %q, expected %q", got, testCase.Want)
+			}
+		})
+	}
+}
+
+func TestNewRecorder(t *testing.T) {
+	if _, err := NewRecorder("invalid", nil); err == nil {
+		t.Error("expected error for invalid mode")
+	}
+
+	for _, mode := range Mode_Values() {
+		recorder, err := NewRecorder(mode, nil)
+
+		if err != nil {
+			t.Fatalf("unexpected error for mode (%s): %s", mode, err)
+		}
+
+		if got := recorder.Mode(); got != mode {
+			t.Errorf("got mode %s, expected %s", got, mode)
+		}
+	}
+}
+
+func TestRecorderRecordAndReplay(t *testing.T) {
+	var requests int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&requests, 1)
+		body, _ := ioutil.ReadAll(r.Body)
+
+		w.Header().Set("X-Amzn-Requestid", "request")
+		w.Header().Set("Date", "Fri, 01 Jan 2021 00:00:00 GMT")
+
+		switch {
+		case strings.Contains(string(body), "Action=Create"):
+			w.Write([]byte("<CreateResponse><Credentials><SecretAccessKey>secret</SecretAccessKey></Credentials></CreateResponse>"))
+		case n < 4:
+			w.Write([]byte("<DescribeResponse><State>pending</State></DescribeResponse>"))
+		default:
+			w.Write([]byte("<DescribeResponse><State>available</State></DescribeResponse>"))
+		}
+	}))
+	defer server.Close()
+
+	recorder, err := NewRecorder(ModeRecord, http.DefaultTransport)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	recorder.Insert(&Cassette{Name: "TestRecorderRecordAndReplay"})
+
+	client := &http.Client{Transport: recorder.Wrap(http.DefaultTransport)}
+	post := func(t *testing.T, body string) string {
+		req, err := http.NewRequest(http.MethodPost, server.URL, strings.NewReader(body))
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		req.Header.Set("Authorization", "AWS4-HMAC-SHA256 Credential=AKIAEXAMPLE")
+		req.Header.Set("X-Amz-Date", "20210101T000000Z")
+
+		resp, err := client.Do(req)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		defer resp.Body.Close()
+
+		b, err := ioutil.ReadAll(resp.Body)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		return string(b)
+	}
+
+	if got, want := post(t, "Action=Create&ClientToken=aaaa"), "<CreateResponse><Credentials><SecretAccessKey>secret</SecretAccessKey></Credentials></CreateResponse>"; got != want {
+		t.Errorf("got recorded response %q, expected %q", got, want)
+	}
+
+	post(t, "Action=Describe")
+	post(t, "Action=Describe")
+	post(t, "Action=Describe")
+
+	filename := Filename(t.TempDir(), "TestRecorderRecordAndReplay/subtest")
+
+	if got, want := filepath.Base(filename), "TestRecorderRecordAndReplay_subtest.json"; got != want {
+		t.Errorf("got filename %s, expected %s", got, want)
+	}
+
+	if err := recorder.Eject().Save(filename); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cassette, err := Load(filename)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := len(cassette.Interactions), 4; got != want {
+		t.Fatalf("got %d interactions, expected %d", got, want)
+	}
+
+	for _, interaction := range cassette.Interactions {
+		for _, header := range []string{"Authorization", "X-Amz-Date", "Date"} {
+			if interaction.Request.Headers.Get(header) != "" || interaction.Response.Headers.Get(header) != "" {
+				t.Errorf("header %s recorded", header)
+			}
+		}
+	}
+
+	if got := cassette.Interactions[0].Response.Body; strings.Contains(got, "secret") {
+		t.Errorf("sensitive value recorded: %s", got)
+	}
+
+	server.Close()
+
+	recorder, err = NewRecorder(ModeReplay, nil)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	recorder.Insert(cassette)
+	client.Transport = recorder
+
+	if got, want := post(t, "Action=Create&ClientToken=bbbb"), "<CreateResponse><Credentials><SecretAccessKey>REDACTED</SecretAccessKey></Credentials></CreateResponse>"; got != want {
+		t.Errorf("got replayed response %q, expected %q", got, want)
+	}
+
+	for i, want := range []string{"pending", "pending", "available", "available"} {
+		if got := post(t, "Action=Describe"); !strings.Contains(got, want) {
+			t.Errorf("replay %d: got %q, expected state %s", i, got, want)
+		}
+	}
+
+	req, err := http.NewRequest(http.MethodPost, server.URL, strings.NewReader("Action=Delete"))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err := client.Do(req); err == nil {
+		t.Error("expected error replaying unrecorded request")
+	}
+
+	if got, want := atomic.LoadInt32(&requests), int32(4); got != want {
+		t.Errorf("got %d requests sent, expected %d", got, want)
+	}
+}
@@ -0,0 +1,119 @@
+package cassette
+
+import (
+	"encoding/json"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+var (
+	// volatileXMLFieldRegexp matches volatile fields of XML documents.
+	volatileXMLFieldRegexp = regexp.MustCompile(`<(` + strings.Join(volatileFields, "|") + `)>[^<]*</(?:` + strings.Join(volatileFields, "|") + `)>`)
+)
+
+// matchKey returns the key identifying equivalent requests: the method, the URL and body
+// without volatile fields such as signatures, timestamps and idempotency tokens, and the
+// operation of JSON protocol requests.
+func (r *Request) matchKey() string {
+	var target string
+
+	for k, v := range r.Headers {
+		if strings.EqualFold(k, "X-Amz-Target") && len(v) > 0 {
+			target = v[0]
+		}
+	}
+
+	return strings.Join([]string{r.Method, normalizeURL(r.URL), target, normalizeBody(r.Body)}, "\n")
+}
+
+// normalizeURL returns the URL with its query string sorted and without volatile fields.
+func normalizeURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+
+	if err != nil {
+		return rawURL
+	}
+
+	u.RawQuery = normalizeQuery(u.Query())
+
+	return u.String()
+}
+
+// normalizeBody returns a JSON, URL-encoded or XML body without volatile fields.
+func normalizeBody(body string) string {
+	trimmed := strings.TrimSpace(body)
+
+	if trimmed == "" {
+		return ""
+	}
+
+	if strings.HasPrefix(trimmed, "{") {
+		var v interface{}
+
+		if err := json.Unmarshal([]byte(trimmed), &v); err == nil {
+			// encoding/json sorts map keys.
+			if b, err := json.Marshal(removeVolatileJSONFields(v)); err == nil {
+				return string(b)
+			}
+		}
+	}
+
+	if strings.HasPrefix(trimmed, "<") {
+		return volatileXMLFieldRegexp.ReplaceAllString(trimmed, "")
+	}
+
+	if values, err := url.ParseQuery(trimmed); err == nil {
+		return normalizeQuery(values)
+	}
+
+	return trimmed
+}
+
+// normalizeQuery returns the values encoded in key order, without volatile fields,
+// including nested fields of the AWS Query protocol such as "Member.1.ClientToken".
+func normalizeQuery(values url.Values) string {
+	for k := range values {
+		field := k
+
+		if i := strings.LastIndex(k, "."); i >= 0 {
+			field = k[i+1:]
+		}
+
+		if isVolatileField(field) {
+			values.Del(k)
+		}
+	}
+
+	return values.Encode()
+}
+
+func removeVolatileJSONFields(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			if isVolatileField(k) {
+				delete(v, k)
+				continue
+			}
+
+			v[k] = removeVolatileJSONFields(e)
+		}
+	case []interface{}:
+		for i, e := range v {
+			v[i] = removeVolatileJSONFields(e)
+		}
+	}
+
+	return v
+}
+
+func isVolatileField(field string) bool {
+	for _, v := range volatileFields {
+		if strings.EqualFold(field, v) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,211 @@
+package cassette
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// minimumReplacementLength is the minimum length of a value substituted in replayed interactions.
+// Shorter values, such as counters, are too likely to appear elsewhere in recorded bodies.
+const minimumReplacementLength = 8
+
+var (
+	// tokenRegexp matches the tokens compared when learning substitutions.
+	tokenRegexp = regexp.MustCompile(`[A-Za-z0-9_-]+`)
+)
+
+// replay returns the response of a recorded interaction matching the request.
+//
+// A request matches the first unplayed recorded interaction with the same match key.
+// Once all such interactions have been played, the last is played again, so that
+// polling for a resource's state completes regardless of the number of polls.
+//
+// Acceptance tests name resources randomly, so a request can differ from its recording
+// by those names. A request without a matching interaction therefore matches the first
+// unplayed recorded interaction of the same operation whose tokens line up with the
+// request's, and the differing values are learned as substitutions. Learned values are
+// replaced by their recorded values in later requests before matching, and recorded
+// values are replaced by the learned values in replayed responses.
+func (r *Recorder) replay(req *http.Request, actual *Request) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.cassette == nil {
+		return nil, fmt.Errorf("no cassette inserted to replay %s %s", req.Method, req.URL)
+	}
+
+	translated := r.translateRequest(actual)
+	i, ok := r.matchInteraction(translated)
+
+	if !ok {
+		i, ok = r.matchOperation(translated)
+
+		if !ok {
+			return nil, fmt.Errorf("no interaction recorded in cassette (%s) matching %s %s", r.cassette.Name, req.Method, req.URL)
+		}
+
+		r.learnReplacements(r.cassette.Interactions[i].Request, translated)
+	}
+
+	r.played[i] = true
+
+	response := r.cassette.Interactions[i].Response
+	replacer := newReplacer(r.replacements)
+	header := http.Header{}
+
+	for k, v := range response.Headers {
+		for _, e := range v {
+			header.Add(k, replacer.Replace(e))
+		}
+	}
+
+	body := replacer.Replace(response.Body)
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", response.StatusCode, http.StatusText(response.StatusCode)),
+		StatusCode:    response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(strings.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+// translateRequest returns a copy of the request with learned values replaced by their recorded values.
+func (r *Recorder) translateRequest(actual *Request) *Request {
+	inverse := make(map[string]string, len(r.replacements))
+
+	for k, v := range r.replacements {
+		inverse[v] = k
+	}
+
+	replacer := newReplacer(inverse)
+
+	return &Request{
+		Method:  actual.Method,
+		URL:     replacer.Replace(actual.URL),
+		Headers: actual.Headers,
+		Body:    replacer.Replace(actual.Body),
+	}
+}
+
+// matchInteraction returns the index of the interaction to replay for the request by match key.
+func (r *Recorder) matchInteraction(request *Request) (int, bool) {
+	key := request.matchKey()
+	last := -1
+
+	for i, interaction := range r.cassette.Interactions {
+		if interaction.Request.matchKey() != key {
+			continue
+		}
+
+		if !r.played[i] {
+			return i, true
+		}
+
+		last = i
+	}
+
+	return last, last >= 0
+}
+
+// matchOperation returns the index of the first unplayed interaction of the request's operation
+// whose tokens line up with the request's.
+func (r *Recorder) matchOperation(request *Request) (int, bool) {
+	key := request.operationKey()
+	tokens := request.tokens()
+
+	for i, interaction := range r.cassette.Interactions {
+		if r.played[i] || interaction.Request.operationKey() != key {
+			continue
+		}
+
+		if len(interaction.Request.tokens()) == len(tokens) {
+			return i, true
+		}
+	}
+
+	return -1, false
+}
+
+// learnReplacements records the values differing between the recorded and actual requests.
+func (r *Recorder) learnReplacements(recorded *Request, actual *Request) {
+	recordedTokens := recorded.tokens()
+	actualTokens := actual.tokens()
+
+	for i, recordedToken := range recordedTokens {
+		actualToken := actualTokens[i]
+
+		if recordedToken == actualToken || len(recordedToken) < minimumReplacementLength || len(actualToken) < minimumReplacementLength {
+			continue
+		}
+
+		if _, ok := r.replacements[recordedToken]; !ok {
+			r.replacements[recordedToken] = actualToken
+		}
+	}
+}
+
+// operationKey returns the key identifying the request's API operation:
+// the method, the JSON protocol target, and the Query protocol action.
+func (r *Request) operationKey() string {
+	var target string
+
+	for k, v := range r.Headers {
+		if strings.EqualFold(k, "X-Amz-Target") && len(v) > 0 {
+			target = v[0]
+		}
+	}
+
+	var action string
+
+	if u, err := url.Parse(r.URL); err == nil {
+		action = u.Query().Get("Action")
+	}
+
+	if action == "" {
+		if values, err := url.ParseQuery(strings.TrimSpace(r.Body)); err == nil {
+			action = values.Get("Action")
+		}
+	}
+
+	return strings.Join([]string{r.Method, target, action}, "\n")
+}
+
+// tokens returns the tokens of the request's normalized URL and body.
+func (r *Request) tokens() []string {
+	return tokenRegexp.FindAllString(normalizeURL(r.URL)+"\n"+normalizeBody(r.Body), -1)
+}
+
+// newReplacer returns a strings.Replacer replacing each key by its value, longest keys first.
+func newReplacer(replacements map[string]string) *strings.Replacer {
+	keys := make([]string, 0, len(replacements))
+
+	for k := range replacements {
+		keys = append(keys, k)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		if len(keys[i]) != len(keys[j]) {
+			return len(keys[i]) > len(keys[j])
+		}
+
+		return keys[i] < keys[j]
+	})
+
+	oldnew := make([]string, 0, 2*len(keys))
+
+	for _, k := range keys {
+		oldnew = append(oldnew, k, replacements[k])
+	}
+
+	return strings.NewReplacer(oldnew...)
+}
@@ -0,0 +1,76 @@
+package cassette
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestRecorderReplay_Substitution(t *testing.T) {
+	cassette := &Cassette{
+		Name: "TestRecorderReplay_Substitution",
+		Interactions: []*Interaction{
+			{
+				Request:  &Request{Method: "POST", URL: "https://sqs.us-west-2.amazonaws.com/", Body: "Action=CreateQueue&QueueName=tf-acc-test-1111111111&Version=2012-11-05"},
+				Response: &Response{StatusCode: 200, Body: "<QueueUrl>https://sqs.us-west-2.amazonaws.com/123456789012/tf-acc-test-1111111111</QueueUrl>"},
+			},
+			{
+				Request:  &Request{Method: "POST", URL: "https://sqs.us-west-2.amazonaws.com/", Body: "Action=GetQueueAttributes&QueueUrl=https%3A%2F%2Fsqs.us-west-2.amazonaws.com%2F123456789012%2Ftf-acc-test-1111111111&Version=2012-11-05"},
+				Response: &Response{StatusCode: 200, Headers: http.Header{"X-Queue": {"tf-acc-test-1111111111"}}, Body: "<QueueArn>arn:aws:sqs:us-west-2:123456789012:tf-acc-test-1111111111</QueueArn>"},
+			},
+		},
+	}
+
+	recorder, err := NewRecorder(ModeReplay, nil)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	recorder.Insert(cassette)
+
+	client := &http.Client{Transport: recorder}
+	post := func(t *testing.T, body string) *http.Response {
+		resp, err := client.Post("https://sqs.us-west-2.amazonaws.com/", "application/x-www-form-urlencoded", strings.NewReader(body))
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		return resp
+	}
+	readBody := func(t *testing.T, resp *http.Response) string {
+		defer resp.Body.Close()
+
+		b, err := ioutil.ReadAll(resp.Body)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		return string(b)
+	}
+
+	resp := post(t, "Action=CreateQueue&QueueName=tf-acc-test-2222222222&Version=2012-11-05")
+
+	if got, want := readBody(t, resp), "<QueueUrl>https://sqs.us-west-2.amazonaws.com/123456789012/tf-acc-test-2222222222</QueueUrl>"; got != want {
+		t.Errorf("got %q, expected %q", got, want)
+	}
+
+	for i := 0; i < 2; i++ {
+		resp = post(t, "Action=GetQueueAttributes&QueueUrl=https%3A%2F%2Fsqs.us-west-2.amazonaws.com%2F123456789012%2Ftf-acc-test-2222222222&Version=2012-11-05")
+
+		if got, want := resp.Header.Get("X-Queue"), "tf-acc-test-2222222222"; got != want {
+			t.Errorf("got header %q, expected %q", got, want)
+		}
+
+		if got, want := readBody(t, resp), "<QueueArn>arn:aws:sqs:us-west-2:123456789012:tf-acc-test-2222222222</QueueArn>"; got != want {
+			t.Errorf("got %q, expected %q", got, want)
+		}
+	}
+
+	if _, err := client.Post("https://sqs.us-west-2.amazonaws.com/", "application/x-www-form-urlencoded", strings.NewReader("Action=CreateQueue&QueueName=tf-acc-test-3333333333&Version=2012-11-05")); err == nil {
+		t.Error("expected error replaying request after its interactions were played")
+	}
+}
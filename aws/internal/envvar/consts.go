@@ -47,6 +47,15 @@ const (
 	// For tests requiring restricted IAM permissions, an existing IAM Role to assume
 	// An inline assume role policy is then used to deny actions for the test
 	TfAccAssumeRoleArn = "TF_ACC_ASSUME_ROLE_ARN"
+
+	// For running acceptance tests against recorded AWS API interactions,
+	// the directory of the cassette files. Defaults to testdata/cassettes.
+	TfAccCassetteDir = "TF_ACC_CASSETTE_DIR"
+
+	// For running acceptance tests against recorded AWS API interactions, either
+	// record, to record interactions with AWS, or replay, to replay recorded
+	// interactions without AWS credentials or network access
+	TfAccCassetteMode = "TF_ACC_CASSETTE_MODE"
 )
 
 // Custom environment variables used for assuming a role with resource sweepers
@@ -0,0 +1,87 @@
+package keyvaluetags
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// KeyCaseConfig contains the canonical casing of tag keys, used to normalize
+// tag keys that differ from a canonical key only in case.
+type KeyCaseConfig struct {
+	// Keys maps lowercase tag keys to their canonical casing.
+	Keys map[string]string
+}
+
+// NewKeyCaseConfig returns a KeyCaseConfig for the canonical tag keys.
+// Returns an error if two canonical keys differ only in case.
+func NewKeyCaseConfig(canonicalKeys []string) (*KeyCaseConfig, error) {
+	config := &KeyCaseConfig{
+		Keys: make(map[string]string, len(canonicalKeys)),
+	}
+
+	for _, key := range canonicalKeys {
+		lowerKey := strings.ToLower(key)
+
+		if existing, ok := config.Keys[lowerKey]; ok && existing != key {
+			return nil, fmt.Errorf("canonical tag keys %q and %q differ only in case", existing, key)
+		}
+
+		config.Keys[lowerKey] = key
+	}
+
+	return config, nil
+}
+
+// CanonicalKey returns the canonical casing of the tag key,
+// or the key itself if it has no canonical casing.
+func (config *KeyCaseConfig) CanonicalKey(key string) string {
+	if config == nil {
+		return key
+	}
+
+	if canonicalKey, ok := config.Keys[strings.ToLower(key)]; ok {
+		return canonicalKey
+	}
+
+	return key
+}
+
+// NormalizeKeyCase returns tags with keys replaced by their canonical casing.
+// Also returns a sorted description of each collision, where several tag keys
+// normalize to the same canonical key. The value of a colliding key that is
+// already canonical is kept, otherwise that of the first colliding key in sort order.
+func (tags KeyValueTags) NormalizeKeyCase(config *KeyCaseConfig) (KeyValueTags, []string) {
+	if config == nil {
+		return tags, nil
+	}
+
+	keysByCanonicalKey := make(map[string][]string)
+
+	for k := range tags {
+		canonicalKey := config.CanonicalKey(k)
+		keysByCanonicalKey[canonicalKey] = append(keysByCanonicalKey[canonicalKey], k)
+	}
+
+	result := make(KeyValueTags, len(keysByCanonicalKey))
+	var collisions []string
+
+	for canonicalKey, keys := range keysByCanonicalKey {
+		sort.Strings(keys)
+
+		if len(keys) > 1 {
+			collisions = append(collisions, fmt.Sprintf("tag keys %q normalize to %q", keys, canonicalKey))
+		}
+
+		if v, ok := tags[canonicalKey]; ok {
+			result[canonicalKey] = v
+			continue
+		}
+
+		result[canonicalKey] = tags[keys[0]]
+	}
+
+	sort.Strings(collisions)
+
+	return result, collisions
+}
@@ -0,0 +1,98 @@
+package keyvaluetags
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNewKeyCaseConfig(t *testing.T) {
+	config, err := NewKeyCaseConfig([]string{"Environment", "CostCenter", "Environment"})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := map[string]string{
+		"costcenter":  "CostCenter",
+		"environment": "Environment",
+	}
+
+	if !reflect.DeepEqual(config.Keys, want) {
+		t.Errorf("got %v, want %v", config.Keys, want)
+	}
+
+	if _, err := NewKeyCaseConfig([]string{"Environment", "ENVIRONMENT"}); err == nil {
+		t.Errorf("expected error for canonical keys differing only in case")
+	}
+}
+
+func TestKeyValueTagsNormalizeKeyCase(t *testing.T) {
+	config, err := NewKeyCaseConfig([]string{"Environment", "CostCenter"})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := []struct {
+		name           string
+		config         *KeyCaseConfig
+		tags           KeyValueTags
+		want           map[string]string
+		wantCollisions []string
+	}{
+		{
+			name:   "nil config",
+			config: nil,
+			tags:   New(map[string]string{"environment": "prod"}),
+			want:   map[string]string{"environment": "prod"},
+		},
+		{
+			name:   "normalized",
+			config: config,
+			tags: New(map[string]string{
+				"environment": "prod",
+				"COSTCENTER":  "100",
+				"Name":        "test",
+			}),
+			want: map[string]string{
+				"Environment": "prod",
+				"CostCenter":  "100",
+				"Name":        "test",
+			},
+		},
+		{
+			name:   "collision with canonical key",
+			config: config,
+			tags: New(map[string]string{
+				"environment": "dev",
+				"Environment": "prod",
+			}),
+			want:           map[string]string{"Environment": "prod"},
+			wantCollisions: []string{`tag keys ["Environment" "environment"] normalize to "Environment"`},
+		},
+		{
+			name:   "collision without canonical key",
+			config: config,
+			tags: New(map[string]string{
+				"environment": "dev",
+				"ENVIRONMENT": "prod",
+			}),
+			want:           map[string]string{"Environment": "prod"},
+			wantCollisions: []string{`tag keys ["ENVIRONMENT" "environment"] normalize to "Environment"`},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got, gotCollisions := testCase.tags.NormalizeKeyCase(testCase.config)
+
+			if !reflect.DeepEqual(got.Map(), testCase.want) {
+				t.Errorf("got %v, want %v", got.Map(), testCase.want)
+			}
+
+			if !reflect.DeepEqual(gotCollisions, testCase.wantCollisions) {
+				t.Errorf("got collisions %q, want %q", gotCollisions, testCase.wantCollisions)
+			}
+		})
+	}
+}
@@ -28,12 +28,109 @@ const (
 // DefaultConfig contains tags to default across all resources.
 type DefaultConfig struct {
 	Tags KeyValueTags
+
+	// ExcludeResourceTypes are the resource types, e.g. "aws_autoscaling_group",
+	// to which no tags are defaulted.
+	ExcludeResourceTypes []string
 }
 
 // IgnoreConfig contains various options for removing resource tags.
 type IgnoreConfig struct {
 	Keys        KeyValueTags
 	KeyPrefixes KeyValueTags
+	KeySuffixes KeyValueTags
+	KeyRegexes  []*regexp.Regexp
+	Rules       []*IgnoreRule
+}
+
+// IgnoreRule removes resource tags whose key and value both match.
+// A nil regular expression matches any key or value.
+type IgnoreRule struct {
+	KeyRegex   *regexp.Regexp
+	ValueRegex *regexp.Regexp
+}
+
+// Match returns true if the tag key and value match the rule.
+func (rule *IgnoreRule) Match(k string, v *TagData) bool {
+	if rule.KeyRegex != nil && !rule.KeyRegex.MatchString(k) {
+		return false
+	}
+
+	if rule.ValueRegex == nil {
+		return true
+	}
+
+	if v == nil || v.Value == nil {
+		return rule.ValueRegex.MatchString("")
+	}
+
+	return rule.ValueRegex.MatchString(*v.Value)
+}
+
+// RequiredConfig contains tags that all taggable resources must have.
+type RequiredConfig struct {
+	// EnforcementLevel is the severity of violations, RequiredEnforcementLevelError or RequiredEnforcementLevelWarn.
+	EnforcementLevel string
+
+	Tags []*RequiredTag
+}
+
+const (
+	// RequiredEnforcementLevelError fails plans of resources violating the required tags configuration.
+	RequiredEnforcementLevelError = "error"
+
+	// RequiredEnforcementLevelWarn logs warnings for resources violating the required tags configuration.
+	RequiredEnforcementLevelWarn = "warn"
+)
+
+// RequiredEnforcementLevel_Values returns all required tags enforcement levels.
+func RequiredEnforcementLevel_Values() []string {
+	return []string{
+		RequiredEnforcementLevelError,
+		RequiredEnforcementLevelWarn,
+	}
+}
+
+// RequiredTag is a tag key that resources must have, optionally with a value matching a regular expression.
+type RequiredTag struct {
+	Key        string
+	ValueRegex *regexp.Regexp
+}
+
+// Violations returns the sorted keys of required tags missing from the given tags
+// and the sorted keys of required tags whose values do not match.
+func (rc *RequiredConfig) Violations(tags KeyValueTags) (missing []string, invalid []string) {
+	if rc == nil {
+		return nil, nil
+	}
+
+	for _, requiredTag := range rc.Tags {
+		v, ok := tags[requiredTag.Key]
+
+		if !ok {
+			missing = append(missing, requiredTag.Key)
+			continue
+		}
+
+		if requiredTag.ValueRegex == nil {
+			continue
+		}
+
+		var value string
+
+		if v != nil && v.Value != nil {
+			value = *v.Value
+		}
+
+		if !requiredTag.ValueRegex.MatchString(value) {
+			invalid = append(invalid, requiredTag.Key)
+		}
+	}
+
+	sort.Strings(missing)
+	sort.Strings(invalid)
+
+	return missing, invalid
 }
 
 // KeyValueTags is a standard implementation for AWS key-value resource tags.
@@ -64,6 +161,32 @@ func (dc *DefaultConfig) GetTags() KeyValueTags {
 	return dc.Tags
 }
 
+// ForResource returns the configuration of tags defaulted to a resource of the given type,
+// excluding any tags with the given keys. If no tags are excluded, the configuration
+// itself is returned.
+func (dc *DefaultConfig) ForResource(resourceType string, excludeKeys KeyValueTags) *DefaultConfig {
+	if dc == nil || len(dc.Tags) == 0 {
+		return dc
+	}
+
+	for _, v := range dc.ExcludeResourceTypes {
+		if v == resourceType {
+			return &DefaultConfig{ExcludeResourceTypes: dc.ExcludeResourceTypes}
+		}
+	}
+
+	tags := dc.Tags.Ignore(excludeKeys)
+
+	if len(tags) == len(dc.Tags) {
+		return dc
+	}
+
+	return &DefaultConfig{
+		Tags:                 tags,
+		ExcludeResourceTypes: dc.ExcludeResourceTypes,
+	}
+}
+
 // MergeTags returns the result of keyvaluetags.Merge() on the given
 // DefaultConfig.Tags with KeyValueTags provided as an argument,
 // overriding the value of any tag with a matching key.
@@ -101,6 +224,9 @@ func (tags KeyValueTags) IgnoreConfig(config *IgnoreConfig) KeyValueTags {
 	}
 
 	result := tags.IgnorePrefixes(config.KeyPrefixes)
+	result = result.IgnoreSuffixes(config.KeySuffixes)
+	result = result.IgnoreRegexes(config.KeyRegexes)
+	result = result.IgnoreRules(config.Rules)
 	result = result.Ignore(config.Keys)
 
 	return result
@@ -153,6 +279,54 @@ func (tags KeyValueTags) IgnorePrefixes(ignoreTagPrefixes KeyValueTags) KeyValue
 	return result
 }
 
+// IgnoreRegexes returns tag keys not matching any of the regular expressions.
+func (tags KeyValueTags) IgnoreRegexes(ignoreTagRegexes []*regexp.Regexp) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		var ignore bool
+
+		for _, ignoreTagRegex := range ignoreTagRegexes {
+			if ignoreTagRegex.MatchString(k) {
+				ignore = true
+				break
+			}
+		}
+
+		if ignore {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
+// IgnoreRules returns tags not matching any of the rules.
+func (tags KeyValueTags) IgnoreRules(rules []*IgnoreRule) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		var ignore bool
+
+		for _, rule := range rules {
+			if rule.Match(k, v) {
+				ignore = true
+				break
+			}
+		}
+
+		if ignore {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
 // IgnoreRDS returns non-AWS and non-RDS tag keys.
 func (tags KeyValueTags) IgnoreRds() KeyValueTags {
 	result := make(KeyValueTags)
@@ -191,6 +365,30 @@ func (tags KeyValueTags) IgnoreServerlessApplicationRepository() KeyValueTags {
 	return result
 }
 
+// IgnoreSuffixes returns non-matching tag key suffixes.
+func (tags KeyValueTags) IgnoreSuffixes(ignoreTagSuffixes KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		var ignore bool
+
+		for ignoreTagSuffix := range ignoreTagSuffixes {
+			if strings.HasSuffix(k, ignoreTagSuffix) {
+				ignore = true
+				break
+			}
+		}
+
+		if ignore {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
 // Ignore returns non-matching tag keys.
 func (tags KeyValueTags) Ignore(ignoreTags KeyValueTags) KeyValueTags {
 	result := make(KeyValueTags)
@@ -1,6 +1,8 @@
 package keyvaluetags
 
 import (
+	"reflect"
+	"regexp"
 	"testing"
 )
 
@@ -43,6 +45,82 @@ func TestKeyValueTagsDefaultConfigGetTags(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsDefaultConfigForResource(t *testing.T) {
+	defaultConfig := &DefaultConfig{
+		Tags: New(map[string]string{
+			"key1": "value1",
+			"key2": "value2",
+		}),
+		ExcludeResourceTypes: []string{"aws_excluded"},
+	}
+
+	testCases := []struct {
+		name          string
+		defaultConfig *DefaultConfig
+		resourceType  string
+		excludeKeys   KeyValueTags
+		want          map[string]string
+		wantSame      bool
+	}{
+		{
+			name:          "no config",
+			defaultConfig: nil,
+			resourceType:  "aws_test",
+			excludeKeys:   New([]string{"key1"}),
+			want:          map[string]string{},
+			wantSame:      true,
+		},
+		{
+			name:          "no exclusions",
+			defaultConfig: defaultConfig,
+			resourceType:  "aws_test",
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+			wantSame: true,
+		},
+		{
+			name:          "exclude missing key",
+			defaultConfig: defaultConfig,
+			resourceType:  "aws_test",
+			excludeKeys:   New([]string{"key3"}),
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+			wantSame: true,
+		},
+		{
+			name:          "exclude key",
+			defaultConfig: defaultConfig,
+			resourceType:  "aws_test",
+			excludeKeys:   New([]string{"key1"}),
+			want: map[string]string{
+				"key2": "value2",
+			},
+		},
+		{
+			name:          "exclude resource type",
+			defaultConfig: defaultConfig,
+			resourceType:  "aws_excluded",
+			want:          map[string]string{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.defaultConfig.ForResource(testCase.resourceType, testCase.excludeKeys)
+
+			if same := got == testCase.defaultConfig; same != testCase.wantSame {
+				t.Errorf("got same config %t, want %t", same, testCase.wantSame)
+			}
+
+			testKeyValueTagsVerifyMap(t, got.GetTags().Map(), testCase.want)
+		})
+	}
+}
+
 func TestKeyValueTagsDefaultConfigMergeTags(t *testing.T) {
 	testCases := []struct {
 		name          string
@@ -291,6 +369,72 @@ func TestKeyValueTagsDefaultConfigTagsEqual(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsRequiredConfigViolations(t *testing.T) {
+	requiredConfig := &RequiredConfig{
+		EnforcementLevel: RequiredEnforcementLevelError,
+		Tags: []*RequiredTag{
+			{Key: "Owner"},
+			{Key: "Environment", ValueRegex: regexp.MustCompile(`^(dev|prod)$`)},
+			{Key: "CostCenter"},
+		},
+	}
+
+	testCases := []struct {
+		name           string
+		requiredConfig *RequiredConfig
+		tags           KeyValueTags
+		wantMissing    []string
+		wantInvalid    []string
+	}{
+		{
+			name:           "no config",
+			requiredConfig: nil,
+			tags:           New(map[string]string{}),
+		},
+		{
+			name:           "all present",
+			requiredConfig: requiredConfig,
+			tags: New(map[string]string{
+				"CostCenter":  "1234",
+				"Environment": "prod",
+				"Owner":       "team",
+			}),
+		},
+		{
+			name:           "missing",
+			requiredConfig: requiredConfig,
+			tags: New(map[string]string{
+				"Environment": "dev",
+			}),
+			wantMissing: []string{"CostCenter", "Owner"},
+		},
+		{
+			name:           "invalid value",
+			requiredConfig: requiredConfig,
+			tags: New(map[string]string{
+				"CostCenter":  "1234",
+				"Environment": "test",
+			}),
+			wantMissing: []string{"Owner"},
+			wantInvalid: []string{"Environment"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			gotMissing, gotInvalid := testCase.requiredConfig.Violations(testCase.tags)
+
+			if !reflect.DeepEqual(gotMissing, testCase.wantMissing) {
+				t.Errorf("got missing %v, want %v", gotMissing, testCase.wantMissing)
+			}
+
+			if !reflect.DeepEqual(gotInvalid, testCase.wantInvalid) {
+				t.Errorf("got invalid %v, want %v", gotInvalid, testCase.wantInvalid)
+			}
+		})
+	}
+}
+
 func TestKeyValueTagsIgnoreAws(t *testing.T) {
 	testCases := []struct {
 		name string
@@ -533,6 +677,57 @@ func TestKeyValueTagsIgnoreConfig(t *testing.T) {
 				"key3": "value3",
 			},
 		},
+		{
+			name: "key suffixes",
+			tags: New(map[string]string{
+				"app:cost-center": "value1",
+				"key2":            "value2",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				KeySuffixes: New([]string{
+					":cost-center",
+				}),
+			},
+			want: map[string]string{
+				"key2": "value2",
+			},
+		},
+		{
+			name: "key regexes",
+			tags: New(map[string]string{
+				"finops:cost-center:team": "value1",
+				"cost-center":             "value2",
+				"key3":                    "value3",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				KeyRegexes: []*regexp.Regexp{
+					regexp.MustCompile(`^.*:cost-center:.*$`),
+				},
+			},
+			want: map[string]string{
+				"cost-center": "value2",
+				"key3":        "value3",
+			},
+		},
+		{
+			name: "rules",
+			tags: New(map[string]string{
+				"aws-backup:source": "backup-plan-1",
+				"managed-by":        "control-tower",
+				"owner":             "control-tower",
+				"key4":              "value4",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				Rules: []*IgnoreRule{
+					{KeyRegex: regexp.MustCompile(`^aws-backup:`)},
+					{KeyRegex: regexp.MustCompile(`^managed-by$`), ValueRegex: regexp.MustCompile(`^control-tower$`)},
+					{ValueRegex: regexp.MustCompile(`^value`)},
+				},
+			},
+			want: map[string]string{
+				"owner": "control-tower",
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
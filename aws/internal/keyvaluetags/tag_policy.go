@@ -0,0 +1,138 @@
+package keyvaluetags
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// TagPolicy is an AWS Organizations tag policy, as used to validate resource tags.
+// Reference: https://docs.aws.amazon.com/organizations/latest/userguide/orgs_manage_policies_tag-policies-syntax.html
+type TagPolicy struct {
+	// Rules are the policy's tag rules, keyed by lowercase tag key.
+	Rules map[string]*TagPolicyRule
+}
+
+// TagPolicyRule is the rule of a tag policy for a single tag key.
+type TagPolicyRule struct {
+	// Key is the required capitalization of the tag key, if any.
+	Key string
+
+	// Values are the allowed tag values, if restricted.
+	// A value ending with "*" allows all values with that prefix.
+	Values []string
+}
+
+// tagPolicyOperatorAssign is the tag policy inheritance operator that sets a value.
+const tagPolicyOperatorAssign = "@@assign"
+
+// ParseTagPolicy parses the JSON content of an AWS Organizations (effective) tag policy.
+func ParseTagPolicy(content string) (*TagPolicy, error) {
+	var document struct {
+		Tags map[string]struct {
+			TagKey   json.RawMessage `json:"tag_key"`
+			TagValue json.RawMessage `json:"tag_value"`
+		} `json:"tags"`
+	}
+
+	if err := json.Unmarshal([]byte(content), &document); err != nil {
+		return nil, fmt.Errorf("error parsing tag policy: %w", err)
+	}
+
+	policy := &TagPolicy{
+		Rules: make(map[string]*TagPolicyRule, len(document.Tags)),
+	}
+
+	for k, v := range document.Tags {
+		rule := &TagPolicyRule{}
+
+		if len(v.TagKey) > 0 {
+			if err := unmarshalTagPolicyValue(v.TagKey, &rule.Key); err != nil {
+				return nil, fmt.Errorf("error parsing tag policy tag_key for %q: %w", k, err)
+			}
+		}
+
+		if len(v.TagValue) > 0 {
+			if err := unmarshalTagPolicyValue(v.TagValue, &rule.Values); err != nil {
+				return nil, fmt.Errorf("error parsing tag policy tag_value for %q: %w", k, err)
+			}
+		}
+
+		policy.Rules[strings.ToLower(k)] = rule
+	}
+
+	return policy, nil
+}
+
+// unmarshalTagPolicyValue unmarshals a tag policy value, which may be wrapped in an "@@assign" operator.
+func unmarshalTagPolicyValue(data json.RawMessage, v interface{}) error {
+	var operators map[string]json.RawMessage
+
+	if err := json.Unmarshal(data, &operators); err == nil {
+		if assigned, ok := operators[tagPolicyOperatorAssign]; ok {
+			data = assigned
+		}
+	}
+
+	return json.Unmarshal(data, v)
+}
+
+// Violations returns a sorted description of each tag not compliant with the tag policy.
+// Tags with keys that the policy does not cover are compliant.
+func (tp *TagPolicy) Violations(tags KeyValueTags) []string {
+	if tp == nil {
+		return nil
+	}
+
+	var violations []string
+
+	for k, v := range tags {
+		rule, ok := tp.Rules[strings.ToLower(k)]
+
+		if !ok {
+			continue
+		}
+
+		if rule.Key != "" && k != rule.Key {
+			violations = append(violations, fmt.Sprintf("tag key %q must be capitalized as %q", k, rule.Key))
+		}
+
+		if len(rule.Values) == 0 {
+			continue
+		}
+
+		var value string
+
+		if v != nil && v.Value != nil {
+			value = *v.Value
+		}
+
+		if !rule.allows(value) {
+			violations = append(violations, fmt.Sprintf("tag %q value %q is not one of the allowed values: %s", k, value, strings.Join(rule.Values, ", ")))
+		}
+	}
+
+	sort.Strings(violations)
+
+	return violations
+}
+
+// allows returns true if the rule allows the tag value.
+func (rule *TagPolicyRule) allows(value string) bool {
+	for _, allowed := range rule.Values {
+		if prefix := strings.TrimSuffix(allowed, "*"); prefix != allowed {
+			if strings.HasPrefix(value, prefix) {
+				return true
+			}
+
+			continue
+		}
+
+		if value == allowed {
+			return true
+		}
+	}
+
+	return false
+}
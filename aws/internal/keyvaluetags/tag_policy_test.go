@@ -0,0 +1,105 @@
+package keyvaluetags
+
+import (
+	"reflect"
+	"testing"
+)
+
+const testTagPolicyContent = `{
+  "tags": {
+    "costcenter": {
+      "tag_key": {"@@assign": "CostCenter"},
+      "tag_value": {"@@assign": ["100", "200*"]},
+      "enforced_for": {"@@assign": ["ec2:instance"]}
+    },
+    "owner": {
+      "tag_key": "Owner"
+    },
+    "project": {
+      "tag_value": ["Alpha"]
+    }
+  }
+}`
+
+func TestParseTagPolicy(t *testing.T) {
+	got, err := ParseTagPolicy(testTagPolicyContent)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := &TagPolicy{
+		Rules: map[string]*TagPolicyRule{
+			"costcenter": {Key: "CostCenter", Values: []string{"100", "200*"}},
+			"owner":      {Key: "Owner"},
+			"project":    {Values: []string{"Alpha"}},
+		},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %#v, want %#v", got, want)
+	}
+
+	if _, err := ParseTagPolicy(`{"tags": [`); err == nil {
+		t.Errorf("expected error parsing invalid content")
+	}
+}
+
+func TestTagPolicyViolations(t *testing.T) {
+	policy, err := ParseTagPolicy(testTagPolicyContent)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := []struct {
+		name   string
+		policy *TagPolicy
+		tags   KeyValueTags
+		want   []string
+	}{
+		{
+			name:   "no policy",
+			policy: nil,
+			tags:   New(map[string]string{"costcenter": "999"}),
+		},
+		{
+			name:   "compliant",
+			policy: policy,
+			tags: New(map[string]string{
+				"CostCenter": "2001",
+				"Owner":      "team",
+				"Project":    "Alpha",
+				"Other":      "value",
+			}),
+		},
+		{
+			name:   "key capitalization",
+			policy: policy,
+			tags:   New(map[string]string{"owner": "team"}),
+			want:   []string{`tag key "owner" must be capitalized as "Owner"`},
+		},
+		{
+			name:   "value not allowed",
+			policy: policy,
+			tags: New(map[string]string{
+				"CostCenter": "300",
+				"project":    "Beta",
+			}),
+			want: []string{
+				`tag "CostCenter" value "300" is not one of the allowed values: 100, 200*`,
+				`tag "project" value "Beta" is not one of the allowed values: Alpha`,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.policy.Violations(testCase.tags)
+
+			if !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("got %q, want %q", got, testCase.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,181 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+const (
+	// minFillRate is the lowest rate, in requests per second, to which the limiter backs off.
+	minFillRate = 0.5
+
+	// throttleBackoffFactor is the multiplicative decrease applied to the request rate on throttling.
+	throttleBackoffFactor = 0.7
+
+	// measurementInterval is the interval over which the sent request rate is measured.
+	measurementInterval = 500 * time.Millisecond
+
+	// measurementSmoothing is the weight given to the most recent request rate measurement.
+	measurementSmoothing = 0.8
+)
+
+// AdaptiveLimiter is a client-side token bucket rate limiter which adapts its
+// rate to throttling responses from a service.
+//
+// The limiter does not delay requests until the first throttling response is
+// seen. It then limits requests to a fraction of the rate measured before
+// throttling (multiplicative decrease) and raises the limit by roughly one
+// request per second for each second of successful requests (additive increase).
+//
+// An AdaptiveLimiter is safe for concurrent use, so a single limiter can be
+// shared by all requests made to a service.
+type AdaptiveLimiter struct {
+	mu sync.Mutex
+
+	enabled  bool
+	fillRate float64
+	tokens   float64
+	capacity float64
+	lastFill time.Time
+
+	measuredRate     float64
+	measurementCount int
+	measurementStart time.Time
+
+	now   func() time.Time
+	sleep func(context.Context, time.Duration) error
+}
+
+// NewAdaptiveLimiter returns a new, not yet enabled, AdaptiveLimiter.
+func NewAdaptiveLimiter() *AdaptiveLimiter {
+	return &AdaptiveLimiter{
+		now:   time.Now,
+		sleep: sleepWithContext,
+	}
+}
+
+// Acquire blocks until the limiter permits a request to be sent or the context is done.
+func (l *AdaptiveLimiter) Acquire(ctx context.Context) error {
+	for {
+		l.mu.Lock()
+
+		now := l.now()
+		l.measure(now)
+
+		if !l.enabled {
+			l.mu.Unlock()
+
+			return nil
+		}
+
+		l.refill(now)
+
+		if l.tokens >= 1 {
+			l.tokens--
+			l.mu.Unlock()
+
+			return nil
+		}
+
+		wait := time.Duration((1 - l.tokens) / l.fillRate * float64(time.Second))
+		l.mu.Unlock()
+
+		if err := l.sleep(ctx, wait); err != nil {
+			return err
+		}
+	}
+}
+
+// Update adjusts the limiter's rate following a response from the service.
+func (l *AdaptiveLimiter) Update(throttled bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+
+	if throttled {
+		rate := l.measuredRate
+
+		if l.enabled {
+			rate = math.Min(rate, l.fillRate)
+		}
+
+		l.refill(now)
+		l.setFillRate(rate * throttleBackoffFactor)
+		l.enabled = true
+
+		return
+	}
+
+	if !l.enabled {
+		return
+	}
+
+	l.refill(now)
+	l.setFillRate(l.fillRate + 1/l.fillRate)
+}
+
+// Enabled returns whether the limiter is currently limiting requests.
+func (l *AdaptiveLimiter) Enabled() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.enabled
+}
+
+// FillRate returns the limiter's current rate, in requests per second.
+func (l *AdaptiveLimiter) FillRate() float64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.fillRate
+}
+
+// setFillRate sets the token fill rate and bucket capacity.
+// The caller must hold the lock.
+func (l *AdaptiveLimiter) setFillRate(rate float64) {
+	l.fillRate = math.Max(rate, minFillRate)
+	l.capacity = math.Max(l.fillRate, 1)
+	l.tokens = math.Min(l.tokens, l.capacity)
+}
+
+// refill adds the tokens accrued since the last refill.
+// The caller must hold the lock.
+func (l *AdaptiveLimiter) refill(now time.Time) {
+	if !l.lastFill.IsZero() {
+		l.tokens = math.Min(l.capacity, l.tokens+now.Sub(l.lastFill).Seconds()*l.fillRate)
+	}
+
+	l.lastFill = now
+}
+
+// measure records a request attempt and updates the smoothed request rate.
+// The caller must hold the lock.
+func (l *AdaptiveLimiter) measure(now time.Time) {
+	if l.measurementStart.IsZero() {
+		l.measurementStart = now
+	}
+
+	l.measurementCount++
+
+	if elapsed := now.Sub(l.measurementStart); elapsed >= measurementInterval {
+		rate := float64(l.measurementCount) / elapsed.Seconds()
+		l.measuredRate = rate*measurementSmoothing + l.measuredRate*(1-measurementSmoothing)
+		l.measurementCount = 0
+		l.measurementStart = now
+	}
+}
+
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
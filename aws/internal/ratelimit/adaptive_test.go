@@ -0,0 +1,143 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+type testClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+func (c *testClock) Sleep(ctx context.Context, d time.Duration) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+
+	return nil
+}
+
+func newTestLimiter() (*AdaptiveLimiter, *testClock) {
+	clock := &testClock{now: time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC)}
+	l := NewAdaptiveLimiter()
+	l.now = clock.Now
+	l.sleep = clock.Sleep
+
+	return l, clock
+}
+
+// sendAt acquires tokens for n requests spread evenly over d.
+func sendAt(t *testing.T, l *AdaptiveLimiter, clock *testClock, n int, d time.Duration) {
+	for i := 0; i < n; i++ {
+		if err := l.Acquire(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		clock.Sleep(context.Background(), d/time.Duration(n)) //nolint:errcheck
+	}
+}
+
+func TestAdaptiveLimiter_NotEnabledUntilThrottled(t *testing.T) {
+	l, clock := newTestLimiter()
+	start := clock.Now()
+
+	sendAt(t, l, clock, 100, 0)
+
+	if got := clock.Now().Sub(start); got != 0 {
+		t.Errorf("expected no delay, got %s", got)
+	}
+
+	l.Update(false)
+
+	if l.Enabled() {
+		t.Error("expected limiter not to be enabled after successful response")
+	}
+}
+
+func TestAdaptiveLimiter_ThrottleDecreasesRate(t *testing.T) {
+	l, clock := newTestLimiter()
+
+	// 20 requests per second for 2 seconds.
+	sendAt(t, l, clock, 40, 2*time.Second)
+
+	l.Update(true)
+
+	if !l.Enabled() {
+		t.Fatal("expected limiter to be enabled after throttling response")
+	}
+
+	first := l.FillRate()
+
+	if first <= minFillRate || first >= 20 {
+		t.Errorf("expected fill rate below measured rate, got %f", first)
+	}
+
+	l.Update(true)
+
+	if second := l.FillRate(); second >= first {
+		t.Errorf("expected fill rate to decrease from %f, got %f", first, second)
+	}
+}
+
+func TestAdaptiveLimiter_SuccessIncreasesRate(t *testing.T) {
+	l, _ := newTestLimiter()
+
+	l.Update(true)
+
+	if got, want := l.FillRate(), minFillRate; got != want {
+		t.Fatalf("expected fill rate %f, got %f", want, got)
+	}
+
+	l.Update(false)
+	l.Update(false)
+
+	if got := l.FillRate(); got <= minFillRate {
+		t.Errorf("expected fill rate to increase from %f, got %f", minFillRate, got)
+	}
+}
+
+func TestAdaptiveLimiter_AcquireWaits(t *testing.T) {
+	l, clock := newTestLimiter()
+
+	l.Update(true)
+
+	start := clock.Now()
+
+	// At the minimum fill rate of 0.5 requests per second, 3 requests take about 6 seconds.
+	sendAt(t, l, clock, 3, 0)
+
+	if got := clock.Now().Sub(start); got < 5*time.Second || got > 7*time.Second {
+		t.Errorf("expected about 6s delay, got %s", got)
+	}
+}
+
+func TestAdaptiveLimiter_AcquireContextCanceled(t *testing.T) {
+	l, _ := newTestLimiter()
+
+	l.Update(true)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := l.Acquire(ctx)
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+}
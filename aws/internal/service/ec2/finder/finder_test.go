@@ -0,0 +1,57 @@
+package finder_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/acctest/fake"
+	tfec2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestHostByID(t *testing.T) {
+	server := fake.NewServer(t)
+	server.State.Put("host", "h-12345678", &ec2.Host{
+		HostId: aws.String("h-12345678"),
+		HostProperties: &ec2.HostProperties{
+			InstanceType: aws.String(ec2.InstanceTypeA1Large),
+		},
+		State: aws.String(ec2.AllocationStateAvailable),
+	})
+	server.State.Put("host", "h-87654321", &ec2.Host{
+		HostId: aws.String("h-87654321"),
+		State:  aws.String(ec2.AllocationStateAvailable),
+	})
+	server.Handle("ec2", "DescribeHosts", func(r *fake.Request) (interface{}, error) {
+		v, ok := r.State.Get("host", r.Form.Get("HostId.1"))
+
+		if !ok {
+			return nil, fake.NewError(http.StatusBadRequest, tfec2.ErrCodeInvalidHostIDNotFound, "not found")
+		}
+
+		return &ec2.DescribeHostsOutput{Hosts: []*ec2.Host{v.(*ec2.Host)}}, nil
+	})
+
+	conn := ec2.New(server.Session())
+
+	host, err := finder.HostByID(conn, "h-12345678")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := aws.StringValue(host.HostProperties.InstanceType), ec2.InstanceTypeA1Large; got != want {
+		t.Errorf("got instance type %s, expected %s", got, want)
+	}
+
+	if _, err := finder.HostByID(conn, "h-00000000"); !tfresource.NotFound(err) {
+		t.Errorf("got error %v, expected not found error", err)
+	}
+
+	if _, err := finder.HostByID(conn, "h-87654321"); !tfresource.NotFound(err) {
+		t.Errorf("got error %v, expected empty result error for host without properties", err)
+	}
+}
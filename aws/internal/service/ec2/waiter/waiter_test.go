@@ -0,0 +1,89 @@
+package waiter_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/acctest/fake"
+	tfec2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/waiter"
+)
+
+func fakeDescribeHostsHandler(state string) fake.HandlerFunc {
+	return func(r *fake.Request) (interface{}, error) {
+		hostID := r.Form.Get("HostId.1")
+
+		if state == "" {
+			return nil, fake.NewError(http.StatusBadRequest, tfec2.ErrCodeInvalidHostIDNotFound, "The host ID '"+hostID+"' was not allocated")
+		}
+
+		return &ec2.DescribeHostsOutput{
+			Hosts: []*ec2.Host{
+				{
+					HostId: aws.String(hostID),
+					HostProperties: &ec2.HostProperties{
+						InstanceType: aws.String(ec2.InstanceTypeA1Large),
+					},
+					State: aws.String(state),
+				},
+			},
+		}, nil
+	}
+}
+
+func TestHostCreated(t *testing.T) {
+	server := fake.NewServer(t)
+	server.Handle("ec2", "DescribeHosts", fake.Sequence(
+		fakeDescribeHostsHandler(ec2.AllocationStatePending),
+		fakeDescribeHostsHandler(ec2.AllocationStatePending),
+		fakeDescribeHostsHandler(ec2.AllocationStateAvailable),
+	))
+
+	host, err := waiter.HostCreated(ec2.New(server.Session()), "h-12345678")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := aws.StringValue(host.State), ec2.AllocationStateAvailable; got != want {
+		t.Errorf("got state %s, expected %s", got, want)
+	}
+
+	if got, want := server.Calls("ec2", "DescribeHosts"), 3; got != want {
+		t.Errorf("got %d DescribeHosts calls, expected %d", got, want)
+	}
+}
+
+func TestHostCreated_UnexpectedState(t *testing.T) {
+	server := fake.NewServer(t)
+	server.Handle("ec2", "DescribeHosts", fake.Sequence(
+		fakeDescribeHostsHandler(ec2.AllocationStatePending),
+		fakeDescribeHostsHandler(ec2.AllocationStatePermanentFailure),
+	))
+
+	_, err := waiter.HostCreated(ec2.New(server.Session()), "h-12345678")
+
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestHostDeleted(t *testing.T) {
+	server := fake.NewServer(t)
+	server.Handle("ec2", "DescribeHosts", fake.Sequence(
+		fakeDescribeHostsHandler(ec2.AllocationStateAvailable),
+		fakeDescribeHostsHandler(""),
+	))
+
+	host, err := waiter.HostDeleted(ec2.New(server.Session()), "h-12345678")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if host != nil {
+		t.Errorf("got host %v, expected nil", host)
+	}
+}
@@ -1,10 +1,41 @@
 package finder
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
+// EffectivePolicyByType returns the policy of the specified type in effect for the caller's account.
+func EffectivePolicyByType(conn *organizations.Organizations, policyType string) (*organizations.EffectivePolicy, error) {
+	input := &organizations.DescribeEffectivePolicyInput{
+		PolicyType: aws.String(policyType),
+	}
+
+	output, err := conn.DescribeEffectivePolicy(input)
+
+	if tfawserr.ErrCodeEquals(err, organizations.ErrCodeAWSOrganizationsNotInUseException, organizations.ErrCodeEffectivePolicyNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.EffectivePolicy == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.EffectivePolicy, nil
+}
+
 func Organization(conn *organizations.Organizations) (*organizations.Organization, error) {
 	input := &organizations.DescribeOrganizationInput{}
 
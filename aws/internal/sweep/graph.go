@@ -0,0 +1,177 @@
+// Package sweep provides the acceptance test sweeper runner's dependency graph,
+// tag filtering and dry run support.
+package sweep
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Graph is the dependency graph of sweepers registered with resource.AddTestSweepers.
+// An edge from one sweeper to another means the latter must run first.
+type Graph struct {
+	sweepers map[string]*resource.Sweeper
+}
+
+// NewGraph returns the dependency graph of the sweepers, keyed by name.
+func NewGraph(sweepers map[string]*resource.Sweeper) *Graph {
+	return &Graph{
+		sweepers: sweepers,
+	}
+}
+
+// Names returns the names of all sweepers in the graph, sorted.
+func (g *Graph) Names() []string {
+	names := make([]string, 0, len(g.sweepers))
+
+	for name := range g.sweepers {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// Validate returns an error for each dependency on a sweeper which is not registered
+// and for each dependency cycle.
+func (g *Graph) Validate() error {
+	var errs *multierror.Error
+
+	for _, name := range g.Names() {
+		for _, dependency := range g.sweepers[name].Dependencies {
+			if _, ok := g.sweepers[dependency]; !ok {
+				errs = multierror.Append(errs, fmt.Errorf("sweeper (%s) has dependency (%s), but that sweeper was not found", name, dependency))
+			}
+		}
+	}
+
+	visited := make(map[string]bool)
+
+	for _, name := range g.Names() {
+		if visited[name] {
+			continue
+		}
+
+		for _, cycle := range g.cycles(name, nil, visited) {
+			errs = multierror.Append(errs, fmt.Errorf("sweeper dependency cycle: %s", strings.Join(cycle, " -> ")))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// cycles returns the dependency cycles reachable from the sweeper which have not already been reported.
+// The path is the chain of sweepers currently being visited.
+func (g *Graph) cycles(name string, path []string, visited map[string]bool) [][]string {
+	for i, v := range path {
+		if v == name {
+			cycle := make([]string, 0, len(path)-i+1)
+			cycle = append(cycle, path[i:]...)
+
+			return [][]string{append(cycle, name)}
+		}
+	}
+
+	if visited[name] {
+		return nil
+	}
+
+	sweeper, ok := g.sweepers[name]
+
+	if !ok {
+		return nil
+	}
+
+	path = append(path, name)
+
+	var result [][]string
+
+	for _, dependency := range sweeper.Dependencies {
+		result = append(result, g.cycles(dependency, path, visited)...)
+	}
+
+	visited[name] = true
+
+	return result
+}
+
+// Filter returns the names of sweepers matching a comma separated list of case-insensitive
+// substrings, as accepted by the -sweep-run flag, sorted. An empty list matches all sweepers.
+func (g *Graph) Filter(run string) []string {
+	if run == "" {
+		return g.Names()
+	}
+
+	var names []string
+
+	for _, name := range g.Names() {
+		for _, s := range strings.Split(strings.ToLower(run), ",") {
+			if s != "" && strings.Contains(strings.ToLower(name), s) {
+				names = append(names, name)
+				break
+			}
+		}
+	}
+
+	return names
+}
+
+// Order returns the named sweepers and all of their dependencies, transitively,
+// ordered so that each sweeper follows its dependencies.
+// Dependencies run in the order they are declared, as with the Terraform Plugin SDK runner.
+func (g *Graph) Order(names []string) ([]string, error) {
+	var order []string
+	state := make(map[string]int)
+
+	const (
+		visiting = iota + 1
+		visited
+	)
+
+	var visit func(name string, path []string) error
+
+	visit = func(name string, path []string) error {
+		switch state[name] {
+		case visiting:
+			return fmt.Errorf("sweeper dependency cycle: %s", strings.Join(append(path, name), " -> "))
+		case visited:
+			return nil
+		}
+
+		sweeper, ok := g.sweepers[name]
+
+		if !ok {
+			if len(path) == 0 {
+				return fmt.Errorf("sweeper (%s) not found", name)
+			}
+
+			return fmt.Errorf("sweeper (%s) has dependency (%s), but that sweeper was not found", path[len(path)-1], name)
+		}
+
+		state[name] = visiting
+
+		for _, dependency := range sweeper.Dependencies {
+			if err := visit(dependency, append(path, name)); err != nil {
+				return err
+			}
+		}
+
+		state[name] = visited
+		order = append(order, name)
+
+		return nil
+	}
+
+	for _, name := range names {
+		if err := visit(name, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	return order, nil
+}
@@ -0,0 +1,219 @@
+package sweep
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testSweepers(dependencies map[string][]string) map[string]*resource.Sweeper {
+	sweepers := make(map[string]*resource.Sweeper)
+
+	for name, deps := range dependencies {
+		sweepers[name] = &resource.Sweeper{
+			Name:         name,
+			Dependencies: deps,
+		}
+	}
+
+	return sweepers
+}
+
+func TestGraphValidate(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Dependencies  map[string][]string
+		ExpectedError []string
+	}{
+		{
+			Name: "valid",
+			Dependencies: map[string][]string{
+				"aws_vpc":               {"aws_subnet", "aws_internet_gateway"},
+				"aws_subnet":            {"aws_instance"},
+				"aws_internet_gateway":  {"aws_instance"},
+				"aws_instance":          nil,
+				"aws_unrelated_sweeper": nil,
+			},
+		},
+		{
+			Name: "missing dependency",
+			Dependencies: map[string][]string{
+				"aws_vpc":    {"aws_subnet", "aws_internet_gateway"},
+				"aws_subnet": nil,
+			},
+			ExpectedError: []string{"sweeper (aws_vpc) has dependency (aws_internet_gateway), but that sweeper was not found"},
+		},
+		{
+			Name: "self cycle",
+			Dependencies: map[string][]string{
+				"aws_vpc": {"aws_vpc"},
+			},
+			ExpectedError: []string{"sweeper dependency cycle: aws_vpc -> aws_vpc"},
+		},
+		{
+			Name: "cycle",
+			Dependencies: map[string][]string{
+				"aws_instance": {"aws_vpc"},
+				"aws_subnet":   {"aws_instance"},
+				"aws_vpc":      {"aws_subnet"},
+			},
+			ExpectedError: []string{"sweeper dependency cycle: aws_instance -> aws_vpc -> aws_subnet -> aws_instance"},
+		},
+		{
+			Name: "cycle and missing dependency",
+			Dependencies: map[string][]string{
+				"aws_subnet": {"aws_vpc", "aws_instance"},
+				"aws_vpc":    {"aws_subnet"},
+			},
+			ExpectedError: []string{
+				"sweeper (aws_subnet) has dependency (aws_instance), but that sweeper was not found",
+				"sweeper dependency cycle: aws_subnet -> aws_vpc -> aws_subnet",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			err := NewGraph(testSweepers(testCase.Dependencies)).Validate()
+
+			if len(testCase.ExpectedError) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error")
+			}
+
+			for _, expected := range testCase.ExpectedError {
+				if !strings.Contains(err.Error(), expected) {
+					t.Errorf("got error %q, expected it to contain %q", err, expected)
+				}
+			}
+
+			if got, want := strings.Count(err.Error(), "\n\t* "), len(testCase.ExpectedError); got != want {
+				t.Errorf("got %d errors, expected %d: %s", got, want, err)
+			}
+		})
+	}
+}
+
+func TestGraphFilter(t *testing.T) {
+	g := NewGraph(testSweepers(map[string][]string{
+		"aws_instance": nil,
+		"aws_subnet":   nil,
+		"aws_vpc":      nil,
+		"aws_vpc_peer": nil,
+	}))
+
+	testCases := []struct {
+		Run      string
+		Expected []string
+	}{
+		{
+			Run:      "",
+			Expected: []string{"aws_instance", "aws_subnet", "aws_vpc", "aws_vpc_peer"},
+		},
+		{
+			Run:      "aws_vpc",
+			Expected: []string{"aws_vpc", "aws_vpc_peer"},
+		},
+		{
+			Run:      "SUBNET,instance",
+			Expected: []string{"aws_instance", "aws_subnet"},
+		},
+		{
+			Run:      "aws_nat_gateway",
+			Expected: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		if got := g.Filter(testCase.Run); !reflect.DeepEqual(got, testCase.Expected) {
+			t.Errorf("%q: got %v, expected %v", testCase.Run, got, testCase.Expected)
+		}
+	}
+}
+
+func TestGraphOrder(t *testing.T) {
+	g := NewGraph(testSweepers(map[string][]string{
+		"aws_instance":         nil,
+		"aws_internet_gateway": {"aws_instance"},
+		"aws_subnet":           {"aws_instance"},
+		"aws_vpc":              {"aws_subnet", "aws_internet_gateway"},
+		"aws_vpc_peer":         nil,
+	}))
+
+	testCases := []struct {
+		Names    []string
+		Expected []string
+	}{
+		{
+			Names:    []string{"aws_vpc"},
+			Expected: []string{"aws_instance", "aws_subnet", "aws_internet_gateway", "aws_vpc"},
+		},
+		{
+			Names:    []string{"aws_internet_gateway", "aws_vpc_peer"},
+			Expected: []string{"aws_instance", "aws_internet_gateway", "aws_vpc_peer"},
+		},
+		{
+			Names:    g.Names(),
+			Expected: []string{"aws_instance", "aws_internet_gateway", "aws_subnet", "aws_vpc", "aws_vpc_peer"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		got, err := g.Order(testCase.Names)
+
+		if err != nil {
+			t.Fatalf("%v: unexpected error: %s", testCase.Names, err)
+		}
+
+		if !reflect.DeepEqual(got, testCase.Expected) {
+			t.Errorf("%v: got %v, expected %v", testCase.Names, got, testCase.Expected)
+		}
+	}
+}
+
+func TestGraphOrder_Invalid(t *testing.T) {
+	g := NewGraph(testSweepers(map[string][]string{
+		"aws_instance": {"aws_vpc"},
+		"aws_subnet":   {"aws_nat_gateway"},
+		"aws_vpc":      {"aws_instance"},
+	}))
+
+	testCases := []struct {
+		Names         []string
+		ExpectedError string
+	}{
+		{
+			Names:         []string{"aws_vpc"},
+			ExpectedError: "sweeper dependency cycle: aws_vpc -> aws_instance -> aws_vpc",
+		},
+		{
+			Names:         []string{"aws_subnet"},
+			ExpectedError: "sweeper (aws_subnet) has dependency (aws_nat_gateway), but that sweeper was not found",
+		},
+		{
+			Names:         []string{"aws_route_table"},
+			ExpectedError: "sweeper (aws_route_table) not found",
+		},
+	}
+
+	for _, testCase := range testCases {
+		_, err := g.Order(testCase.Names)
+
+		if err == nil {
+			t.Fatalf("%v: expected error", testCase.Names)
+		}
+
+		if got, want := err.Error(), testCase.ExpectedError; got != want {
+			t.Errorf("%v: got error %q, expected %q", testCase.Names, got, want)
+		}
+	}
+}
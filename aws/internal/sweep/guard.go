@@ -0,0 +1,159 @@
+package sweep
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync/atomic"
+)
+
+// readOnlyOperationPrefixes are the prefixes of AWS API operations which do not modify resources.
+var readOnlyOperationPrefixes = []string{
+	"BatchGet",
+	"Describe",
+	"Get",
+	"List",
+	"Lookup",
+	"Query",
+	"Scan",
+	"Search",
+}
+
+// Guard blocks AWS API requests which may modify resources, unless explicitly allowed.
+//
+// Sweepers which delete resources directly, rather than through the sweeper resource
+// orchestrator, cannot be previewed or filtered by tag. During a dry run or a tag-scoped
+// sweep their deletions are therefore blocked, while the orchestrator allows the deletion
+// of the resources it has selected.
+type Guard struct {
+	allowed int32
+}
+
+// NewGuard returns a Guard which blocks modifying requests.
+func NewGuard() *Guard {
+	return &Guard{}
+}
+
+// Allow calls f, permitting modifying requests until it returns.
+func (g *Guard) Allow(f func() error) error {
+	atomic.AddInt32(&g.allowed, 1)
+	defer atomic.AddInt32(&g.allowed, -1)
+
+	return f()
+}
+
+// Wrap returns a transport which sends requests permitted by the guard using the transport,
+// or http.DefaultTransport if nil.
+func (g *Guard) Wrap(transport http.RoundTripper) http.RoundTripper {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	return &guardedTransport{
+		guard:     g,
+		transport: transport,
+	}
+}
+
+type guardedTransport struct {
+	guard     *Guard
+	transport http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *guardedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if atomic.LoadInt32(&t.guard.allowed) == 0 {
+		readOnly, operation, err := IsReadOnlyRequest(req)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if !readOnly {
+			return nil, &BlockedRequestError{Operation: operation}
+		}
+	}
+
+	return t.transport.RoundTrip(req)
+}
+
+// BlockedRequestError is returned for requests blocked by a Guard.
+type BlockedRequestError struct {
+	Operation string
+}
+
+func (e *BlockedRequestError) Error() string {
+	return fmt.Sprintf("request (%s) blocked during sweeper dry run or tag-scoped sweep", e.Operation)
+}
+
+// Temporary returns false so that the AWS SDK does not retry blocked requests.
+func (e *BlockedRequestError) Temporary() bool {
+	return false
+}
+
+// IsReadOnlyRequest returns whether the AWS API request does not modify resources, along with
+// a description of its operation. JSON, Query and EC2 Query protocol requests are identified by
+// operation name. REST protocol requests are read-only if their HTTP method is GET or HEAD.
+func IsReadOnlyRequest(req *http.Request) (bool, string, error) {
+	if target := req.Header.Get("X-Amz-Target"); target != "" {
+		operation := target[strings.LastIndex(target, ".")+1:]
+
+		return isReadOnlyOperation(operation), operation, nil
+	}
+
+	action := req.URL.Query().Get("Action")
+
+	if action == "" && strings.HasPrefix(req.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		body, err := readRequestBody(req)
+
+		if err != nil {
+			return false, "", err
+		}
+
+		if values, err := url.ParseQuery(string(body)); err == nil {
+			action = values.Get("Action")
+		}
+	}
+
+	if action != "" {
+		return isReadOnlyOperation(action), action, nil
+	}
+
+	operation := req.Method + " " + req.URL.Path
+
+	return req.Method == http.MethodGet || req.Method == http.MethodHead, operation, nil
+}
+
+func isReadOnlyOperation(operation string) bool {
+	for _, prefix := range readOnlyOperationPrefixes {
+		if strings.HasPrefix(operation, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+
+	body, err := ioutil.ReadAll(req.Body)
+	req.Body.Close()
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading request body: %w", err)
+	}
+
+	req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(body)), nil
+	}
+
+	return body, nil
+}
@@ -0,0 +1,173 @@
+package sweep
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+type testRoundTripper struct {
+	requests int
+}
+
+func (t *testRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.requests++
+
+	return &http.Response{
+		Body:       ioutil.NopCloser(strings.NewReader("")),
+		StatusCode: http.StatusOK,
+	}, nil
+}
+
+func testRequest(t *testing.T, method, url, target, body string) *http.Request {
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+
+	if err != nil {
+		t.Fatalf("error creating request: %s", err)
+	}
+
+	if target != "" {
+		req.Header.Set("X-Amz-Target", target)
+		req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	} else if body != "" {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+	}
+
+	return req
+}
+
+func TestIsReadOnlyRequest(t *testing.T) {
+	testCases := []struct {
+		Name              string
+		Method            string
+		URL               string
+		Target            string
+		Body              string
+		ExpectedOperation string
+		Expected          bool
+	}{
+		{
+			Name:              "JSON read",
+			Method:            http.MethodPost,
+			URL:               "https://logs.us-west-2.amazonaws.com/",
+			Target:            "Logs_20140328.DescribeLogGroups",
+			Body:              "{}",
+			ExpectedOperation: "DescribeLogGroups",
+			Expected:          true,
+		},
+		{
+			Name:              "JSON delete",
+			Method:            http.MethodPost,
+			URL:               "https://logs.us-west-2.amazonaws.com/",
+			Target:            "Logs_20140328.DeleteLogGroup",
+			Body:              `{"logGroupName":"test"}`,
+			ExpectedOperation: "DeleteLogGroup",
+			Expected:          false,
+		},
+		{
+			Name:              "EC2 Query read",
+			Method:            http.MethodPost,
+			URL:               "https://ec2.us-west-2.amazonaws.com/",
+			Body:              "Action=DescribeVpcs&Version=2016-11-15",
+			ExpectedOperation: "DescribeVpcs",
+			Expected:          true,
+		},
+		{
+			Name:              "EC2 Query delete",
+			Method:            http.MethodPost,
+			URL:               "https://ec2.us-west-2.amazonaws.com/",
+			Body:              "Action=DeleteVpc&Version=2016-11-15&VpcId=vpc-12345678",
+			ExpectedOperation: "DeleteVpc",
+			Expected:          false,
+		},
+		{
+			Name:              "Query in URL",
+			Method:            http.MethodGet,
+			URL:               "https://iam.amazonaws.com/?Action=DeleteRole&RoleName=test",
+			ExpectedOperation: "DeleteRole",
+			Expected:          false,
+		},
+		{
+			Name:              "REST read",
+			Method:            http.MethodGet,
+			URL:               "https://lambda.us-west-2.amazonaws.com/2015-03-31/functions/",
+			ExpectedOperation: "GET /2015-03-31/functions/",
+			Expected:          true,
+		},
+		{
+			Name:              "REST delete",
+			Method:            http.MethodDelete,
+			URL:               "https://lambda.us-west-2.amazonaws.com/2015-03-31/functions/test",
+			ExpectedOperation: "DELETE /2015-03-31/functions/test",
+			Expected:          false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			req := testRequest(t, testCase.Method, testCase.URL, testCase.Target, testCase.Body)
+
+			got, operation, err := IsReadOnlyRequest(req)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+
+			if operation != testCase.ExpectedOperation {
+				t.Errorf("got operation %s, expected %s", operation, testCase.ExpectedOperation)
+			}
+
+			body, err := ioutil.ReadAll(req.Body)
+
+			if err != nil {
+				t.Fatalf("error reading request body: %s", err)
+			}
+
+			if got, want := string(body), testCase.Body; got != want {
+				t.Errorf("got request body %q, expected %q", got, want)
+			}
+		})
+	}
+}
+
+func TestGuard(t *testing.T) {
+	guard := NewGuard()
+	next := &testRoundTripper{}
+	transport := guard.Wrap(next)
+
+	if _, err := transport.RoundTrip(testRequest(t, http.MethodPost, "https://ec2.us-west-2.amazonaws.com/", "", "Action=DescribeVpcs")); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	_, err := transport.RoundTrip(testRequest(t, http.MethodPost, "https://ec2.us-west-2.amazonaws.com/", "", "Action=DeleteVpc"))
+
+	var blockedErr *BlockedRequestError
+
+	if !errors.As(err, &blockedErr) {
+		t.Fatalf("got error %v, expected BlockedRequestError", err)
+	}
+
+	if got, want := blockedErr.Operation, "DeleteVpc"; got != want {
+		t.Errorf("got operation %s, expected %s", got, want)
+	}
+
+	err = guard.Allow(func() error {
+		_, err := transport.RoundTrip(testRequest(t, http.MethodPost, "https://ec2.us-west-2.amazonaws.com/", "", "Action=DeleteVpc"))
+
+		return err
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := next.requests, 2; got != want {
+		t.Errorf("got %d requests, expected %d", got, want)
+	}
+}
@@ -0,0 +1,58 @@
+package sweep
+
+import (
+	"fmt"
+	"strings"
+)
+
+// TagFilter restricts sweeping to resources carrying a tag.
+type TagFilter struct {
+	Key string
+
+	// Value, if non-empty, is the required tag value. Otherwise any value matches.
+	Value string
+}
+
+// ParseTagFilter parses a tag filter of the form key=value, or key to match any value.
+func ParseTagFilter(s string) (*TagFilter, error) {
+	key, value := s, ""
+
+	if i := strings.Index(s, "="); i >= 0 {
+		key, value = s[:i], s[i+1:]
+	}
+
+	if key == "" {
+		return nil, fmt.Errorf("invalid sweeper tag filter (%s): expected key=value or key", s)
+	}
+
+	return &TagFilter{
+		Key:   key,
+		Value: value,
+	}, nil
+}
+
+// Match returns whether the tags, as read from a resource's tags or tags_all attribute, carry the tag.
+func (f *TagFilter) Match(tags map[string]interface{}) bool {
+	v, ok := tags[f.Key]
+
+	if !ok {
+		return false
+	}
+
+	if f.Value == "" {
+		return true
+	}
+
+	value, ok := v.(string)
+
+	return ok && value == f.Value
+}
+
+// String returns the tag filter in the form accepted by ParseTagFilter.
+func (f *TagFilter) String() string {
+	if f.Value == "" {
+		return f.Key
+	}
+
+	return f.Key + "=" + f.Value
+}
@@ -0,0 +1,106 @@
+package sweep
+
+import (
+	"testing"
+)
+
+func TestParseTagFilter(t *testing.T) {
+	testCases := []struct {
+		Input         string
+		Expected      TagFilter
+		ExpectedError bool
+	}{
+		{
+			Input:    "Owner=sweeper",
+			Expected: TagFilter{Key: "Owner", Value: "sweeper"},
+		},
+		{
+			Input:    "Owner",
+			Expected: TagFilter{Key: "Owner"},
+		},
+		{
+			Input:    "Expression=a=b",
+			Expected: TagFilter{Key: "Expression", Value: "a=b"},
+		},
+		{
+			Input:         "",
+			ExpectedError: true,
+		},
+		{
+			Input:         "=sweeper",
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		got, err := ParseTagFilter(testCase.Input)
+
+		if testCase.ExpectedError {
+			if err == nil {
+				t.Errorf("%q: expected error", testCase.Input)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%q: unexpected error: %s", testCase.Input, err)
+			continue
+		}
+
+		if *got != testCase.Expected {
+			t.Errorf("%q: got %#v, expected %#v", testCase.Input, *got, testCase.Expected)
+		}
+
+		if got, want := got.String(), testCase.Input; got != want {
+			t.Errorf("%q: got string %q", want, got)
+		}
+	}
+}
+
+func TestTagFilterMatch(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Filter   TagFilter
+		Tags     map[string]interface{}
+		Expected bool
+	}{
+		{
+			Name:     "key and value",
+			Filter:   TagFilter{Key: "Owner", Value: "sweeper"},
+			Tags:     map[string]interface{}{"Name": "test", "Owner": "sweeper"},
+			Expected: true,
+		},
+		{
+			Name:     "different value",
+			Filter:   TagFilter{Key: "Owner", Value: "sweeper"},
+			Tags:     map[string]interface{}{"Owner": "production"},
+			Expected: false,
+		},
+		{
+			Name:     "key only",
+			Filter:   TagFilter{Key: "Owner"},
+			Tags:     map[string]interface{}{"Owner": "production"},
+			Expected: true,
+		},
+		{
+			Name:     "missing key",
+			Filter:   TagFilter{Key: "Owner"},
+			Tags:     map[string]interface{}{"owner": "sweeper"},
+			Expected: false,
+		},
+		{
+			Name:     "no tags",
+			Filter:   TagFilter{Key: "Owner", Value: "sweeper"},
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := testCase.Filter.Match(testCase.Tags); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"go/format"
 	"log"
 	"os"
+	"path/filepath"
 	"strings"
 	"text/template"
 
@@ -17,6 +18,7 @@ import (
 )
 
 var (
+	docs        = flag.Bool("docs", false, "generate resource documentation")
 	serviceName = flag.String("servicename", "", "lowercase service name")
 )
 
@@ -24,6 +26,9 @@ type TemplateData struct {
 	ServiceName string
 }
 
+// websiteResourceDocsPath is the path of the resource documentation, relative to the aws directory.
+const websiteResourceDocsPath = "../website/docs/r"
+
 func main() {
 	flag.Parse()
 
@@ -40,7 +45,13 @@ func main() {
 		ServiceName: *serviceName,
 	}
 	templateFuncMap := template.FuncMap{
+		"ARNNamespace":            tagresource.ServiceARNNamespace,
+		"ClientConnFunction":      tagresource.ServiceClientConnFunction,
+		"ExampleIdentifier":       exampleIdentifier,
 		"IdentifierAttributeName": tagresource.ServiceIdentifierAttributeName,
+		"IdentifierDescription":   identifierDescription,
+		"PadRight":                padRight,
+		"Subcategory":             tagresource.ServiceDocumentationSubcategory,
 		"Title":                   strings.Title,
 	}
 
@@ -51,6 +62,83 @@ func main() {
 	if err := generateTemplateFile(resourceTestFilename, resourceTestTemplateBody, templateFuncMap, templateData); err != nil {
 		log.Fatal(err)
 	}
+
+	if !*docs {
+		return
+	}
+
+	if tagresource.ServiceDocumentationSubcategory(*serviceName) == "" {
+		log.Fatalf("missing documentation subcategory for service (%s)", *serviceName)
+	}
+
+	docsFilename := filepath.Join(websiteResourceDocsPath, fmt.Sprintf("%s_tag.html.markdown", *serviceName))
+
+	if err := generateDocsFile(docsFilename, docsTemplateBody, templateFuncMap, templateData); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// exampleIdentifier returns an example resource identifier for the service's documentation.
+func exampleIdentifier(serviceName string) string {
+	switch attributeName := tagresource.ServiceIdentifierAttributeName(serviceName); {
+	case attributeName == "queue_url":
+		return "https://sqs.us-west-2.amazonaws.com/123456789012/example"
+	case strings.HasSuffix(attributeName, "arn"):
+		return fmt.Sprintf("arn:aws:%s:us-west-2:123456789012:example", tagresource.ServiceARNNamespace(serviceName))
+	default:
+		return "example"
+	}
+}
+
+// identifierDescription returns the description of the service's identifier attribute.
+func identifierDescription(serviceName string) string {
+	subcategory := tagresource.ServiceDocumentationSubcategory(serviceName)
+
+	switch attributeName := tagresource.ServiceIdentifierAttributeName(serviceName); {
+	case strings.HasSuffix(attributeName, "arn"):
+		return fmt.Sprintf("Amazon Resource Name (ARN) of the %s resource to tag.", subcategory)
+	case strings.HasSuffix(attributeName, "_name"):
+		return fmt.Sprintf("Name of the %s resource to tag.", subcategory)
+	case attributeName == "queue_url":
+		return fmt.Sprintf("URL of the %s queue to tag.", subcategory)
+	default:
+		return fmt.Sprintf("Identifier of the %s resource to tag.", subcategory)
+	}
+}
+
+// padRight pads the string with spaces to the length of the longest of the strings,
+// aligning the arguments of Terraform configuration examples.
+func padRight(str string, strs ...string) string {
+	width := len(str)
+
+	for _, s := range strs {
+		if len(s) > width {
+			width = len(s)
+		}
+	}
+
+	return str + strings.Repeat(" ", width-len(str))
+}
+
+func generateDocsFile(filename string, templateBody string, templateFuncs template.FuncMap, templateData interface{}) error {
+	tmpl, err := template.New(filename).Funcs(templateFuncs).Parse(templateBody)
+
+	if err != nil {
+		return fmt.Errorf("error parsing template: %w", err)
+	}
+
+	var buffer bytes.Buffer
+	err = tmpl.Execute(&buffer, templateData)
+
+	if err != nil {
+		return fmt.Errorf("error executing template: %w", err)
+	}
+
+	if err := os.WriteFile(filename, bytes.TrimLeft(buffer.Bytes(), "\n"), 0644); err != nil {
+		return fmt.Errorf("error writing to file (%s): %w", filename, err)
+	}
+
+	return nil
 }
 
 func generateTemplateFile(filename string, templateBody string, templateFuncs template.FuncMap, templateData interface{}) error {
@@ -137,7 +225,7 @@ func resourceAws{{ .ServiceName | Title }}Tag() *schema.Resource {
 }
 
 func resourceAws{{ .ServiceName | Title }}TagCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).{{ .ServiceName }}conn
+	conn := meta.(*AWSClient).{{ .ServiceName | ClientConnFunction }}()
 
 	identifier := d.Get("{{ .ServiceName | IdentifierAttributeName }}").(string)
 	key := d.Get("key").(string)
@@ -157,7 +245,7 @@ func resourceAws{{ .ServiceName | Title }}TagCreate(d *schema.ResourceData, meta
 }
 
 func resourceAws{{ .ServiceName | Title }}TagRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).{{ .ServiceName }}conn
+	conn := meta.(*AWSClient).{{ .ServiceName | ClientConnFunction }}()
 	identifier, key, err := tagresource.GetResourceID(d.Id())
 
 	if err != nil {
@@ -184,7 +272,7 @@ func resourceAws{{ .ServiceName | Title }}TagRead(d *schema.ResourceData, meta i
 }
 
 func resourceAws{{ .ServiceName | Title }}TagUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).{{ .ServiceName }}conn
+	conn := meta.(*AWSClient).{{ .ServiceName | ClientConnFunction }}()
 	identifier, key, err := tagresource.GetResourceID(d.Id())
 
 	if err != nil {
@@ -199,7 +287,7 @@ func resourceAws{{ .ServiceName | Title }}TagUpdate(d *schema.ResourceData, meta
 }
 
 func resourceAws{{ .ServiceName | Title }}TagDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).{{ .ServiceName }}conn
+	conn := meta.(*AWSClient).{{ .ServiceName | ClientConnFunction }}()
 	identifier, key, err := tagresource.GetResourceID(d.Id())
 
 	if err != nil {
@@ -229,8 +317,19 @@ import (
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
+func init() {
+	resource.AddTestSweepers("aws_{{ .ServiceName }}_tag", &resource.Sweeper{
+		Name: "aws_{{ .ServiceName }}_tag",
+		F:    testSweep{{ .ServiceName | Title }}Tags,
+	})
+}
+
+func testSweep{{ .ServiceName | Title }}Tags(region string) error {
+	return testSweepTagResources(region, "{{ .ServiceName | ARNNamespace }}")
+}
+
 func testAccCheck{{ .ServiceName | Title }}TagDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).{{ .ServiceName }}conn
+	conn := testAccProvider.Meta().(*AWSClient).{{ .ServiceName | ClientConnFunction }}()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_{{ .ServiceName }}_tag" {
@@ -276,7 +375,7 @@ func testAccCheck{{ .ServiceName | Title }}TagExists(resourceName string) resour
 			return err
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).{{ .ServiceName }}conn
+		conn := testAccProvider.Meta().(*AWSClient).{{ .ServiceName | ClientConnFunction }}()
 
 		_, err = keyvaluetags.{{ .ServiceName | Title }}GetTag(conn, identifier, key)
 
@@ -287,5 +386,54 @@ func testAccCheck{{ .ServiceName | Title }}TagExists(resourceName string) resour
 		return nil
 	}
 }
+`
+	docsTemplateBody = `
+---
+subcategory: "{{ .ServiceName | Subcategory }}"
+layout: "aws"
+page_title: "AWS: aws_{{ .ServiceName }}_tag"
+description: |-
+  Manages an individual {{ .ServiceName | Subcategory }} resource tag
+---
+
+# Resource: aws_{{ .ServiceName }}_tag
+
+Manages an individual {{ .ServiceName | Subcategory }} resource tag. This resource should only be used in cases where {{ .ServiceName | Subcategory }} resources are created outside Terraform or implicitly created by other means.
+
+~> **NOTE:** This tagging resource should not be combined with the Terraform resource for managing the parent resource. For example, using a resource's ` + "`tags`" + ` argument and ` + "`aws_{{ .ServiceName }}_tag`" + ` to manage tags of the same resource will cause a perpetual difference where the parent resource will try to remove the tag being added by the ` + "`aws_{{ .ServiceName }}_tag`" + ` resource.
+
+~> **NOTE:** This tagging resource does not use the [provider ` + "`ignore_tags`" + ` configuration](/docs/providers/aws/index.html#ignore_tags).
+
+## Example Usage
+
+` + "```terraform" + `
+resource "aws_{{ .ServiceName }}_tag" "example" {
+  {{ PadRight (.ServiceName | IdentifierAttributeName) "value" }} = "{{ .ServiceName | ExampleIdentifier }}"
+  {{ PadRight "key" (.ServiceName | IdentifierAttributeName) "value" }} = "Name"
+  {{ PadRight "value" (.ServiceName | IdentifierAttributeName) }} = "Hello World"
+}
+` + "```" + `
+
+## Argument Reference
+
+The following arguments are supported:
+
+* ` + "`{{ .ServiceName | IdentifierAttributeName }}`" + ` - (Required) {{ .ServiceName | IdentifierDescription }}
+* ` + "`key`" + ` - (Required) Tag name.
+* ` + "`value`" + ` - (Required) Tag value.
+
+## Attributes Reference
+
+In addition to all arguments above, the following attributes are exported:
+
+* ` + "`id`" + ` - {{ .ServiceName | Subcategory }} resource identifier and key, separated by a comma (` + "`,`" + `)
+
+## Import
+
+` + "`aws_{{ .ServiceName }}_tag`" + ` can be imported by using the {{ .ServiceName | Subcategory }} resource identifier and key, separated by a comma (` + "`,`" + `), e.g.
+
+` + "```" + `
+$ terraform import aws_{{ .ServiceName }}_tag.example {{ .ServiceName | ExampleIdentifier }},Name
+` + "```" + `
 `
 )
@@ -4,11 +4,312 @@ import (
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
+// ServiceARNNamespace determines the service namespace of resource ARNs,
+// as used by the Resource Groups Tagging API resource type filters.
+func ServiceARNNamespace(serviceName string) string {
+	switch serviceName {
+	case "accessanalyzer":
+		return "access-analyzer"
+	case "acmpca":
+		return "acm-pca"
+	case "apigatewayv2":
+		return "apigateway"
+	case "cloudhsmv2":
+		return "cloudhsm"
+	case "cloudwatchevents":
+		return "events"
+	case "cloudwatchlogs":
+		return "logs"
+	case "codestarconnections":
+		return "codestar-connections"
+	case "codestarnotifications":
+		return "codestar-notifications"
+	case "cognitoidentity":
+		return "cognito-identity"
+	case "cognitoidentityprovider":
+		return "cognito-idp"
+	case "configservice":
+		return "config"
+	case "databasemigrationservice":
+		return "dms"
+	case "directoryservice":
+		return "ds"
+	case "docdb":
+		return "rds"
+	case "efs":
+		return "elasticfilesystem"
+	case "elasticsearchservice":
+		return "es"
+	case "elb":
+		return "elasticloadbalancing"
+	case "elbv2":
+		return "elasticloadbalancing"
+	case "kinesisanalyticsv2":
+		return "kinesisanalytics"
+	case "licensemanager":
+		return "license-manager"
+	case "neptune":
+		return "rds"
+	case "networkfirewall":
+		return "network-firewall"
+	case "pinpoint":
+		return "mobiletargeting"
+	case "resourcegroups":
+		return "resource-groups"
+	case "route53recoveryreadiness":
+		return "route53-recovery-readiness"
+	case "sfn":
+		return "states"
+	case "timestreamwrite":
+		return "timestream"
+	case "wafregional":
+		return "waf-regional"
+	default:
+		return serviceName
+	}
+}
+
+// ServiceClientConnFunction determines the AWSClient method returning the service client.
+func ServiceClientConnFunction(serviceName string) string {
+	switch serviceName {
+	case "cognitoidentity":
+		return "cognitoconn"
+	case "cognitoidentityprovider":
+		return "cognitoidpconn"
+	case "configservice":
+		return "configconn"
+	case "databasemigrationservice":
+		return "dmsconn"
+	case "directconnect":
+		return "dxconn"
+	case "directoryservice":
+		return "dsconn"
+	case "elasticsearchservice":
+		return "esconn"
+	case "servicediscovery":
+		return "sdconn"
+	default:
+		return serviceName + "conn"
+	}
+}
+
+// ServiceDocumentationSubcategory determines the documentation subcategory of the tag resource.
+func ServiceDocumentationSubcategory(serviceName string) string {
+	switch serviceName {
+	case "accessanalyzer":
+		return "Access Analyzer"
+	case "acm":
+		return "ACM"
+	case "acmpca":
+		return "ACM PCA"
+	case "amplify":
+		return "Amplify Console"
+	case "apigatewayv2":
+		return "API Gateway v2 (WebSocket and HTTP APIs)"
+	case "appconfig":
+		return "AppConfig"
+	case "appmesh":
+		return "AppMesh"
+	case "apprunner":
+		return "App Runner"
+	case "appstream":
+		return "AppStream"
+	case "appsync":
+		return "AppSync"
+	case "athena":
+		return "Athena"
+	case "backup":
+		return "Backup"
+	case "batch":
+		return "Batch"
+	case "cloud9":
+		return "Cloud9"
+	case "cloudfront":
+		return "CloudFront"
+	case "cloudhsmv2":
+		return "CloudHSM v2"
+	case "cloudtrail":
+		return "CloudTrail"
+	case "cloudwatch", "cloudwatchlogs":
+		return "CloudWatch"
+	case "cloudwatchevents":
+		return "EventBridge (CloudWatch Events)"
+	case "codeartifact":
+		return "CodeArtifact"
+	case "codecommit":
+		return "CodeCommit"
+	case "codedeploy":
+		return "CodeDeploy"
+	case "codepipeline":
+		return "CodePipeline"
+	case "codestarconnections":
+		return "CodeStar Connections"
+	case "codestarnotifications":
+		return "CodeStar Notifications"
+	case "cognitoidentity", "cognitoidentityprovider":
+		return "Cognito"
+	case "configservice":
+		return "Config"
+	case "databasemigrationservice":
+		return "Database Migration Service (DMS)"
+	case "dataexchange":
+		return "Data Exchange"
+	case "datasync":
+		return "DataSync"
+	case "dax":
+		return "DynamoDB Accelerator (DAX)"
+	case "devicefarm":
+		return "Device Farm"
+	case "directconnect":
+		return "Direct Connect"
+	case "directoryservice":
+		return "Directory Service"
+	case "dlm":
+		return "Data Lifecycle Manager (DLM)"
+	case "docdb":
+		return "DocumentDB"
+	case "dynamodb":
+		return "DynamoDB"
+	case "ec2":
+		return "EC2"
+	case "ecr":
+		return "ECR"
+	case "ecs":
+		return "ECS"
+	case "efs":
+		return "EFS"
+	case "eks":
+		return "EKS"
+	case "elasticache":
+		return "ElastiCache"
+	case "elasticbeanstalk":
+		return "Elastic Beanstalk"
+	case "elasticsearchservice":
+		return "ElasticSearch"
+	case "elb":
+		return "Elastic Load Balancing (ELB Classic)"
+	case "elbv2":
+		return "Elastic Load Balancing v2 (ALB/NLB)"
+	case "firehose":
+		return "Kinesis Firehose"
+	case "fsx":
+		return "File System (FSx)"
+	case "gamelift":
+		return "Gamelift"
+	case "glacier":
+		return "Glacier"
+	case "globalaccelerator":
+		return "Global Accelerator"
+	case "glue":
+		return "Glue"
+	case "greengrass", "iot", "iotanalytics", "iotevents":
+		return "IoT"
+	case "guardduty":
+		return "GuardDuty"
+	case "imagebuilder":
+		return "Image Builder"
+	case "kafka":
+		return "Managed Streaming for Kafka (MSK)"
+	case "kinesis":
+		return "Kinesis"
+	case "kinesisanalytics":
+		return "Kinesis Data Analytics (SQL Applications)"
+	case "kinesisanalyticsv2":
+		return "Kinesis Data Analytics v2 (SQL and Flink Applications)"
+	case "kinesisvideo":
+		return "Kinesis Video"
+	case "kms":
+		return "KMS"
+	case "lambda":
+		return "Lambda"
+	case "licensemanager":
+		return "License Manager"
+	case "mediaconnect":
+		return "MediaConnect"
+	case "medialive":
+		return "MediaLive"
+	case "mediapackage":
+		return "MediaPackage"
+	case "mediastore":
+		return "MediaStore"
+	case "mq":
+		return "MQ"
+	case "neptune":
+		return "Neptune"
+	case "networkfirewall":
+		return "Network Firewall"
+	case "networkmanager":
+		return "Transit Gateway Network Manager"
+	case "opsworks":
+		return "OpsWorks"
+	case "organizations":
+		return "Organizations"
+	case "pinpoint":
+		return "Pinpoint"
+	case "qldb":
+		return "Quantum Ledger Database (QLDB)"
+	case "quicksight":
+		return "QuickSight"
+	case "rds":
+		return "RDS"
+	case "resourcegroups":
+		return "Resource Groups"
+	case "route53recoveryreadiness":
+		return "Route53 Recovery Readiness"
+	case "route53resolver":
+		return "Route53 Resolver"
+	case "sagemaker":
+		return "Sagemaker"
+	case "schemas":
+		return "EventBridge Schemas"
+	case "securityhub":
+		return "Security Hub"
+	case "servicediscovery":
+		return "Service Discovery"
+	case "sfn":
+		return "Step Function (SFN)"
+	case "shield":
+		return "Shield"
+	case "signer":
+		return "Signer"
+	case "sns":
+		return "SNS"
+	case "sqs":
+		return "SQS"
+	case "storagegateway":
+		return "Storage Gateway"
+	case "swf":
+		return "SWF"
+	case "timestreamwrite":
+		return "Timestream Write"
+	case "transfer":
+		return "Transfer"
+	case "waf":
+		return "WAF"
+	case "wafregional":
+		return "WAF Regional"
+	case "wafv2":
+		return "WAFv2"
+	case "worklink":
+		return "WorkLink"
+	case "workspaces":
+		return "WorkSpaces"
+	case "xray":
+		return "XRay"
+	default:
+		return ""
+	}
+}
+
 // ServiceIdentifierAttributeName determines the schema identifier attribute name.
 func ServiceIdentifierAttributeName(serviceName string) string {
 	switch serviceName {
 	case "ec2":
 		return "resource_id"
+	case "elb":
+		return "load_balancer_name"
+	case "elbv2":
+		return "resource_arn"
 	default:
 		return toSnakeCase(keyvaluetags.ServiceTagInputIdentifierField(serviceName))
 	}
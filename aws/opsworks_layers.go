@@ -270,7 +270,7 @@ func (lt *opsworksLayerType) SchemaResource() *schema.Resource {
 }
 
 func (lt *opsworksLayerType) Read(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).opsworksconn
+	conn := meta.(*AWSClient).opsworksconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
@@ -369,7 +369,7 @@ func (lt *opsworksLayerType) Read(d *schema.ResourceData, meta interface{}) erro
 }
 
 func (lt *opsworksLayerType) Create(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).opsworksconn
+	conn := meta.(*AWSClient).opsworksconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 
@@ -443,7 +443,7 @@ func (lt *opsworksLayerType) Create(d *schema.ResourceData, meta interface{}) er
 }
 
 func (lt *opsworksLayerType) Update(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).opsworksconn
+	conn := meta.(*AWSClient).opsworksconn()
 
 	attributes, err := lt.AttributeMap(d)
 	if err != nil {
@@ -523,7 +523,7 @@ func (lt *opsworksLayerType) Update(d *schema.ResourceData, meta interface{}) er
 }
 
 func (lt *opsworksLayerType) Delete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).opsworksconn
+	conn := meta.(*AWSClient).opsworksconn()
 
 	req := &opsworks.DeleteLayerInput{
 		LayerId: aws.String(d.Id()),
@@ -1,11 +1,16 @@
 package aws
 
 import (
+	"fmt"
 	"log"
+	"regexp"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/apimetrics"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/mutexkv"
 )
@@ -41,11 +46,35 @@ func Provider() *schema.Provider {
 
 			"assume_role": assumeRoleSchema(),
 
-			"shared_credentials_file": {
+			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+
+			"credential_process": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Default:     "",
-				Description: descriptions["shared_credentials_file"],
+				Description: descriptions["credential_process"],
+			},
+
+			"shared_config_files": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions["shared_config_files"],
+			},
+
+			"shared_credentials_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Default:       "",
+				Description:   descriptions["shared_credentials_file"],
+				ConflictsWith: []string{"shared_credentials_files"},
+			},
+
+			"shared_credentials_files": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Description:   descriptions["shared_credentials_files"],
+				ConflictsWith: []string{"shared_credentials_file"},
 			},
 
 			"token": {
@@ -73,6 +102,41 @@ func Provider() *schema.Provider {
 				Description: descriptions["max_retries"],
 			},
 
+			"max_retry_duration": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  descriptions["max_retry_duration"],
+				ValidateFunc: validateDuration,
+			},
+
+			"retry_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("AWS_RETRY_MODE", retryModeStandard),
+				Description:  descriptions["retry_mode"],
+				ValidateFunc: validation.StringInSlice(retryMode_Values(), false),
+			},
+
+			"api_audit_log_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["api_audit_log_path"],
+			},
+
+			"api_metrics_format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      apimetrics.FormatJSON,
+				Description:  descriptions["api_metrics_format"],
+				ValidateFunc: validation.StringInSlice(apimetrics.Format_Values(), false),
+			},
+
+			"api_metrics_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["api_metrics_path"],
+			},
+
 			"allowed_account_ids": {
 				Type:          schema.TypeSet,
 				Elem:          &schema.Schema{Type: schema.TypeString},
@@ -96,6 +160,12 @@ func Provider() *schema.Provider {
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"exclude_resource_types": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Resource types to which no tags are defaulted",
+						},
 						"tags": {
 							Type:        schema.TypeMap,
 							Optional:    true,
@@ -112,8 +182,107 @@ func Provider() *schema.Provider {
 				Description: descriptions["http_proxy"],
 			},
 
+			"no_proxy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"NO_PROXY", "no_proxy"}, nil),
+				Description: descriptions["no_proxy"],
+			},
+
+			"custom_ca_bundle": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AWS_CA_BUNDLE", nil),
+				Description: descriptions["custom_ca_bundle"],
+			},
+
 			"endpoints": endpointsSchema(),
 
+			"endpoint_url_template": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  descriptions["endpoint_url_template"],
+				ValidateFunc: validateEndpointURLTemplate,
+			},
+
+			"local_emulator": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions["local_emulator"],
+			},
+
+			"extra_retryable_errors": extraRetryableErrorsSchema(),
+
+			"service_retry": serviceRetrySchema(),
+
+			"service_timeouts": serviceTimeoutsSchema(),
+
+			"tag_policy_compliance": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  descriptions["tag_policy_compliance"],
+				ValidateFunc: validation.StringInSlice(keyvaluetags.RequiredEnforcementLevel_Values(), false),
+			},
+
+			"required_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to require resource tags across all resources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enforcement_level": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      keyvaluetags.RequiredEnforcementLevelError,
+							ValidateFunc: validation.StringInSlice(keyvaluetags.RequiredEnforcementLevel_Values(), false),
+							Description:  "Whether resources without the required tags fail to plan (`error`) or only log a warning (`warn`).",
+						},
+						"tag": {
+							Type:        schema.TypeList,
+							Required:    true,
+							MinItems:    1,
+							Description: "Resource tags to require across all resources.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+										Description:  "Key of the required tag.",
+									},
+									"allowed_value_regex": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsValidRegExp,
+										Description:  "Regular expression that the value of the required tag must match.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"tag_key_normalization": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to normalize the case of resource tag keys across all resources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"canonical_keys": {
+							Type:        schema.TypeSet,
+							Required:    true,
+							MinItems:    1,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Canonical casing of resource tag keys. Tag keys differing only in case are replaced with the canonical key.",
+						},
+					},
+				},
+			},
+
 			"ignore_tags": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -135,6 +304,44 @@ func Provider() *schema.Provider {
 							Set:         schema.HashString,
 							Description: "Resource tag key prefixes to ignore across all resources.",
 						},
+						"key_regexes": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsValidRegExp,
+							},
+							Set:         schema.HashString,
+							Description: "Regular expressions matching resource tag keys to ignore across all resources.",
+						},
+						"key_suffixes": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Resource tag key suffixes to ignore across all resources.",
+						},
+						"rule": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Rules matching resource tag keys and values to ignore across all resources.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key_regex": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsValidRegExp,
+										Description:  "Regular expression matching the keys of resource tags to ignore. Matches all keys if omitted.",
+									},
+									"value_regex": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsValidRegExp,
+										Description:  "Regular expression matching the values of resource tags to ignore. Matches all values if omitted.",
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -181,12 +388,49 @@ func Provider() *schema.Provider {
 				Description: descriptions["skip_metadata_api_check"],
 			},
 
+			"ec2_metadata_service_endpoint": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("AWS_EC2_METADATA_SERVICE_ENDPOINT", nil),
+				Description:  descriptions["ec2_metadata_service_endpoint"],
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+
+			"ec2_metadata_service_endpoint_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE", nil),
+				Description:  descriptions["ec2_metadata_service_endpoint_mode"],
+				ValidateFunc: validation.StringInSlice([]string{"IPv4", "IPv6"}, false),
+			},
+
+			"ec2_metadata_service_require_token": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions["ec2_metadata_service_require_token"],
+			},
+
 			"s3_force_path_style": {
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Default:     false,
 				Description: descriptions["s3_force_path_style"],
 			},
+
+			"use_dualstack_endpoint": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions["use_dualstack_endpoint"],
+			},
+
+			"use_fips_endpoint": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions["use_fips_endpoint"],
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -479,11 +723,14 @@ func Provider() *schema.Provider {
 
 		ResourcesMap: map[string]*schema.Resource{
 			"aws_accessanalyzer_analyzer":                             resourceAwsAccessAnalyzerAnalyzer(),
+			"aws_accessanalyzer_tag":                                  resourceAwsAccessanalyzerTag(),
 			"aws_acm_certificate":                                     resourceAwsAcmCertificate(),
 			"aws_acm_certificate_validation":                          resourceAwsAcmCertificateValidation(),
+			"aws_acm_tag":                                             resourceAwsAcmTag(),
 			"aws_acmpca_certificate_authority":                        resourceAwsAcmpcaCertificateAuthority(),
 			"aws_acmpca_certificate_authority_certificate":            resourceAwsAcmpcaCertificateAuthorityCertificate(),
 			"aws_acmpca_certificate":                                  resourceAwsAcmpcaCertificate(),
+			"aws_acmpca_tag":                                          resourceAwsAcmpcaTag(),
 			"aws_ami":                                                 resourceAwsAmi(),
 			"aws_ami_copy":                                            resourceAwsAmiCopy(),
 			"aws_ami_from_instance":                                   resourceAwsAmiFromInstance(),
@@ -492,6 +739,7 @@ func Provider() *schema.Provider {
 			"aws_amplify_backend_environment":                         resourceAwsAmplifyBackendEnvironment(),
 			"aws_amplify_branch":                                      resourceAwsAmplifyBranch(),
 			"aws_amplify_domain_association":                          resourceAwsAmplifyDomainAssociation(),
+			"aws_amplify_tag":                                         resourceAwsAmplifyTag(),
 			"aws_amplify_webhook":                                     resourceAwsAmplifyWebhook(),
 			"aws_api_gateway_account":                                 resourceAwsApiGatewayAccount(),
 			"aws_api_gateway_api_key":                                 resourceAwsApiGatewayApiKey(),
@@ -528,6 +776,7 @@ func Provider() *schema.Provider {
 			"aws_apigatewayv2_route":                                  resourceAwsApiGatewayV2Route(),
 			"aws_apigatewayv2_route_response":                         resourceAwsApiGatewayV2RouteResponse(),
 			"aws_apigatewayv2_stage":                                  resourceAwsApiGatewayV2Stage(),
+			"aws_apigatewayv2_tag":                                    resourceAwsApigatewayv2Tag(),
 			"aws_apigatewayv2_vpc_link":                               resourceAwsApiGatewayV2VpcLink(),
 			"aws_app_cookie_stickiness_policy":                        resourceAwsAppCookieStickinessPolicy(),
 			"aws_appautoscaling_target":                               resourceAwsAppautoscalingTarget(),
@@ -539,9 +788,11 @@ func Provider() *schema.Provider {
 			"aws_appconfig_deployment_strategy":                       resourceAwsAppconfigDeploymentStrategy(),
 			"aws_appconfig_environment":                               resourceAwsAppconfigEnvironment(),
 			"aws_appconfig_hosted_configuration_version":              resourceAwsAppconfigHostedConfigurationVersion(),
+			"aws_appconfig_tag":                                       resourceAwsAppconfigTag(),
 			"aws_appmesh_gateway_route":                               resourceAwsAppmeshGatewayRoute(),
 			"aws_appmesh_mesh":                                        resourceAwsAppmeshMesh(),
 			"aws_appmesh_route":                                       resourceAwsAppmeshRoute(),
+			"aws_appmesh_tag":                                         resourceAwsAppmeshTag(),
 			"aws_appmesh_virtual_gateway":                             resourceAwsAppmeshVirtualGateway(),
 			"aws_appmesh_virtual_node":                                resourceAwsAppmeshVirtualNode(),
 			"aws_appmesh_virtual_router":                              resourceAwsAppmeshVirtualRouter(),
@@ -550,16 +801,20 @@ func Provider() *schema.Provider {
 			"aws_apprunner_connection":                                resourceAwsAppRunnerConnection(),
 			"aws_apprunner_custom_domain_association":                 resourceAwsAppRunnerCustomDomainAssociation(),
 			"aws_apprunner_service":                                   resourceAwsAppRunnerService(),
+			"aws_apprunner_tag":                                       resourceAwsApprunnerTag(),
 			"aws_appstream_stack":                                     resourceAwsAppStreamStack(),
 			"aws_appstream_fleet":                                     resourceAwsAppStreamFleet(),
 			"aws_appstream_image_builder":                             resourceAwsAppStreamImageBuilder(),
+			"aws_appstream_tag":                                       resourceAwsAppstreamTag(),
 			"aws_appsync_api_key":                                     resourceAwsAppsyncApiKey(),
 			"aws_appsync_datasource":                                  resourceAwsAppsyncDatasource(),
 			"aws_appsync_function":                                    resourceAwsAppsyncFunction(),
 			"aws_appsync_graphql_api":                                 resourceAwsAppsyncGraphqlApi(),
 			"aws_appsync_resolver":                                    resourceAwsAppsyncResolver(),
+			"aws_appsync_tag":                                         resourceAwsAppsyncTag(),
 			"aws_athena_database":                                     resourceAwsAthenaDatabase(),
 			"aws_athena_named_query":                                  resourceAwsAthenaNamedQuery(),
+			"aws_athena_tag":                                          resourceAwsAthenaTag(),
 			"aws_athena_workgroup":                                    resourceAwsAthenaWorkgroup(),
 			"aws_autoscaling_attachment":                              resourceAwsAutoscalingAttachment(),
 			"aws_autoscaling_group":                                   resourceAwsAutoscalingGroup(),
@@ -573,6 +828,7 @@ func Provider() *schema.Provider {
 			"aws_backup_plan":                                         resourceAwsBackupPlan(),
 			"aws_backup_region_settings":                              resourceAwsBackupRegionSettings(),
 			"aws_backup_selection":                                    resourceAwsBackupSelection(),
+			"aws_backup_tag":                                          resourceAwsBackupTag(),
 			"aws_backup_vault":                                        resourceAwsBackupVault(),
 			"aws_backup_vault_notifications":                          resourceAwsBackupVaultNotifications(),
 			"aws_backup_vault_policy":                                 resourceAwsBackupVaultPolicy(),
@@ -585,6 +841,7 @@ func Provider() *schema.Provider {
 			"aws_chime_voice_connector_origination":                   resourceAwsChimeVoiceConnectorOrigination(),
 			"aws_chime_voice_connector_termination":                   resourceAwsChimeVoiceConnectorTermination(),
 			"aws_cloud9_environment_ec2":                              resourceAwsCloud9EnvironmentEc2(),
+			"aws_cloud9_tag":                                          resourceAwsCloud9Tag(),
 			"aws_cloudcontrolapi_resource":                            resourceAwsCloudControlApiResource(),
 			"aws_cloudformation_stack":                                resourceAwsCloudFormationStack(),
 			"aws_cloudformation_stack_set":                            resourceAwsCloudFormationStackSet(),
@@ -599,7 +856,9 @@ func Provider() *schema.Provider {
 			"aws_cloudfront_origin_request_policy":                    resourceAwsCloudFrontOriginRequestPolicy(),
 			"aws_cloudfront_public_key":                               resourceAwsCloudFrontPublicKey(),
 			"aws_cloudfront_realtime_log_config":                      resourceAwsCloudFrontRealtimeLogConfig(),
+			"aws_cloudfront_tag":                                      resourceAwsCloudfrontTag(),
 			"aws_cloudtrail":                                          resourceAwsCloudTrail(),
+			"aws_cloudtrail_tag":                                      resourceAwsCloudtrailTag(),
 			"aws_cloudwatch_event_bus":                                resourceAwsCloudWatchEventBus(),
 			"aws_cloudwatch_event_bus_policy":                         resourceAwsCloudWatchEventBusPolicy(),
 			"aws_cloudwatch_event_permission":                         resourceAwsCloudWatchEventPermission(),
@@ -608,6 +867,7 @@ func Provider() *schema.Provider {
 			"aws_cloudwatch_event_archive":                            resourceAwsCloudWatchEventArchive(),
 			"aws_cloudwatch_event_connection":                         resourceAwsCloudWatchEventConnection(),
 			"aws_cloudwatch_event_api_destination":                    resourceAwsCloudWatchEventApiDestination(),
+			"aws_cloudwatchevents_tag":                                resourceAwsCloudwatcheventsTag(),
 			"aws_cloudwatch_log_destination":                          resourceAwsCloudWatchLogDestination(),
 			"aws_cloudwatch_log_destination_policy":                   resourceAwsCloudWatchLogDestinationPolicy(),
 			"aws_cloudwatch_log_group":                                resourceAwsCloudWatchLogGroup(),
@@ -615,6 +875,7 @@ func Provider() *schema.Provider {
 			"aws_cloudwatch_log_resource_policy":                      resourceAwsCloudWatchLogResourcePolicy(),
 			"aws_cloudwatch_log_stream":                               resourceAwsCloudWatchLogStream(),
 			"aws_cloudwatch_log_subscription_filter":                  resourceAwsCloudwatchLogSubscriptionFilter(),
+			"aws_cloudwatchlogs_tag":                                  resourceAwsCloudwatchlogsTag(),
 			"aws_config_aggregate_authorization":                      resourceAwsConfigAggregateAuthorization(),
 			"aws_config_config_rule":                                  resourceAwsConfigConfigRule(),
 			"aws_config_configuration_aggregator":                     resourceAwsConfigConfigurationAggregator(),
@@ -626,40 +887,51 @@ func Provider() *schema.Provider {
 			"aws_config_organization_custom_rule":                     resourceAwsConfigOrganizationCustomRule(),
 			"aws_config_organization_managed_rule":                    resourceAwsConfigOrganizationManagedRule(),
 			"aws_config_remediation_configuration":                    resourceAwsConfigRemediationConfiguration(),
+			"aws_configservice_tag":                                   resourceAwsConfigserviceTag(),
 			"aws_cognito_identity_pool":                               resourceAwsCognitoIdentityPool(),
 			"aws_cognito_identity_pool_roles_attachment":              resourceAwsCognitoIdentityPoolRolesAttachment(),
 			"aws_cognito_identity_provider":                           resourceAwsCognitoIdentityProvider(),
+			"aws_cognitoidentity_tag":                                 resourceAwsCognitoidentityTag(),
 			"aws_cognito_resource_server":                             resourceAwsCognitoResourceServer(),
 			"aws_cognito_user_group":                                  resourceAwsCognitoUserGroup(),
 			"aws_cognito_user_pool":                                   resourceAwsCognitoUserPool(),
 			"aws_cognito_user_pool_client":                            resourceAwsCognitoUserPoolClient(),
 			"aws_cognito_user_pool_domain":                            resourceAwsCognitoUserPoolDomain(),
 			"aws_cognito_user_pool_ui_customization":                  resourceAwsCognitoUserPoolUICustomization(),
+			"aws_cognitoidentityprovider_tag":                         resourceAwsCognitoidentityproviderTag(),
 			"aws_cloudhsm_v2_cluster":                                 resourceAwsCloudHsmV2Cluster(),
 			"aws_cloudhsm_v2_hsm":                                     resourceAwsCloudHsmV2Hsm(),
+			"aws_cloudhsmv2_tag":                                      resourceAwsCloudhsmv2Tag(),
 			"aws_cloudwatch_composite_alarm":                          resourceAwsCloudWatchCompositeAlarm(),
 			"aws_cloudwatch_metric_alarm":                             resourceAwsCloudWatchMetricAlarm(),
 			"aws_cloudwatch_dashboard":                                resourceAwsCloudWatchDashboard(),
 			"aws_cloudwatch_metric_stream":                            resourceAwsCloudWatchMetricStream(),
 			"aws_cloudwatch_query_definition":                         resourceAwsCloudWatchQueryDefinition(),
+			"aws_cloudwatch_tag":                                      resourceAwsCloudwatchTag(),
 			"aws_codedeploy_app":                                      resourceAwsCodeDeployApp(),
 			"aws_codedeploy_deployment_config":                        resourceAwsCodeDeployDeploymentConfig(),
 			"aws_codedeploy_deployment_group":                         resourceAwsCodeDeployDeploymentGroup(),
+			"aws_codedeploy_tag":                                      resourceAwsCodedeployTag(),
 			"aws_codecommit_repository":                               resourceAwsCodeCommitRepository(),
+			"aws_codecommit_tag":                                      resourceAwsCodecommitTag(),
 			"aws_codecommit_trigger":                                  resourceAwsCodeCommitTrigger(),
 			"aws_codeartifact_domain":                                 resourceAwsCodeArtifactDomain(),
 			"aws_codeartifact_domain_permissions_policy":              resourceAwsCodeArtifactDomainPermissionsPolicy(),
 			"aws_codeartifact_repository":                             resourceAwsCodeArtifactRepository(),
 			"aws_codeartifact_repository_permissions_policy":          resourceAwsCodeArtifactRepositoryPermissionsPolicy(),
+			"aws_codeartifact_tag":                                    resourceAwsCodeartifactTag(),
 			"aws_codebuild_project":                                   resourceAwsCodeBuildProject(),
 			"aws_codebuild_report_group":                              resourceAwsCodeBuildReportGroup(),
 			"aws_codebuild_source_credential":                         resourceAwsCodeBuildSourceCredential(),
 			"aws_codebuild_webhook":                                   resourceAwsCodeBuildWebhook(),
 			"aws_codepipeline":                                        resourceAwsCodePipeline(),
+			"aws_codepipeline_tag":                                    resourceAwsCodepipelineTag(),
 			"aws_codepipeline_webhook":                                resourceAwsCodePipelineWebhook(),
 			"aws_codestarconnections_connection":                      resourceAwsCodeStarConnectionsConnection(),
 			"aws_codestarconnections_host":                            resourceAwsCodeStarConnectionsHost(),
+			"aws_codestarconnections_tag":                             resourceAwsCodestarconnectionsTag(),
 			"aws_codestarnotifications_notification_rule":             resourceAwsCodeStarNotificationsNotificationRule(),
+			"aws_codestarnotifications_tag":                           resourceAwsCodestarnotificationsTag(),
 			"aws_connect_contact_flow":                                resourceAwsConnectContactFlow(),
 			"aws_connect_instance":                                    resourceAwsConnectInstance(),
 			"aws_cur_report_definition":                               resourceAwsCurReportDefinition(),
@@ -671,10 +943,13 @@ func Provider() *schema.Provider {
 			"aws_datasync_location_nfs":                               resourceAwsDataSyncLocationNfs(),
 			"aws_datasync_location_s3":                                resourceAwsDataSyncLocationS3(),
 			"aws_datasync_location_smb":                               resourceAwsDataSyncLocationSmb(),
+			"aws_datasync_tag":                                        resourceAwsDatasyncTag(),
 			"aws_datasync_task":                                       resourceAwsDataSyncTask(),
+			"aws_dataexchange_tag":                                    resourceAwsDataexchangeTag(),
 			"aws_dax_cluster":                                         resourceAwsDaxCluster(),
 			"aws_dax_parameter_group":                                 resourceAwsDaxParameterGroup(),
 			"aws_dax_subnet_group":                                    resourceAwsDaxSubnetGroup(),
+			"aws_dax_tag":                                             resourceAwsDaxTag(),
 			"aws_db_cluster_snapshot":                                 resourceAwsDbClusterSnapshot(),
 			"aws_db_event_subscription":                               resourceAwsDbEventSubscription(),
 			"aws_db_instance":                                         resourceAwsDbInstance(),
@@ -689,21 +964,26 @@ func Provider() *schema.Provider {
 			"aws_db_snapshot":                                         resourceAwsDbSnapshot(),
 			"aws_db_subnet_group":                                     resourceAwsDbSubnetGroup(),
 			"aws_devicefarm_project":                                  resourceAwsDevicefarmProject(),
+			"aws_devicefarm_tag":                                      resourceAwsDevicefarmTag(),
 			"aws_directory_service_directory":                         resourceAwsDirectoryServiceDirectory(),
 			"aws_directory_service_conditional_forwarder":             resourceAwsDirectoryServiceConditionalForwarder(),
 			"aws_directory_service_log_subscription":                  resourceAwsDirectoryServiceLogSubscription(),
+			"aws_directoryservice_tag":                                resourceAwsDirectoryserviceTag(),
 			"aws_dlm_lifecycle_policy":                                resourceAwsDlmLifecyclePolicy(),
+			"aws_dlm_tag":                                             resourceAwsDlmTag(),
 			"aws_dms_certificate":                                     resourceAwsDmsCertificate(),
 			"aws_dms_endpoint":                                        resourceAwsDmsEndpoint(),
 			"aws_dms_event_subscription":                              resourceAwsDmsEventSubscription(),
 			"aws_dms_replication_instance":                            resourceAwsDmsReplicationInstance(),
 			"aws_dms_replication_subnet_group":                        resourceAwsDmsReplicationSubnetGroup(),
 			"aws_dms_replication_task":                                resourceAwsDmsReplicationTask(),
+			"aws_databasemigrationservice_tag":                        resourceAwsDatabasemigrationserviceTag(),
 			"aws_docdb_cluster":                                       resourceAwsDocDBCluster(),
 			"aws_docdb_cluster_instance":                              resourceAwsDocDBClusterInstance(),
 			"aws_docdb_cluster_parameter_group":                       resourceAwsDocDBClusterParameterGroup(),
 			"aws_docdb_cluster_snapshot":                              resourceAwsDocDBClusterSnapshot(),
 			"aws_docdb_subnet_group":                                  resourceAwsDocDBSubnetGroup(),
+			"aws_docdb_tag":                                           resourceAwsDocdbTag(),
 			"aws_dx_bgp_peer":                                         resourceAwsDxBgpPeer(),
 			"aws_dx_connection":                                       resourceAwsDxConnection(),
 			"aws_dx_connection_association":                           resourceAwsDxConnectionAssociation(),
@@ -722,6 +1002,7 @@ func Provider() *schema.Provider {
 			"aws_dx_private_virtual_interface":                        resourceAwsDxPrivateVirtualInterface(),
 			"aws_dx_public_virtual_interface":                         resourceAwsDxPublicVirtualInterface(),
 			"aws_dx_transit_virtual_interface":                        resourceAwsDxTransitVirtualInterface(),
+			"aws_directconnect_tag":                                   resourceAwsDirectconnectTag(),
 			"aws_dynamodb_table":                                      resourceAwsDynamoDbTable(),
 			"aws_dynamodb_table_item":                                 resourceAwsDynamoDbTableItem(),
 			"aws_dynamodb_tag":                                        resourceAwsDynamodbTag(),
@@ -767,6 +1048,7 @@ func Provider() *schema.Provider {
 			"aws_ecr_replication_configuration":                       resourceAwsEcrReplicationConfiguration(),
 			"aws_ecr_repository":                                      resourceAwsEcrRepository(),
 			"aws_ecr_repository_policy":                               resourceAwsEcrRepositoryPolicy(),
+			"aws_ecr_tag":                                             resourceAwsEcrTag(),
 			"aws_ecs_capacity_provider":                               resourceAwsEcsCapacityProvider(),
 			"aws_ecs_cluster":                                         resourceAwsEcsCluster(),
 			"aws_ecs_service":                                         resourceAwsEcsService(),
@@ -777,6 +1059,7 @@ func Provider() *schema.Provider {
 			"aws_efs_file_system":                                     resourceAwsEfsFileSystem(),
 			"aws_efs_file_system_policy":                              resourceAwsEfsFileSystemPolicy(),
 			"aws_efs_mount_target":                                    resourceAwsEfsMountTarget(),
+			"aws_efs_tag":                                             resourceAwsEfsTag(),
 			"aws_egress_only_internet_gateway":                        resourceAwsEgressOnlyInternetGateway(),
 			"aws_eip":                                                 resourceAwsEip(),
 			"aws_eip_association":                                     resourceAwsEipAssociation(),
@@ -785,25 +1068,30 @@ func Provider() *schema.Provider {
 			"aws_eks_fargate_profile":                                 resourceAwsEksFargateProfile(),
 			"aws_eks_identity_provider_config":                        resourceAwsEksIdentityProviderConfig(),
 			"aws_eks_node_group":                                      resourceAwsEksNodeGroup(),
+			"aws_eks_tag":                                             resourceAwsEksTag(),
 			"aws_elasticache_cluster":                                 resourceAwsElasticacheCluster(),
 			"aws_elasticache_global_replication_group":                resourceAwsElasticacheGlobalReplicationGroup(),
 			"aws_elasticache_parameter_group":                         resourceAwsElasticacheParameterGroup(),
 			"aws_elasticache_replication_group":                       resourceAwsElasticacheReplicationGroup(),
 			"aws_elasticache_security_group":                          resourceAwsElasticacheSecurityGroup(),
 			"aws_elasticache_subnet_group":                            resourceAwsElasticacheSubnetGroup(),
+			"aws_elasticache_tag":                                     resourceAwsElasticacheTag(),
 			"aws_elasticache_user":                                    resourceAwsElasticacheUser(),
 			"aws_elasticache_user_group":                              resourceAwsElasticacheUserGroup(),
 			"aws_elastic_beanstalk_application":                       resourceAwsElasticBeanstalkApplication(),
 			"aws_elastic_beanstalk_application_version":               resourceAwsElasticBeanstalkApplicationVersion(),
 			"aws_elastic_beanstalk_configuration_template":            resourceAwsElasticBeanstalkConfigurationTemplate(),
 			"aws_elastic_beanstalk_environment":                       resourceAwsElasticBeanstalkEnvironment(),
+			"aws_elasticbeanstalk_tag":                                resourceAwsElasticbeanstalkTag(),
 			"aws_elasticsearch_domain":                                resourceAwsElasticSearchDomain(),
 			"aws_elasticsearch_domain_policy":                         resourceAwsElasticSearchDomainPolicy(),
 			"aws_elasticsearch_domain_saml_options":                   resourceAwsElasticSearchDomainSAMLOptions(),
+			"aws_elasticsearchservice_tag":                            resourceAwsElasticsearchserviceTag(),
 			"aws_elastictranscoder_pipeline":                          resourceAwsElasticTranscoderPipeline(),
 			"aws_elastictranscoder_preset":                            resourceAwsElasticTranscoderPreset(),
 			"aws_elb":                                                 resourceAwsElb(),
 			"aws_elb_attachment":                                      resourceAwsElbAttachment(),
+			"aws_elb_tag":                                             resourceAwsElbTag(),
 			"aws_emr_cluster":                                         resourceAwsEMRCluster(),
 			"aws_emr_instance_group":                                  resourceAwsEMRInstanceGroup(),
 			"aws_emr_instance_fleet":                                  resourceAwsEMRInstanceFleet(),
@@ -813,6 +1101,7 @@ func Provider() *schema.Provider {
 			"aws_fsx_backup":                                          resourceAwsFsxBackup(),
 			"aws_fsx_lustre_file_system":                              resourceAwsFsxLustreFileSystem(),
 			"aws_fsx_ontap_file_system":                               resourceAwsFsxOntapFileSystem(),
+			"aws_fsx_tag":                                             resourceAwsFsxTag(),
 			"aws_fsx_windows_file_system":                             resourceAwsFsxWindowsFileSystem(),
 			"aws_fms_admin_account":                                   resourceAwsFmsAdminAccount(),
 			"aws_fms_policy":                                          resourceAwsFmsPolicy(),
@@ -820,11 +1109,14 @@ func Provider() *schema.Provider {
 			"aws_gamelift_build":                                      resourceAwsGameliftBuild(),
 			"aws_gamelift_fleet":                                      resourceAwsGameliftFleet(),
 			"aws_gamelift_game_session_queue":                         resourceAwsGameliftGameSessionQueue(),
+			"aws_gamelift_tag":                                        resourceAwsGameliftTag(),
+			"aws_glacier_tag":                                         resourceAwsGlacierTag(),
 			"aws_glacier_vault":                                       resourceAwsGlacierVault(),
 			"aws_glacier_vault_lock":                                  resourceAwsGlacierVaultLock(),
 			"aws_globalaccelerator_accelerator":                       resourceAwsGlobalAcceleratorAccelerator(),
 			"aws_globalaccelerator_endpoint_group":                    resourceAwsGlobalAcceleratorEndpointGroup(),
 			"aws_globalaccelerator_listener":                          resourceAwsGlobalAcceleratorListener(),
+			"aws_globalaccelerator_tag":                               resourceAwsGlobalacceleratorTag(),
 			"aws_glue_catalog_database":                               resourceAwsGlueCatalogDatabase(),
 			"aws_glue_catalog_table":                                  resourceAwsGlueCatalogTable(),
 			"aws_glue_classifier":                                     resourceAwsGlueClassifier(),
@@ -839,9 +1131,11 @@ func Provider() *schema.Provider {
 			"aws_glue_resource_policy":                                resourceAwsGlueResourcePolicy(),
 			"aws_glue_schema":                                         resourceAwsGlueSchema(),
 			"aws_glue_security_configuration":                         resourceAwsGlueSecurityConfiguration(),
+			"aws_glue_tag":                                            resourceAwsGlueTag(),
 			"aws_glue_trigger":                                        resourceAwsGlueTrigger(),
 			"aws_glue_user_defined_function":                          resourceAwsGlueUserDefinedFunction(),
 			"aws_glue_workflow":                                       resourceAwsGlueWorkflow(),
+			"aws_greengrass_tag":                                      resourceAwsGreengrassTag(),
 			"aws_guardduty_detector":                                  resourceAwsGuardDutyDetector(),
 			"aws_guardduty_filter":                                    resourceAwsGuardDutyFilter(),
 			"aws_guardduty_invite_accepter":                           resourceAwsGuardDutyInviteAccepter(),
@@ -850,6 +1144,7 @@ func Provider() *schema.Provider {
 			"aws_guardduty_organization_admin_account":                resourceAwsGuardDutyOrganizationAdminAccount(),
 			"aws_guardduty_organization_configuration":                resourceAwsGuardDutyOrganizationConfiguration(),
 			"aws_guardduty_publishing_destination":                    resourceAwsGuardDutyPublishingDestination(),
+			"aws_guardduty_tag":                                       resourceAwsGuarddutyTag(),
 			"aws_guardduty_threatintelset":                            resourceAwsGuardDutyThreatintelset(),
 			"aws_iam_access_key":                                      resourceAwsIamAccessKey(),
 			"aws_iam_account_alias":                                   resourceAwsIamAccountAlias(),
@@ -880,6 +1175,7 @@ func Provider() *schema.Provider {
 			"aws_imagebuilder_image_pipeline":                         resourceAwsImageBuilderImagePipeline(),
 			"aws_imagebuilder_image_recipe":                           resourceAwsImageBuilderImageRecipe(),
 			"aws_imagebuilder_infrastructure_configuration":           resourceAwsImageBuilderInfrastructureConfiguration(),
+			"aws_imagebuilder_tag":                                    resourceAwsImagebuilderTag(),
 			"aws_inspector_assessment_target":                         resourceAWSInspectorAssessmentTarget(),
 			"aws_inspector_assessment_template":                       resourceAWSInspectorAssessmentTemplate(),
 			"aws_inspector_resource_group":                            resourceAWSInspectorResourceGroup(),
@@ -889,24 +1185,33 @@ func Provider() *schema.Provider {
 			"aws_iot_certificate":                                     resourceAwsIotCertificate(),
 			"aws_iot_policy":                                          resourceAwsIotPolicy(),
 			"aws_iot_policy_attachment":                               resourceAwsIotPolicyAttachment(),
+			"aws_iot_tag":                                             resourceAwsIotTag(),
 			"aws_iot_thing":                                           resourceAwsIotThing(),
 			"aws_iot_thing_principal_attachment":                      resourceAwsIotThingPrincipalAttachment(),
 			"aws_iot_thing_type":                                      resourceAwsIotThingType(),
 			"aws_iot_topic_rule":                                      resourceAwsIotTopicRule(),
 			"aws_iot_role_alias":                                      resourceAwsIotRoleAlias(),
+			"aws_iotevents_tag":                                       resourceAwsIoteventsTag(),
+			"aws_iotanalytics_tag":                                    resourceAwsIotanalyticsTag(),
 			"aws_key_pair":                                            resourceAwsKeyPair(),
 			"aws_kinesis_analytics_application":                       resourceAwsKinesisAnalyticsApplication(),
+			"aws_kinesisanalytics_tag":                                resourceAwsKinesisanalyticsTag(),
 			"aws_kinesisanalyticsv2_application":                      resourceAwsKinesisAnalyticsV2Application(),
 			"aws_kinesisanalyticsv2_application_snapshot":             resourceAwsKinesisAnalyticsV2ApplicationSnapshot(),
+			"aws_kinesisanalyticsv2_tag":                              resourceAwsKinesisanalyticsv2Tag(),
 			"aws_kinesis_firehose_delivery_stream":                    resourceAwsKinesisFirehoseDeliveryStream(),
+			"aws_firehose_tag":                                        resourceAwsFirehoseTag(),
 			"aws_kinesis_stream":                                      resourceAwsKinesisStream(),
 			"aws_kinesis_stream_consumer":                             resourceAwsKinesisStreamConsumer(),
+			"aws_kinesis_tag":                                         resourceAwsKinesisTag(),
 			"aws_kinesis_video_stream":                                resourceAwsKinesisVideoStream(),
+			"aws_kinesisvideo_tag":                                    resourceAwsKinesisvideoTag(),
 			"aws_kms_alias":                                           resourceAwsKmsAlias(),
 			"aws_kms_external_key":                                    resourceAwsKmsExternalKey(),
 			"aws_kms_grant":                                           resourceAwsKmsGrant(),
 			"aws_kms_key":                                             resourceAwsKmsKey(),
 			"aws_kms_ciphertext":                                      resourceAwsKmsCiphertext(),
+			"aws_kms_tag":                                             resourceAwsKmsTag(),
 			"aws_lakeformation_data_lake_settings":                    resourceAwsLakeFormationDataLakeSettings(),
 			"aws_lakeformation_permissions":                           resourceAwsLakeFormationPermissions(),
 			"aws_lakeformation_resource":                              resourceAwsLakeFormationResource(),
@@ -918,6 +1223,7 @@ func Provider() *schema.Provider {
 			"aws_lambda_layer_version":                                resourceAwsLambdaLayerVersion(),
 			"aws_lambda_permission":                                   resourceAwsLambdaPermission(),
 			"aws_lambda_provisioned_concurrency_config":               resourceAwsLambdaProvisionedConcurrencyConfig(),
+			"aws_lambda_tag":                                          resourceAwsLambdaTag(),
 			"aws_launch_configuration":                                resourceAwsLaunchConfiguration(),
 			"aws_launch_template":                                     resourceAwsLaunchTemplate(),
 			"aws_lex_bot":                                             resourceAwsLexBot(),
@@ -926,6 +1232,7 @@ func Provider() *schema.Provider {
 			"aws_lex_slot_type":                                       resourceAwsLexSlotType(),
 			"aws_licensemanager_association":                          resourceAwsLicenseManagerAssociation(),
 			"aws_licensemanager_license_configuration":                resourceAwsLicenseManagerLicenseConfiguration(),
+			"aws_licensemanager_tag":                                  resourceAwsLicensemanagerTag(),
 			"aws_lightsail_domain":                                    resourceAwsLightsailDomain(),
 			"aws_lightsail_instance":                                  resourceAwsLightsailInstance(),
 			"aws_lightsail_instance_public_ports":                     resourceAwsLightsailInstancePublicPorts(),
@@ -949,13 +1256,19 @@ func Provider() *schema.Provider {
 			"aws_main_route_table_association":                        resourceAwsMainRouteTableAssociation(),
 			"aws_mq_broker":                                           resourceAwsMqBroker(),
 			"aws_mq_configuration":                                    resourceAwsMqConfiguration(),
+			"aws_mq_tag":                                              resourceAwsMqTag(),
 			"aws_media_convert_queue":                                 resourceAwsMediaConvertQueue(),
+			"aws_medialive_tag":                                       resourceAwsMedialiveTag(),
+			"aws_mediaconnect_tag":                                    resourceAwsMediaconnectTag(),
 			"aws_media_package_channel":                               resourceAwsMediaPackageChannel(),
+			"aws_mediapackage_tag":                                    resourceAwsMediapackageTag(),
 			"aws_media_store_container":                               resourceAwsMediaStoreContainer(),
 			"aws_media_store_container_policy":                        resourceAwsMediaStoreContainerPolicy(),
+			"aws_mediastore_tag":                                      resourceAwsMediastoreTag(),
 			"aws_msk_cluster":                                         resourceAwsMskCluster(),
 			"aws_msk_configuration":                                   resourceAwsMskConfiguration(),
 			"aws_msk_scram_secret_association":                        resourceAwsMskScramSecretAssociation(),
+			"aws_kafka_tag":                                           resourceAwsKafkaTag(),
 			"aws_mwaa_environment":                                    resourceAwsMwaaEnvironment(),
 			"aws_nat_gateway":                                         resourceAwsNatGateway(),
 			"aws_network_acl":                                         resourceAwsNetworkAcl(),
@@ -968,6 +1281,7 @@ func Provider() *schema.Provider {
 			"aws_neptune_event_subscription":                          resourceAwsNeptuneEventSubscription(),
 			"aws_neptune_parameter_group":                             resourceAwsNeptuneParameterGroup(),
 			"aws_neptune_subnet_group":                                resourceAwsNeptuneSubnetGroup(),
+			"aws_neptune_tag":                                         resourceAwsNeptuneTag(),
 			"aws_network_acl_rule":                                    resourceAwsNetworkAclRule(),
 			"aws_network_interface":                                   resourceAwsNetworkInterface(),
 			"aws_network_interface_attachment":                        resourceAwsNetworkInterfaceAttachment(),
@@ -976,6 +1290,8 @@ func Provider() *schema.Provider {
 			"aws_networkfirewall_logging_configuration":               resourceAwsNetworkFirewallLoggingConfiguration(),
 			"aws_networkfirewall_resource_policy":                     resourceAwsNetworkFirewallResourcePolicy(),
 			"aws_networkfirewall_rule_group":                          resourceAwsNetworkFirewallRuleGroup(),
+			"aws_networkfirewall_tag":                                 resourceAwsNetworkfirewallTag(),
+			"aws_networkmanager_tag":                                  resourceAwsNetworkmanagerTag(),
 			"aws_opsworks_application":                                resourceAwsOpsworksApplication(),
 			"aws_opsworks_stack":                                      resourceAwsOpsworksStack(),
 			"aws_opsworks_java_app_layer":                             resourceAwsOpsworksJavaAppLayer(),
@@ -989,6 +1305,7 @@ func Provider() *schema.Provider {
 			"aws_opsworks_ganglia_layer":                              resourceAwsOpsworksGangliaLayer(),
 			"aws_opsworks_custom_layer":                               resourceAwsOpsworksCustomLayer(),
 			"aws_opsworks_instance":                                   resourceAwsOpsworksInstance(),
+			"aws_opsworks_tag":                                        resourceAwsOpsworksTag(),
 			"aws_opsworks_user_profile":                               resourceAwsOpsworksUserProfile(),
 			"aws_opsworks_permission":                                 resourceAwsOpsworksPermission(),
 			"aws_opsworks_rds_db_instance":                            resourceAwsOpsworksRdsDbInstance(),
@@ -998,13 +1315,16 @@ func Provider() *schema.Provider {
 			"aws_organizations_policy":                                resourceAwsOrganizationsPolicy(),
 			"aws_organizations_policy_attachment":                     resourceAwsOrganizationsPolicyAttachment(),
 			"aws_organizations_organizational_unit":                   resourceAwsOrganizationsOrganizationalUnit(),
+			"aws_organizations_tag":                                   resourceAwsOrganizationsTag(),
 			"aws_placement_group":                                     resourceAwsPlacementGroup(),
 			"aws_prometheus_workspace":                                resourceAwsPrometheusWorkspace(),
 			"aws_proxy_protocol_policy":                               resourceAwsProxyProtocolPolicy(),
 			"aws_qldb_ledger":                                         resourceAwsQLDBLedger(),
+			"aws_qldb_tag":                                            resourceAwsQldbTag(),
 			"aws_quicksight_data_source":                              resourceAwsQuickSightDataSource(),
 			"aws_quicksight_group":                                    resourceAwsQuickSightGroup(),
 			"aws_quicksight_group_membership":                         resourceAwsQuickSightGroupMembership(),
+			"aws_quicksight_tag":                                      resourceAwsQuicksightTag(),
 			"aws_quicksight_user":                                     resourceAwsQuickSightUser(),
 			"aws_ram_principal_association":                           resourceAwsRamPrincipalAssociation(),
 			"aws_ram_resource_association":                            resourceAwsRamResourceAssociation(),
@@ -1016,6 +1336,7 @@ func Provider() *schema.Provider {
 			"aws_rds_cluster_parameter_group":                         resourceAwsRDSClusterParameterGroup(),
 			"aws_rds_cluster_role_association":                        resourceAwsRDSClusterRoleAssociation(),
 			"aws_rds_global_cluster":                                  resourceAwsRDSGlobalCluster(),
+			"aws_rds_tag":                                             resourceAwsRdsTag(),
 			"aws_redshift_cluster":                                    resourceAwsRedshiftCluster(),
 			"aws_redshift_security_group":                             resourceAwsRedshiftSecurityGroup(),
 			"aws_redshift_parameter_group":                            resourceAwsRedshiftParameterGroup(),
@@ -1026,6 +1347,7 @@ func Provider() *schema.Provider {
 			"aws_redshift_event_subscription":                         resourceAwsRedshiftEventSubscription(),
 			"aws_redshift_scheduled_action":                           resourceAwsRedshiftScheduledAction(),
 			"aws_resourcegroups_group":                                resourceAwsResourceGroupsGroup(),
+			"aws_resourcegroups_tag":                                  resourceAwsResourcegroupsTag(),
 			"aws_route53_delegation_set":                              resourceAwsRoute53DelegationSet(),
 			"aws_route53_hosted_zone_dnssec":                          resourceAwsRoute53HostedZoneDnssec(),
 			"aws_route53_key_signing_key":                             resourceAwsRoute53KeySigningKey(),
@@ -1046,6 +1368,7 @@ func Provider() *schema.Provider {
 			"aws_route53_resolver_query_log_config_association":       resourceAwsRoute53ResolverQueryLogConfigAssociation(),
 			"aws_route53_resolver_rule_association":                   resourceAwsRoute53ResolverRuleAssociation(),
 			"aws_route53_resolver_rule":                               resourceAwsRoute53ResolverRule(),
+			"aws_route53resolver_tag":                                 resourceAwsRoute53resolverTag(),
 			"aws_route53recoverycontrolconfig_cluster":                resourceAwsRoute53RecoveryControlConfigCluster(),
 			"aws_route53recoverycontrolconfig_control_panel":          resourceAwsRoute53RecoveryControlConfigControlPanel(),
 			"aws_route53recoverycontrolconfig_routing_control":        resourceAwsRoute53RecoveryControlConfigRoutingControl(),
@@ -1054,6 +1377,7 @@ func Provider() *schema.Provider {
 			"aws_route53recoveryreadiness_readiness_check":            resourceAwsRoute53RecoveryReadinessReadinessCheck(),
 			"aws_route53recoveryreadiness_recovery_group":             resourceAwsRoute53RecoveryReadinessRecoveryGroup(),
 			"aws_route53recoveryreadiness_resource_set":               resourceAwsRoute53RecoveryReadinessResourceSet(),
+			"aws_route53recoveryreadiness_tag":                        resourceAwsRoute53recoveryreadinessTag(),
 			"aws_route":                                               resourceAwsRoute(),
 			"aws_route_table":                                         resourceAwsRouteTable(),
 			"aws_default_route_table":                                 resourceAwsDefaultRouteTable(),
@@ -1075,12 +1399,14 @@ func Provider() *schema.Provider {
 			"aws_sagemaker_notebook_instance_lifecycle_configuration": resourceAwsSagemakerNotebookInstanceLifeCycleConfiguration(),
 			"aws_sagemaker_notebook_instance":                         resourceAwsSagemakerNotebookInstance(),
 			"aws_sagemaker_studio_lifecycle_config":                   resourceAwsSagemakerStudioLifecycleConfig(),
+			"aws_sagemaker_tag":                                       resourceAwsSagemakerTag(),
 			"aws_sagemaker_user_profile":                              resourceAwsSagemakerUserProfile(),
 			"aws_sagemaker_workforce":                                 resourceAwsSagemakerWorkforce(),
 			"aws_sagemaker_workteam":                                  resourceAwsSagemakerWorkteam(),
 			"aws_schemas_discoverer":                                  resourceAwsSchemasDiscoverer(),
 			"aws_schemas_registry":                                    resourceAwsSchemasRegistry(),
 			"aws_schemas_schema":                                      resourceAwsSchemasSchema(),
+			"aws_schemas_tag":                                         resourceAwsSchemasTag(),
 			"aws_secretsmanager_secret":                               resourceAwsSecretsManagerSecret(),
 			"aws_secretsmanager_secret_policy":                        resourceAwsSecretsManagerSecretPolicy(),
 			"aws_secretsmanager_secret_version":                       resourceAwsSecretsManagerSecretVersion(),
@@ -1129,6 +1455,7 @@ func Provider() *schema.Provider {
 			"aws_securityhub_product_subscription":                    resourceAwsSecurityHubProductSubscription(),
 			"aws_securityhub_standards_control":                       resourceAwsSecurityHubStandardsControl(),
 			"aws_securityhub_standards_subscription":                  resourceAwsSecurityHubStandardsSubscription(),
+			"aws_securityhub_tag":                                     resourceAwsSecurityhubTag(),
 			"aws_servicecatalog_budget_resource_association":          resourceAwsServiceCatalogBudgetResourceAssociation(),
 			"aws_servicecatalog_constraint":                           resourceAwsServiceCatalogConstraint(),
 			"aws_servicecatalog_organizations_access":                 resourceAwsServiceCatalogOrganizationsAccess(),
@@ -1147,12 +1474,15 @@ func Provider() *schema.Provider {
 			"aws_service_discovery_private_dns_namespace":             resourceAwsServiceDiscoveryPrivateDnsNamespace(),
 			"aws_service_discovery_public_dns_namespace":              resourceAwsServiceDiscoveryPublicDnsNamespace(),
 			"aws_service_discovery_service":                           resourceAwsServiceDiscoveryService(),
+			"aws_servicediscovery_tag":                                resourceAwsServicediscoveryTag(),
 			"aws_servicequotas_service_quota":                         resourceAwsServiceQuotasServiceQuota(),
 			"aws_shield_protection":                                   resourceAwsShieldProtection(),
 			"aws_shield_protection_group":                             resourceAwsShieldProtectionGroup(),
+			"aws_shield_tag":                                          resourceAwsShieldTag(),
 			"aws_signer_signing_job":                                  resourceAwsSignerSigningJob(),
 			"aws_signer_signing_profile":                              resourceAwsSignerSigningProfile(),
 			"aws_signer_signing_profile_permission":                   resourceAwsSignerSigningProfilePermission(),
+			"aws_signer_tag":                                          resourceAwsSignerTag(),
 			"aws_simpledb_domain":                                     resourceAwsSimpleDBDomain(),
 			"aws_ssm_activation":                                      resourceAwsSsmActivation(),
 			"aws_ssm_association":                                     resourceAwsSsmAssociation(),
@@ -1175,6 +1505,7 @@ func Provider() *schema.Provider {
 			"aws_storagegateway_nfs_file_share":                       resourceAwsStorageGatewayNfsFileShare(),
 			"aws_storagegateway_smb_file_share":                       resourceAwsStorageGatewaySmbFileShare(),
 			"aws_storagegateway_stored_iscsi_volume":                  resourceAwsStorageGatewayStoredIscsiVolume(),
+			"aws_storagegateway_tag":                                  resourceAwsStoragegatewayTag(),
 			"aws_storagegateway_tape_pool":                            resourceAwsStorageGatewayTapePool(),
 			"aws_storagegateway_upload_buffer":                        resourceAwsStorageGatewayUploadBuffer(),
 			"aws_storagegateway_working_storage":                      resourceAwsStorageGatewayWorkingStorage(),
@@ -1183,23 +1514,29 @@ func Provider() *schema.Provider {
 			"aws_spot_fleet_request":                                  resourceAwsSpotFleetRequest(),
 			"aws_sqs_queue":                                           resourceAwsSqsQueue(),
 			"aws_sqs_queue_policy":                                    resourceAwsSqsQueuePolicy(),
+			"aws_sqs_tag":                                             resourceAwsSqsTag(),
 			"aws_snapshot_create_volume_permission":                   resourceAwsSnapshotCreateVolumePermission(),
 			"aws_sns_platform_application":                            resourceAwsSnsPlatformApplication(),
 			"aws_sns_sms_preferences":                                 resourceAwsSnsSmsPreferences(),
+			"aws_sns_tag":                                             resourceAwsSnsTag(),
 			"aws_sns_topic":                                           resourceAwsSnsTopic(),
 			"aws_sns_topic_policy":                                    resourceAwsSnsTopicPolicy(),
 			"aws_sns_topic_subscription":                              resourceAwsSnsTopicSubscription(),
 			"aws_sfn_activity":                                        resourceAwsSfnActivity(),
 			"aws_sfn_state_machine":                                   resourceAwsSfnStateMachine(),
+			"aws_sfn_tag":                                             resourceAwsSfnTag(),
 			"aws_default_subnet":                                      resourceAwsDefaultSubnet(),
 			"aws_subnet":                                              resourceAwsSubnet(),
 			"aws_swf_domain":                                          resourceAwsSwfDomain(),
+			"aws_swf_tag":                                             resourceAwsSwfTag(),
 			"aws_synthetics_canary":                                   resourceAwsSyntheticsCanary(),
 			"aws_timestreamwrite_database":                            resourceAwsTimestreamWriteDatabase(),
 			"aws_timestreamwrite_table":                               resourceAwsTimestreamWriteTable(),
+			"aws_timestreamwrite_tag":                                 resourceAwsTimestreamwriteTag(),
 			"aws_transfer_server":                                     resourceAwsTransferServer(),
 			"aws_transfer_access":                                     resourceAwsTransferAccess(),
 			"aws_transfer_ssh_key":                                    resourceAwsTransferSshKey(),
+			"aws_transfer_tag":                                        resourceAwsTransferTag(),
 			"aws_transfer_user":                                       resourceAwsTransferUser(),
 			"aws_volume_attachment":                                   resourceAwsVolumeAttachment(),
 			"aws_vpc_dhcp_options_association":                        resourceAwsVpcDhcpOptionsAssociation(),
@@ -1230,6 +1567,7 @@ func Provider() *schema.Provider {
 			"aws_waf_rule":                                            resourceAwsWafRule(),
 			"aws_waf_rule_group":                                      resourceAwsWafRuleGroup(),
 			"aws_waf_size_constraint_set":                             resourceAwsWafSizeConstraintSet(),
+			"aws_waf_tag":                                             resourceAwsWafTag(),
 			"aws_waf_web_acl":                                         resourceAwsWafWebAcl(),
 			"aws_waf_xss_match_set":                                   resourceAwsWafXssMatchSet(),
 			"aws_waf_sql_injection_match_set":                         resourceAwsWafSqlInjectionMatchSet(),
@@ -1244,22 +1582,27 @@ func Provider() *schema.Provider {
 			"aws_wafregional_rule_group":                              resourceAwsWafRegionalRuleGroup(),
 			"aws_wafregional_size_constraint_set":                     resourceAwsWafRegionalSizeConstraintSet(),
 			"aws_wafregional_sql_injection_match_set":                 resourceAwsWafRegionalSqlInjectionMatchSet(),
+			"aws_wafregional_tag":                                     resourceAwsWafregionalTag(),
 			"aws_wafregional_xss_match_set":                           resourceAwsWafRegionalXssMatchSet(),
 			"aws_wafregional_web_acl":                                 resourceAwsWafRegionalWebAcl(),
 			"aws_wafregional_web_acl_association":                     resourceAwsWafRegionalWebAclAssociation(),
 			"aws_wafv2_ip_set":                                        resourceAwsWafv2IPSet(),
 			"aws_wafv2_regex_pattern_set":                             resourceAwsWafv2RegexPatternSet(),
 			"aws_wafv2_rule_group":                                    resourceAwsWafv2RuleGroup(),
+			"aws_wafv2_tag":                                           resourceAwsWafv2Tag(),
 			"aws_wafv2_web_acl":                                       resourceAwsWafv2WebACL(),
 			"aws_wafv2_web_acl_association":                           resourceAwsWafv2WebACLAssociation(),
 			"aws_wafv2_web_acl_logging_configuration":                 resourceAwsWafv2WebACLLoggingConfiguration(),
 			"aws_worklink_fleet":                                      resourceAwsWorkLinkFleet(),
+			"aws_worklink_tag":                                        resourceAwsWorklinkTag(),
 			"aws_worklink_website_certificate_authority_association":  resourceAwsWorkLinkWebsiteCertificateAuthorityAssociation(),
 			"aws_workspaces_directory":                                resourceAwsWorkspacesDirectory(),
+			"aws_workspaces_tag":                                      resourceAwsWorkspacesTag(),
 			"aws_workspaces_workspace":                                resourceAwsWorkspacesWorkspace(),
 			"aws_batch_compute_environment":                           resourceAwsBatchComputeEnvironment(),
 			"aws_batch_job_definition":                                resourceAwsBatchJobDefinition(),
 			"aws_batch_job_queue":                                     resourceAwsBatchJobQueue(),
+			"aws_batch_tag":                                           resourceAwsBatchTag(),
 			"aws_pinpoint_app":                                        resourceAwsPinpointApp(),
 			"aws_pinpoint_adm_channel":                                resourceAwsPinpointADMChannel(),
 			"aws_pinpoint_apns_channel":                               resourceAwsPinpointAPNSChannel(),
@@ -1271,9 +1614,11 @@ func Provider() *schema.Provider {
 			"aws_pinpoint_event_stream":                               resourceAwsPinpointEventStream(),
 			"aws_pinpoint_gcm_channel":                                resourceAwsPinpointGCMChannel(),
 			"aws_pinpoint_sms_channel":                                resourceAwsPinpointSMSChannel(),
+			"aws_pinpoint_tag":                                        resourceAwsPinpointTag(),
 			"aws_xray_encryption_config":                              resourceAwsXrayEncryptionConfig(),
 			"aws_xray_group":                                          resourceAwsXrayGroup(),
 			"aws_xray_sampling_rule":                                  resourceAwsXraySamplingRule(),
+			"aws_xray_tag":                                            resourceAwsXrayTag(),
 			"aws_workspaces_ip_group":                                 resourceAwsWorkspacesIpGroup(),
 
 			// ALBs are actually LBs because they can be type `network` or `application`
@@ -1292,6 +1637,7 @@ func Provider() *schema.Provider {
 			"aws_lb_target_group":             resourceAwsLbTargetGroup(),
 			"aws_alb_target_group_attachment": resourceAwsLbTargetGroupAttachment(),
 			"aws_lb_target_group_attachment":  resourceAwsLbTargetGroupAttachment(),
+			"aws_elbv2_tag":                                           resourceAwsElbv2Tag(),
 		},
 	}
 
@@ -1300,6 +1646,10 @@ func Provider() *schema.Provider {
 	provider.DataSourcesMap["aws_serverlessapplicationrepository_application"] = dataSourceAwsServerlessApplicationRepositoryApplication()
 	provider.ResourcesMap["aws_serverlessapplicationrepository_cloudformation_stack"] = resourceAwsServerlessApplicationRepositoryCloudFormationStack()
 
+	configureResourceDefaultTags(provider.ResourcesMap)
+
+	var configureAPIMetricsOnce sync.Once
+
 	provider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
 		terraformVersion := provider.TerraformVersion
 		if terraformVersion == "" {
@@ -1307,6 +1657,23 @@ func Provider() *schema.Provider {
 			// We can therefore assume that if it's missing it's 0.10 or 0.11
 			terraformVersion = "0.11+compatible"
 		}
+
+		serviceTimeouts, err := expandProviderServiceTimeouts(d.Get("service_timeouts").([]interface{}))
+
+		if err != nil {
+			return nil, err
+		}
+
+		// Resource default timeouts are read when a resource operation is planned,
+		// which always happens after the provider is configured.
+		configureResourceServiceTimeouts(provider.ResourcesMap, serviceTimeouts)
+
+		if d.Get("api_metrics_path").(string) != "" {
+			configureAPIMetricsOnce.Do(func() {
+				configureAPIMetrics(provider.ResourcesMap, provider.DataSourcesMap)
+			})
+		}
+
 		return providerConfigure(d, terraformVersion)
 	}
 
@@ -1330,9 +1697,20 @@ func init() {
 		"profile": "The profile for API operations. If not set, the default profile\n" +
 			"created with `aws configure` will be used.",
 
+		"credential_process": "Command to run to obtain credentials, in the format used by the " +
+			"`credential_process` setting of the AWS shared config file. Takes precedence over all " +
+			"other credential sources except `assume_role_with_web_identity`.",
+
+		"shared_config_files": "List of paths to shared config files. Values in later files take precedence. " +
+			"If not set, this defaults to ~/.aws/config or the `AWS_CONFIG_FILE` environment variable.",
+
 		"shared_credentials_file": "The path to the shared credentials file. If not set\n" +
 			"this defaults to ~/.aws/credentials.",
 
+		"shared_credentials_files": "List of paths to shared credentials files. Credentials for the profile " +
+			"in later files take precedence. If not set, this defaults to ~/.aws/credentials or the " +
+			"`AWS_SHARED_CREDENTIALS_FILE` environment variable.",
+
 		"token": "session token. A session token is only required if you are\n" +
 			"using temporary security credentials.",
 
@@ -1340,11 +1718,56 @@ func init() {
 			"being executed. If the API request still fails, an error is\n" +
 			"thrown.",
 
+		"max_retry_duration": "The maximum total time, as a duration such as `10m`, to spend retrying an AWS API request. " +
+			"Retries stop when either this duration or `max_retries` is reached.",
+
+		"retry_mode": "Specifies how retries are attempted. Valid values are `standard` and `adaptive`. " +
+			"In `adaptive` mode, requests to each AWS service are additionally rate limited on the client " +
+			"when the service responds with throttling errors. " +
+			"Can also be configured using the `AWS_RETRY_MODE` environment variable.",
+
+		"api_audit_log_path": "Path of a file to which a JSON record of every AWS API call is appended, " +
+			"including the service, operation, request ID, latency, retry count and error code. " +
+			"Known sensitive request parameters are redacted.",
+
+		"api_metrics_format": "Format of the API metrics summary. Valid values are `json` and `openmetrics`. Defaults to `json`.",
+
+		"api_metrics_path": "Path of a file to which a summary of AWS API calls, throttling responses, retries and latency, " +
+			"per service operation and per resource type, is written when the provider process stops.",
+
+		"tag_policy_compliance": "Checks the tags of resources being planned against the AWS Organizations tag policy " +
+			"in effect for the account. Valid values are `warn`, which logs non-compliant tags, and `error`, " +
+			"which fails the plan. If omitted, tags are not checked.",
+
 		"http_proxy": "The address of an HTTP proxy to use when accessing the AWS API. " +
 			"Can also be configured using the `HTTP_PROXY` or `HTTPS_PROXY` environment variables.",
 
+		"no_proxy": "Comma-separated list of hosts that should not be accessed through the `http_proxy`. " +
+			"Can also be configured using the `NO_PROXY` environment variable.",
+
+		"custom_ca_bundle": "File containing custom root and intermediate certificates, or the PEM-encoded " +
+			"certificates themselves, to trust when connecting to AWS service endpoints. " +
+			"Can also be configured using the `AWS_CA_BUNDLE` environment variable.",
+
 		"endpoint": "Use this to override the default service endpoint URL",
 
+		"endpoint_url_template": "URL template for the endpoints of all services not configured in `endpoints`. " +
+			"The `{service}` and `{region}` placeholders are replaced with the service name, as used in `endpoints`, " +
+			"and the provider region, e.g. `https://{service}.{region}.internal`.",
+
+		"local_emulator": "Configure the provider for a local AWS emulator. Skips credentials validation, " +
+			"requesting the account ID, getting the supported EC2 platforms and the AWS Metadata API check, " +
+			"forces S3 path-style addressing and, unless `endpoint_url_template` is set, sends all requests to `http://localhost:4566`.",
+
+		"extra_retryable_errors": "Configuration block describing an AWS API error that should be retried, " +
+			"in addition to the errors retried by the provider.",
+
+		"service_retry": "Configuration block with retry settings for requests to a single AWS service. " +
+			"Overrides `max_retries` for that service.",
+
+		"service_timeouts": "Configuration block with default create, update and delete timeouts for " +
+			"all resources of a single AWS service. Timeouts configured in a resource's `timeouts` block take precedence.",
+
 		"insecure": "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted, " +
 			"default value is `false`",
 
@@ -1363,10 +1786,26 @@ func init() {
 		"skip_medatadata_api_check": "Skip the AWS Metadata API check. " +
 			"Used for AWS API implementations that do not have a metadata api endpoint.",
 
+		"ec2_metadata_service_endpoint": "Address of the EC2 Instance Metadata Service (IMDS) endpoint to use. " +
+			"Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT` environment variable.",
+
+		"ec2_metadata_service_endpoint_mode": "Protocol to use with the EC2 Instance Metadata Service (IMDS) endpoint. " +
+			"Valid values are `IPv4` and `IPv6`. " +
+			"Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE` environment variable.",
+
+		"ec2_metadata_service_require_token": "Require IMDSv2 session tokens when obtaining credentials from the " +
+			"EC2 Instance Metadata Service (IMDS), disabling the fallback to IMDSv1.",
+
 		"s3_force_path_style": "Set this to true to force the request to use path-style addressing,\n" +
 			"i.e., http://s3.amazonaws.com/BUCKET/KEY. By default, the S3 client will\n" +
 			"use virtual hosted bucket addressing when possible\n" +
 			"(http://BUCKET.s3.amazonaws.com/KEY). Specific to the Amazon S3 service.",
+
+		"use_dualstack_endpoint": "Resolve an endpoint with dual-stack (IPv4 and IPv6) capability. " +
+			"Explicitly configured `endpoints` take precedence.",
+
+		"use_fips_endpoint": "Resolve an endpoint with FIPS capability. " +
+			"Explicitly configured `endpoints` take precedence.",
 	}
 
 	endpointServiceNames = []string{
@@ -1538,39 +1977,68 @@ func init() {
 func providerConfigure(d *schema.ResourceData, terraformVersion string) (interface{}, error) {
 	config := Config{
 		AccessKey:               d.Get("access_key").(string),
+		APIAuditLogPath:         d.Get("api_audit_log_path").(string),
+		APIMetricsFormat:        d.Get("api_metrics_format").(string),
+		APIMetricsPath:          d.Get("api_metrics_path").(string),
 		SecretKey:               d.Get("secret_key").(string),
 		Profile:                 d.Get("profile").(string),
 		Token:                   d.Get("token").(string),
 		Region:                  d.Get("region").(string),
+		CredentialProcess:       d.Get("credential_process").(string),
 		CredsFilename:           d.Get("shared_credentials_file").(string),
 		DefaultTagsConfig:       expandProviderDefaultTags(d.Get("default_tags").([]interface{})),
 		Endpoints:               make(map[string]string),
+		EndpointURLTemplate:     d.Get("endpoint_url_template").(string),
 		MaxRetries:              d.Get("max_retries").(int),
 		IgnoreTagsConfig:        expandProviderIgnoreTags(d.Get("ignore_tags").([]interface{})),
+		RequiredTagsConfig:      expandProviderRequiredTags(d.Get("required_tags").([]interface{})),
+		TagPolicyCompliance:     d.Get("tag_policy_compliance").(string),
 		Insecure:                d.Get("insecure").(bool),
 		HTTPProxy:               d.Get("http_proxy").(string),
+		LocalEmulator:           d.Get("local_emulator").(bool),
+		NoProxy:                 d.Get("no_proxy").(string),
+		CustomCABundle:          d.Get("custom_ca_bundle").(string),
 		SkipCredsValidation:     d.Get("skip_credentials_validation").(bool),
 		SkipGetEC2Platforms:     d.Get("skip_get_ec2_platforms").(bool),
 		SkipRegionValidation:    d.Get("skip_region_validation").(bool),
 		SkipRequestingAccountId: d.Get("skip_requesting_account_id").(bool),
 		SkipMetadataApiCheck:    d.Get("skip_metadata_api_check").(bool),
 		S3ForcePathStyle:        d.Get("s3_force_path_style").(bool),
+		UseDualStackEndpoint:    d.Get("use_dualstack_endpoint").(bool),
+		UseFIPSEndpoint:         d.Get("use_fips_endpoint").(bool),
 		terraformVersion:        terraformVersion,
+
+		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
+		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
+		EC2MetadataServiceRequireToken: d.Get("ec2_metadata_service_require_token").(bool),
 	}
 
-	if l, ok := d.Get("assume_role").([]interface{}); ok && len(l) > 0 && l[0] != nil {
-		m := l[0].(map[string]interface{})
+	if v, ok := d.GetOk("max_retry_duration"); ok {
+		// Validated by validateDuration.
+		config.MaxRetryDuration, _ = time.ParseDuration(v.(string))
+	}
 
-		if v, ok := m["duration_seconds"].(int); ok && v != 0 {
-			config.AssumeRoleDurationSeconds = v
-		}
+	config.RetryMode = d.Get("retry_mode").(string)
 
-		if v, ok := m["external_id"].(string); ok && v != "" {
-			config.AssumeRoleExternalID = v
-		}
+	for _, v := range d.Get("shared_config_files").([]interface{}) {
+		config.SharedConfigFiles = append(config.SharedConfigFiles, v.(string))
+	}
 
-		if v, ok := m["policy"].(string); ok && v != "" {
-			config.AssumeRolePolicy = v
+	for _, v := range d.Get("shared_credentials_files").([]interface{}) {
+		config.SharedCredentialsFiles = append(config.SharedCredentialsFiles, v.(string))
+	}
+
+	for _, assumeRole := range expandProviderAssumeRole(d.Get("assume_role").([]interface{})) {
+		config.AssumeRole = append(config.AssumeRole, assumeRole)
+
+		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q)", assumeRole.RoleARN, assumeRole.SessionName, assumeRole.ExternalID)
+	}
+
+	if l, ok := d.Get("assume_role_with_web_identity").([]interface{}); ok && len(l) > 0 && l[0] != nil {
+		m := l[0].(map[string]interface{})
+
+		if v, ok := m["duration_seconds"].(int); ok && v != 0 {
+			config.AssumeRoleWithWebIdentityDurationSeconds = v
 		}
 
 		if policyARNSet, ok := m["policy_arns"].(*schema.Set); ok && policyARNSet.Len() > 0 {
@@ -1581,45 +2049,27 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 					continue
 				}
 
-				config.AssumeRolePolicyARNs = append(config.AssumeRolePolicyARNs, policyARN)
+				config.AssumeRoleWithWebIdentityPolicyARNs = append(config.AssumeRoleWithWebIdentityPolicyARNs, policyARN)
 			}
 		}
 
 		if v, ok := m["role_arn"].(string); ok && v != "" {
-			config.AssumeRoleARN = v
+			config.AssumeRoleWithWebIdentityARN = v
 		}
 
 		if v, ok := m["session_name"].(string); ok && v != "" {
-			config.AssumeRoleSessionName = v
+			config.AssumeRoleWithWebIdentitySessionName = v
 		}
 
-		if tagMapRaw, ok := m["tags"].(map[string]interface{}); ok && len(tagMapRaw) > 0 {
-			config.AssumeRoleTags = make(map[string]string)
-
-			for k, vRaw := range tagMapRaw {
-				v, ok := vRaw.(string)
-
-				if !ok {
-					continue
-				}
-
-				config.AssumeRoleTags[k] = v
-			}
+		if v, ok := m["web_identity_token"].(string); ok && v != "" {
+			config.AssumeRoleWithWebIdentityToken = v
 		}
 
-		if transitiveTagKeySet, ok := m["transitive_tag_keys"].(*schema.Set); ok && transitiveTagKeySet.Len() > 0 {
-			for _, transitiveTagKeyRaw := range transitiveTagKeySet.List() {
-				transitiveTagKey, ok := transitiveTagKeyRaw.(string)
-
-				if !ok {
-					continue
-				}
-
-				config.AssumeRoleTransitiveTagKeys = append(config.AssumeRoleTransitiveTagKeys, transitiveTagKey)
-			}
+		if v, ok := m["web_identity_token_file"].(string); ok && v != "" {
+			config.AssumeRoleWithWebIdentityTokenFile = v
 		}
 
-		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q)", config.AssumeRoleARN, config.AssumeRoleSessionName, config.AssumeRoleExternalID)
+		log.Printf("[INFO] assume_role_with_web_identity configuration set: (ARN: %q, SessionID: %q)", config.AssumeRoleWithWebIdentityARN, config.AssumeRoleWithWebIdentitySessionName)
 	}
 
 	endpointsSet := d.Get("endpoints").(*schema.Set)
@@ -1631,6 +2081,34 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		}
 	}
 
+	config.ExtraRetryableErrors = expandProviderExtraRetryableErrors(d.Get("extra_retryable_errors").([]interface{}))
+
+	serviceRetries, err := expandProviderServiceRetry(d.Get("service_retry").([]interface{}))
+
+	if err != nil {
+		return nil, err
+	}
+
+	config.ServiceRetries = serviceRetries
+
+	tagKeyCaseConfig, err := expandProviderTagKeyNormalization(d.Get("tag_key_normalization").([]interface{}))
+
+	if err != nil {
+		return nil, err
+	}
+
+	config.TagKeyCaseConfig = tagKeyCaseConfig
+
+	if config.DefaultTagsConfig != nil {
+		defaultTags, collisions := config.DefaultTagsConfig.Tags.NormalizeKeyCase(tagKeyCaseConfig)
+
+		if len(collisions) > 0 {
+			return nil, fmt.Errorf("default_tags collide when normalized by tag_key_normalization: %s", strings.Join(collisions, "; "))
+		}
+
+		config.DefaultTagsConfig.Tags = defaultTags
+	}
+
 	if v, ok := d.GetOk("allowed_account_ids"); ok {
 		for _, accountIDRaw := range v.(*schema.Set).List() {
 			config.AllowedAccountIds = append(config.AllowedAccountIds, accountIDRaw.(string))
@@ -1653,7 +2131,6 @@ func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
 		Optional: true,
-		MaxItems: 1,
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"duration_seconds": {
@@ -1709,6 +2186,56 @@ func assumeRoleSchema() *schema.Schema {
 	}
 }
 
+func assumeRoleWithWebIdentitySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"duration_seconds": {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Description: "Seconds to restrict the assume role session duration.",
+				},
+				"policy_arns": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: "Amazon Resource Names (ARNs) of IAM Policies describing further restricting permissions for the IAM Role being assumed.",
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validateArn,
+					},
+				},
+				"role_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "Amazon Resource Name of an IAM Role to assume with a web identity token.",
+					ValidateFunc: validateArn,
+				},
+				"session_name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Identifier for the assumed role session.",
+				},
+				"web_identity_token": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Sensitive:    true,
+					Description:  "OAuth 2.0 access token or OpenID Connect ID token provided by the identity provider.",
+					ExactlyOneOf: []string{"assume_role_with_web_identity.0.web_identity_token", "assume_role_with_web_identity.0.web_identity_token_file"},
+				},
+				"web_identity_token_file": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Path to a file containing an OAuth 2.0 access token or OpenID Connect ID token provided by the identity provider.",
+					ExactlyOneOf: []string{"assume_role_with_web_identity.0.web_identity_token", "assume_role_with_web_identity.0.web_identity_token_file"},
+				},
+			},
+		},
+	}
+}
+
 func endpointsSchema() *schema.Schema {
 	endpointsAttributes := make(map[string]*schema.Schema)
 
@@ -1730,6 +2257,191 @@ func endpointsSchema() *schema.Schema {
 	}
 }
 
+func extraRetryableErrorsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: descriptions["extra_retryable_errors"],
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"error_code": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "The error code, e.g. `ConflictException`.",
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				"max_attempts": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  "The maximum number of attempts made when the error is returned.",
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"message": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "A substring which must be contained in the error message.",
+				},
+				"operations": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: "The API operation names for which the error is retried. A name ending in `*` matches all operations with that prefix.",
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				"service": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "The service name, as used in the `endpoints` configuration block.",
+					ValidateFunc: validation.StringInSlice(endpointServiceNames, false),
+				},
+			},
+		},
+	}
+}
+
+func serviceRetrySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: descriptions["service_retry"],
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_backoff": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "The maximum delay between retries, as a duration such as `30s`.",
+					ValidateFunc: validateDuration,
+				},
+				"max_retries": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  "The maximum number of times a request to the service is retried.",
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"service": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "The service name, as used in the `endpoints` configuration block.",
+					ValidateFunc: validation.StringInSlice(endpointServiceNames, false),
+				},
+			},
+		},
+	}
+}
+
+func serviceTimeoutsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: descriptions["service_timeouts"],
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"create": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validateDuration,
+				},
+				"delete": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validateDuration,
+				},
+				"service": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "The service name, as used in the `endpoints` configuration block.",
+					ValidateFunc: validation.StringInSlice(endpointServiceNames, false),
+				},
+				"update": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validateDuration,
+				},
+			},
+		},
+	}
+}
+
+func expandProviderAssumeRole(l []interface{}) []*AssumeRole {
+	var assumeRoles []*AssumeRole
+
+	for _, tfMapRaw := range l {
+		m, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		assumeRole := &AssumeRole{}
+
+		if v, ok := m["duration_seconds"].(int); ok && v != 0 {
+			assumeRole.DurationSeconds = v
+		}
+
+		if v, ok := m["external_id"].(string); ok && v != "" {
+			assumeRole.ExternalID = v
+		}
+
+		if v, ok := m["policy"].(string); ok && v != "" {
+			assumeRole.Policy = v
+		}
+
+		if policyARNSet, ok := m["policy_arns"].(*schema.Set); ok && policyARNSet.Len() > 0 {
+			for _, policyARNRaw := range policyARNSet.List() {
+				policyARN, ok := policyARNRaw.(string)
+
+				if !ok {
+					continue
+				}
+
+				assumeRole.PolicyARNs = append(assumeRole.PolicyARNs, policyARN)
+			}
+		}
+
+		if v, ok := m["role_arn"].(string); ok && v != "" {
+			assumeRole.RoleARN = v
+		}
+
+		if v, ok := m["session_name"].(string); ok && v != "" {
+			assumeRole.SessionName = v
+		}
+
+		if tagMapRaw, ok := m["tags"].(map[string]interface{}); ok && len(tagMapRaw) > 0 {
+			assumeRole.Tags = make(map[string]string)
+
+			for k, vRaw := range tagMapRaw {
+				v, ok := vRaw.(string)
+
+				if !ok {
+					continue
+				}
+
+				assumeRole.Tags[k] = v
+			}
+		}
+
+		if transitiveTagKeySet, ok := m["transitive_tag_keys"].(*schema.Set); ok && transitiveTagKeySet.Len() > 0 {
+			for _, transitiveTagKeyRaw := range transitiveTagKeySet.List() {
+				transitiveTagKey, ok := transitiveTagKeyRaw.(string)
+
+				if !ok {
+					continue
+				}
+
+				assumeRole.TransitiveTagKeys = append(assumeRole.TransitiveTagKeys, transitiveTagKey)
+			}
+		}
+
+		// An empty assume_role block is not a role hop.
+		if assumeRole.RoleARN == "" {
+			continue
+		}
+
+		assumeRoles = append(assumeRoles, assumeRole)
+	}
+
+	return assumeRoles
+}
+
 func expandProviderDefaultTags(l []interface{}) *keyvaluetags.DefaultConfig {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -1741,9 +2453,75 @@ func expandProviderDefaultTags(l []interface{}) *keyvaluetags.DefaultConfig {
 	if v, ok := m["tags"].(map[string]interface{}); ok {
 		defaultConfig.Tags = keyvaluetags.New(v)
 	}
+
+	if v, ok := m["exclude_resource_types"].(*schema.Set); ok {
+		for _, resourceType := range v.List() {
+			defaultConfig.ExcludeResourceTypes = append(defaultConfig.ExcludeResourceTypes, resourceType.(string))
+		}
+	}
+
 	return defaultConfig
 }
 
+func expandProviderRequiredTags(l []interface{}) *keyvaluetags.RequiredConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	requiredConfig := &keyvaluetags.RequiredConfig{}
+	m := l[0].(map[string]interface{})
+
+	if v, ok := m["enforcement_level"].(string); ok {
+		requiredConfig.EnforcementLevel = v
+	}
+
+	if v, ok := m["tag"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			requiredTag := &keyvaluetags.RequiredTag{
+				Key: tfMap["key"].(string),
+			}
+
+			if v, ok := tfMap["allowed_value_regex"].(string); ok && v != "" {
+				requiredTag.ValueRegex = regexp.MustCompile(v)
+			}
+
+			requiredConfig.Tags = append(requiredConfig.Tags, requiredTag)
+		}
+	}
+
+	return requiredConfig
+}
+
+func expandProviderTagKeyNormalization(l []interface{}) (*keyvaluetags.KeyCaseConfig, error) {
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	var canonicalKeys []string
+
+	if v, ok := m["canonical_keys"].(*schema.Set); ok {
+		for _, key := range v.List() {
+			canonicalKeys = append(canonicalKeys, key.(string))
+		}
+	}
+
+	keyCaseConfig, err := keyvaluetags.NewKeyCaseConfig(canonicalKeys)
+
+	if err != nil {
+		return nil, fmt.Errorf("error expanding tag_key_normalization: %w", err)
+	}
+
+	return keyCaseConfig, nil
+}
+
 func expandProviderIgnoreTags(l []interface{}) *keyvaluetags.IgnoreConfig {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -1760,9 +2538,150 @@ func expandProviderIgnoreTags(l []interface{}) *keyvaluetags.IgnoreConfig {
 		ignoreConfig.KeyPrefixes = keyvaluetags.New(v.List())
 	}
 
+	if v, ok := m["key_suffixes"].(*schema.Set); ok {
+		ignoreConfig.KeySuffixes = keyvaluetags.New(v.List())
+	}
+
+	if v, ok := m["key_regexes"].(*schema.Set); ok {
+		for _, keyRegex := range v.List() {
+			ignoreConfig.KeyRegexes = append(ignoreConfig.KeyRegexes, regexp.MustCompile(keyRegex.(string)))
+		}
+	}
+
+	if v, ok := m["rule"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			rule := &keyvaluetags.IgnoreRule{}
+
+			if v, ok := tfMap["key_regex"].(string); ok && v != "" {
+				rule.KeyRegex = regexp.MustCompile(v)
+			}
+
+			if v, ok := tfMap["value_regex"].(string); ok && v != "" {
+				rule.ValueRegex = regexp.MustCompile(v)
+			}
+
+			if rule.KeyRegex == nil && rule.ValueRegex == nil {
+				continue
+			}
+
+			ignoreConfig.Rules = append(ignoreConfig.Rules, rule)
+		}
+	}
+
 	return ignoreConfig
 }
 
+func expandProviderExtraRetryableErrors(l []interface{}) []*RetryableError {
+	var retryableErrors []*RetryableError
+
+	for _, tfMapRaw := range l {
+		m, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		retryableError := &RetryableError{
+			Code:    m["error_code"].(string),
+			Service: m["service"].(string),
+		}
+
+		if v, ok := m["max_attempts"].(int); ok && v != 0 {
+			retryableError.MaxAttempts = v
+		}
+
+		if v, ok := m["message"].(string); ok && v != "" {
+			retryableError.Message = v
+		}
+
+		if v, ok := m["operations"].(*schema.Set); ok {
+			for _, operationRaw := range v.List() {
+				retryableError.Operations = append(retryableError.Operations, operationRaw.(string))
+			}
+		}
+
+		retryableErrors = append(retryableErrors, retryableError)
+	}
+
+	return retryableErrors
+}
+
+func expandProviderServiceRetry(l []interface{}) (map[string]*ServiceRetry, error) {
+	serviceRetries := make(map[string]*ServiceRetry)
+
+	for _, tfMapRaw := range l {
+		m, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		service := m["service"].(string)
+
+		if _, ok := serviceRetries[service]; ok {
+			return nil, fmt.Errorf("duplicate service_retry configuration block for service (%s)", service)
+		}
+
+		serviceRetry := &ServiceRetry{}
+
+		if v, ok := m["max_backoff"].(string); ok && v != "" {
+			// Validated by validateDuration.
+			serviceRetry.MaxBackoff, _ = time.ParseDuration(v)
+		}
+
+		if v, ok := m["max_retries"].(int); ok && v != 0 {
+			serviceRetry.MaxRetries = v
+		}
+
+		serviceRetries[service] = serviceRetry
+	}
+
+	return serviceRetries, nil
+}
+
+func expandProviderServiceTimeouts(l []interface{}) (map[string]*ServiceTimeouts, error) {
+	serviceTimeouts := make(map[string]*ServiceTimeouts)
+
+	for _, tfMapRaw := range l {
+		m, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		service := m["service"].(string)
+
+		if _, ok := serviceTimeouts[service]; ok {
+			return nil, fmt.Errorf("duplicate service_timeouts configuration block for service (%s)", service)
+		}
+
+		timeouts := &ServiceTimeouts{}
+
+		// Validated by validateDuration.
+		if v, ok := m["create"].(string); ok && v != "" {
+			timeouts.Create, _ = time.ParseDuration(v)
+		}
+
+		if v, ok := m["delete"].(string); ok && v != "" {
+			timeouts.Delete, _ = time.ParseDuration(v)
+		}
+
+		if v, ok := m["update"].(string); ok && v != "" {
+			timeouts.Update, _ = time.ParseDuration(v)
+		}
+
+		serviceTimeouts[service] = timeouts
+	}
+
+	return serviceTimeouts, nil
+}
+
 // ReverseDns switches a DNS hostname to reverse DNS and vice-versa.
 func ReverseDns(hostname string) string {
 	parts := strings.Split(hostname, ".")
@@ -0,0 +1,216 @@
+package aws
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceTypePrefixServiceNames maps resource type name prefixes, without the "aws_" prefix,
+// to service names used in the endpoints configuration block where the two differ.
+var resourceTypePrefixServiceNames = map[string]string{
+	"alb":                          "elb",
+	"ami":                          "ec2",
+	"api_gateway":                  "apigateway",
+	"apigatewayv2":                 "apigateway",
+	"app_cookie_stickiness_policy": "elb",
+	"appautoscaling":               "applicationautoscaling",
+	"cloudwatch_event":             "cloudwatchevents",
+	"cloudwatch_log":               "cloudwatchlogs",
+	"cloudwatch_query_definition":  "cloudwatchlogs",
+	"cognito":                      "cognitoidp",
+	"cognito_identity_pool":        "cognitoidentity",
+	"config":                       "configservice",
+	"customer_gateway":             "ec2",
+	"db":                           "rds",
+	"default_network_acl":          "ec2",
+	"default_route_table":          "ec2",
+	"default_security_group":       "ec2",
+	"default_subnet":               "ec2",
+	"default_vpc":                  "ec2",
+	"directory_service":            "ds",
+	"dx":                           "directconnect",
+	"ebs":                          "ec2",
+	"egress_only_internet_gateway": "ec2",
+	"eip":                          "ec2",
+	"elastic_beanstalk":            "elasticbeanstalk",
+	"elasticsearch":                "es",
+	"flow_log":                     "ec2",
+	"instance":                     "ec2",
+	"internet_gateway":             "ec2",
+	"key_pair":                     "ec2",
+	"launch_configuration":         "autoscaling",
+	"launch_template":              "ec2",
+	"lb":                           "elb",
+	"lb_cookie_stickiness_policy":  "elb",
+	"lb_ssl_negotiation_policy":    "elb",
+	"lex":                          "lexmodels",
+	"load_balancer":                "elb",
+	"main_route_table_association": "ec2",
+	"media_convert":                "mediaconvert",
+	"media_package":                "mediapackage",
+	"media_store":                  "mediastore",
+	"msk":                          "kafka",
+	"nat_gateway":                  "ec2",
+	"network_acl":                  "ec2",
+	"network_interface":            "ec2",
+	"placement_group":              "ec2",
+	"proxy_protocol_policy":        "elb",
+	"route":                        "ec2",
+	"route_table":                  "ec2",
+	"route53_resolver":             "route53resolver",
+	"security_group":               "ec2",
+	"service_discovery":            "servicediscovery",
+	"sfn":                          "stepfunctions",
+	"simpledb":                     "sdb",
+	"snapshot_create_volume":       "ec2",
+	"spot":                         "ec2",
+	"subnet":                       "ec2",
+	"volume_attachment":            "ec2",
+	"vpc":                          "ec2",
+	"vpn":                          "ec2",
+}
+
+// resourceServiceName returns the service name, as used in the endpoints configuration block,
+// of the AWS service that manages a resource type, or "" if it cannot be determined.
+// The longest matching resource type name prefix is used,
+// e.g. "aws_route53_resolver_rule" is a "route53resolver" resource, not a "route53" resource.
+func resourceServiceName(resourceType string) string {
+	name := strings.TrimPrefix(resourceType, "aws_")
+	serviceName := ""
+	matchLen := 0
+
+	match := func(prefix, service string) {
+		if len(prefix) <= matchLen {
+			return
+		}
+
+		if name == prefix || strings.HasPrefix(name, prefix+"_") {
+			serviceName = service
+			matchLen = len(prefix)
+		}
+	}
+
+	for _, endpointServiceName := range endpointServiceNames {
+		match(endpointServiceName, endpointServiceName)
+	}
+
+	for prefix, service := range resourceTypePrefixServiceNames {
+		match(prefix, service)
+	}
+
+	return serviceName
+}
+
+// configureResourceServiceTimeouts replaces the default create, update and delete timeouts of
+// resources with any configured for the resource's service. Only timeouts that a resource
+// already supports are replaced.
+func configureResourceServiceTimeouts(resources map[string]*schema.Resource, serviceTimeouts map[string]*ServiceTimeouts) {
+	if len(serviceTimeouts) == 0 {
+		return
+	}
+
+	for resourceType, resource := range resources {
+		if resource.Timeouts == nil {
+			continue
+		}
+
+		v, ok := serviceTimeouts[resourceServiceName(resourceType)]
+
+		if !ok {
+			continue
+		}
+
+		// Resources may share a ResourceTimeout value, so modify a copy.
+		timeouts := *resource.Timeouts
+
+		if timeouts.Create != nil && v.Create != 0 {
+			timeouts.Create = schema.DefaultTimeout(v.Create)
+		}
+
+		if timeouts.Delete != nil && v.Delete != 0 {
+			timeouts.Delete = schema.DefaultTimeout(v.Delete)
+		}
+
+		if timeouts.Update != nil && v.Update != 0 {
+			timeouts.Update = schema.DefaultTimeout(v.Update)
+		}
+
+		log.Printf("[DEBUG] Setting %s default timeouts from service_timeouts", resourceType)
+		resource.Timeouts = &timeouts
+	}
+}
+
+type resourceCRUDFunc = func(*schema.ResourceData, interface{}) error
+
+type resourceCRUDContextFunc = func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics
+
+// resourceFuncWrappers wrap the functions of a resource. A nil wrapper leaves the functions unchanged.
+// The operation passed to CRUD and CRUDContext is one of "create", "read", "update" or "delete".
+type resourceFuncWrappers struct {
+	CRUD          func(operation string, f resourceCRUDFunc) resourceCRUDFunc
+	CRUDContext   func(operation string, f resourceCRUDContextFunc) resourceCRUDContextFunc
+	CustomizeDiff func(f schema.CustomizeDiffFunc) schema.CustomizeDiffFunc
+}
+
+// wrapResourceFuncs replaces each of a resource's CRUD and CustomizeDiff functions with its wrapped function.
+func wrapResourceFuncs(resource *schema.Resource, wrappers resourceFuncWrappers) {
+	if wrap := wrappers.CRUD; wrap != nil {
+		if resource.Create != nil {
+			resource.Create = wrap("create", resource.Create)
+		}
+
+		if resource.Read != nil {
+			resource.Read = wrap("read", resource.Read)
+		}
+
+		if resource.Update != nil {
+			resource.Update = wrap("update", resource.Update)
+		}
+
+		if resource.Delete != nil {
+			resource.Delete = wrap("delete", resource.Delete)
+		}
+	}
+
+	if wrap := wrappers.CRUDContext; wrap != nil {
+		if resource.CreateContext != nil {
+			resource.CreateContext = wrap("create", resource.CreateContext)
+		}
+
+		if resource.ReadContext != nil {
+			resource.ReadContext = wrap("read", resource.ReadContext)
+		}
+
+		if resource.UpdateContext != nil {
+			resource.UpdateContext = wrap("update", resource.UpdateContext)
+		}
+
+		if resource.DeleteContext != nil {
+			resource.DeleteContext = wrap("delete", resource.DeleteContext)
+		}
+
+		if resource.CreateWithoutTimeout != nil {
+			resource.CreateWithoutTimeout = wrap("create", resource.CreateWithoutTimeout)
+		}
+
+		if resource.ReadWithoutTimeout != nil {
+			resource.ReadWithoutTimeout = wrap("read", resource.ReadWithoutTimeout)
+		}
+
+		if resource.UpdateWithoutTimeout != nil {
+			resource.UpdateWithoutTimeout = wrap("update", resource.UpdateWithoutTimeout)
+		}
+
+		if resource.DeleteWithoutTimeout != nil {
+			resource.DeleteWithoutTimeout = wrap("delete", resource.DeleteWithoutTimeout)
+		}
+	}
+
+	if wrap := wrappers.CustomizeDiff; wrap != nil && resource.CustomizeDiff != nil {
+		resource.CustomizeDiff = wrap(resource.CustomizeDiff)
+	}
+}
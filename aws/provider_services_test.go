@@ -0,0 +1,85 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceServiceName(t *testing.T) {
+	testCases := []struct {
+		ResourceType string
+		Expected     string
+	}{
+		{ResourceType: "aws_cloudformation_stack_set", Expected: "cloudformation"},
+		{ResourceType: "aws_cloudwatch_log_group", Expected: "cloudwatchlogs"},
+		{ResourceType: "aws_cloudwatch_metric_alarm", Expected: "cloudwatch"},
+		{ResourceType: "aws_cognito_identity_pool", Expected: "cognitoidentity"},
+		{ResourceType: "aws_cognito_user_pool", Expected: "cognitoidp"},
+		{ResourceType: "aws_config_organization_managed_rule", Expected: "configservice"},
+		{ResourceType: "aws_db_instance", Expected: "rds"},
+		{ResourceType: "aws_instance", Expected: "ec2"},
+		{ResourceType: "aws_lb_cookie_stickiness_policy", Expected: "elb"},
+		{ResourceType: "aws_lb_listener", Expected: "elb"},
+		{ResourceType: "aws_organizations_account", Expected: "organizations"},
+		{ResourceType: "aws_route53_resolver_rule", Expected: "route53resolver"},
+		{ResourceType: "aws_route53_zone", Expected: "route53"},
+		{ResourceType: "aws_route_table", Expected: "ec2"},
+		{ResourceType: "aws_s3_bucket", Expected: "s3"},
+		{ResourceType: "aws_s3control_bucket", Expected: "s3control"},
+		{ResourceType: "aws_unknown_thing", Expected: ""},
+	}
+
+	for _, testCase := range testCases {
+		if got := resourceServiceName(testCase.ResourceType); got != testCase.Expected {
+			t.Errorf("resourceServiceName(%q) = %q, expected %q", testCase.ResourceType, got, testCase.Expected)
+		}
+	}
+}
+
+func TestConfigureResourceServiceTimeouts(t *testing.T) {
+	sharedTimeouts := &schema.ResourceTimeout{
+		Create: schema.DefaultTimeout(10 * time.Minute),
+		Delete: schema.DefaultTimeout(10 * time.Minute),
+	}
+
+	resources := map[string]*schema.Resource{
+		"aws_cloudformation_stack_set": {
+			Timeouts: sharedTimeouts,
+		},
+		"aws_instance": {
+			Timeouts: sharedTimeouts,
+		},
+		"aws_cloudformation_type": {},
+	}
+
+	configureResourceServiceTimeouts(resources, map[string]*ServiceTimeouts{
+		"cloudformation": {
+			Create: 60 * time.Minute,
+			Update: 60 * time.Minute,
+		},
+	})
+
+	stackSetTimeouts := resources["aws_cloudformation_stack_set"].Timeouts
+
+	if got, want := *stackSetTimeouts.Create, 60*time.Minute; got != want {
+		t.Errorf("got create timeout %s, expected %s", got, want)
+	}
+
+	if got, want := *stackSetTimeouts.Delete, 10*time.Minute; got != want {
+		t.Errorf("got delete timeout %s, expected %s", got, want)
+	}
+
+	if stackSetTimeouts.Update != nil {
+		t.Errorf("expected no update timeout, got %s", *stackSetTimeouts.Update)
+	}
+
+	if got, want := *resources["aws_instance"].Timeouts.Create, 10*time.Minute; got != want {
+		t.Errorf("got shared create timeout %s, expected %s", got, want)
+	}
+
+	if resources["aws_cloudformation_type"].Timeouts != nil {
+		t.Error("expected no timeouts for resource without timeouts")
+	}
+}
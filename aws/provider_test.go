@@ -24,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/acctest/fake"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/envvar"
 	organizationsfinder "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/organizations/finder"
 	stsfinder "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/sts/finder"
@@ -224,6 +225,23 @@ func TestReverseDns(t *testing.T) {
 	}
 }
 
+// testFakeAWSClient returns an AWSClient whose service clients send requests to the fake AWS API server
+//
+// This allows resource CRUD functions to be unit tested without AWS credentials, e.g.
+//
+//	diags := resourceAwsCloudControlApiResourceCreate(ctx, d, testFakeAWSClient(server))
+func testFakeAWSClient(server *fake.Server) *AWSClient {
+	return &AWSClient{
+		accountid:        "123456789012",
+		dnsSuffix:        "amazonaws.com",
+		partition:        endpoints.AwsPartitionID,
+		region:           fake.DefaultRegion,
+		reverseDnsPrefix: "com.amazonaws",
+		s3ForcePathStyle: true,
+		session:          server.Session(),
+	}
+}
+
 // testAccPreCheck verifies and sets required provider testing configuration
 //
 // This PreCheck function should be present in every acceptance test. It allows
@@ -233,6 +251,10 @@ func TestReverseDns(t *testing.T) {
 // These verifications and configuration are preferred at this level to prevent
 // provider developers from experiencing less clear errors for every test.
 func testAccPreCheck(t *testing.T) {
+	// Record or replay the test's AWS API interactions before any are made,
+	// if TF_ACC_CASSETTE_MODE is set.
+	testAccCassette(t)
+
 	// Since we are outside the scope of the Terraform configuration we must
 	// call Configure() to properly initialize the provider configuration.
 	testAccProviderConfigure.Do(func() {
@@ -784,7 +806,7 @@ func testAccPartitionPreCheck(partition string, t *testing.T) {
 }
 
 func testAccOrganizationsAccountPreCheck(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).organizationsconn
+	conn := testAccProvider.Meta().(*AWSClient).organizationsconn()
 	input := &organizations.DescribeOrganizationInput{}
 	_, err := conn.DescribeOrganization(input)
 	if isAWSErr(err, organizations.ErrCodeAWSOrganizationsNotInUseException, "") {
@@ -797,7 +819,7 @@ func testAccOrganizationsAccountPreCheck(t *testing.T) {
 }
 
 func testAccOrganizationsEnabledPreCheck(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).organizationsconn
+	conn := testAccProvider.Meta().(*AWSClient).organizationsconn()
 	input := &organizations.DescribeOrganizationInput{}
 	_, err := conn.DescribeOrganization(input)
 	if isAWSErr(err, organizations.ErrCodeAWSOrganizationsNotInUseException, "") {
@@ -809,13 +831,13 @@ func testAccOrganizationsEnabledPreCheck(t *testing.T) {
 }
 
 func testAccOrganizationManagementAccountPreCheck(t *testing.T) {
-	organization, err := organizationsfinder.Organization(testAccProvider.Meta().(*AWSClient).organizationsconn)
+	organization, err := organizationsfinder.Organization(testAccProvider.Meta().(*AWSClient).organizationsconn())
 
 	if err != nil {
 		t.Fatalf("error describing AWS Organization: %s", err)
 	}
 
-	callerIdentity, err := stsfinder.CallerIdentity(testAccProvider.Meta().(*AWSClient).stsconn)
+	callerIdentity, err := stsfinder.CallerIdentity(testAccProvider.Meta().(*AWSClient).stsconn())
 
 	if err != nil {
 		t.Fatalf("error getting current identity: %s", err)
@@ -827,7 +849,7 @@ func testAccOrganizationManagementAccountPreCheck(t *testing.T) {
 }
 
 func testAccPreCheckHasIAMRole(t *testing.T, roleName string) {
-	conn := testAccProvider.Meta().(*AWSClient).iamconn
+	conn := testAccProvider.Meta().(*AWSClient).iamconn()
 
 	input := &iam.GetRoleInput{
 		RoleName: aws.String(roleName),
@@ -846,7 +868,7 @@ func testAccPreCheckHasIAMRole(t *testing.T, roleName string) {
 }
 
 func testAccPreCheckIamServiceLinkedRole(t *testing.T, pathPrefix string) {
-	conn := testAccProvider.Meta().(*AWSClient).iamconn
+	conn := testAccProvider.Meta().(*AWSClient).iamconn()
 
 	input := &iam.ListRolesInput{
 		PathPrefix: aws.String(pathPrefix),
@@ -1965,7 +1987,7 @@ func testAccPreCheckEc2ClassicOrHasDefaultVpcWithDefaultSubnets(t *testing.T) {
 
 // testAccHasDefaultVpc returns whether the current AWS region has a default VPC.
 func testAccHasDefaultVpc(t *testing.T) bool {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	resp, err := conn.DescribeAccountAttributes(&ec2.DescribeAccountAttributesInput{
 		AttributeNames: aws.StringSlice([]string{ec2.AccountAttributeNameDefaultVpc}),
@@ -1985,7 +2007,7 @@ func testAccHasDefaultVpc(t *testing.T) bool {
 
 // testAccDefaultSubnetCount returns the number of default subnets in the current region's default VPC.
 func testAccDefaultSubnetCount(t *testing.T) int {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	input := &ec2.DescribeSubnetsInput{
 		Filters: buildEC2AttributeFilterList(map[string]string{
@@ -66,7 +66,7 @@ func resourceAwsAccessAnalyzerAnalyzer() *schema.Resource {
 }
 
 func resourceAwsAccessAnalyzerAnalyzerCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).accessanalyzerconn
+	conn := meta.(*AWSClient).accessanalyzerconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 	analyzerName := d.Get("analyzer_name").(string)
@@ -107,7 +107,7 @@ func resourceAwsAccessAnalyzerAnalyzerCreate(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsAccessAnalyzerAnalyzerRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).accessanalyzerconn
+	conn := meta.(*AWSClient).accessanalyzerconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
@@ -151,7 +151,7 @@ func resourceAwsAccessAnalyzerAnalyzerRead(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsAccessAnalyzerAnalyzerUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).accessanalyzerconn
+	conn := meta.(*AWSClient).accessanalyzerconn()
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
@@ -164,7 +164,7 @@ func resourceAwsAccessAnalyzerAnalyzerUpdate(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsAccessAnalyzerAnalyzerDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).accessanalyzerconn
+	conn := meta.(*AWSClient).accessanalyzerconn()
 
 	input := &accessanalyzer.DeleteAnalyzerInput{
 		AnalyzerName: aws.String(d.Id()),
@@ -46,7 +46,7 @@ func resourceAwsAcmpcaCertificateAuthorityCertificate() *schema.Resource {
 }
 
 func resourceAwsAcmpcaCertificateAuthorityCertificateCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).acmpcaconn
+	conn := meta.(*AWSClient).acmpcaconn()
 
 	certificateAuthorityArn := d.Get("certificate_authority_arn").(string)
 
@@ -69,7 +69,7 @@ func resourceAwsAcmpcaCertificateAuthorityCertificateCreate(d *schema.ResourceDa
 }
 
 func resourceAwsAcmpcaCertificateAuthorityCertificateRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).acmpcaconn
+	conn := meta.(*AWSClient).acmpcaconn()
 
 	output, err := finder.CertificateAuthorityCertificateByARN(conn, d.Id())
 	if !d.IsNewResource() && tfresource.NotFound(err) {
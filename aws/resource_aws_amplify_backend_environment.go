@@ -63,7 +63,7 @@ func resourceAwsAmplifyBackendEnvironment() *schema.Resource {
 }
 
 func resourceAwsAmplifyBackendEnvironmentCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).amplifyconn
+	conn := meta.(*AWSClient).amplifyconn()
 
 	appID := d.Get("app_id").(string)
 	environmentName := d.Get("environment_name").(string)
@@ -95,7 +95,7 @@ func resourceAwsAmplifyBackendEnvironmentCreate(d *schema.ResourceData, meta int
 }
 
 func resourceAwsAmplifyBackendEnvironmentRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).amplifyconn
+	conn := meta.(*AWSClient).amplifyconn()
 
 	appID, environmentName, err := tfamplify.BackendEnvironmentParseResourceID(d.Id())
 
@@ -125,7 +125,7 @@ func resourceAwsAmplifyBackendEnvironmentRead(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsAmplifyBackendEnvironmentDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).amplifyconn
+	conn := meta.(*AWSClient).amplifyconn()
 
 	appID, environmentName, err := tfamplify.BackendEnvironmentParseResourceID(d.Id())
 
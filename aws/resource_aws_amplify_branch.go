@@ -182,7 +182,7 @@ func resourceAwsAmplifyBranch() *schema.Resource {
 }
 
 func resourceAwsAmplifyBranchCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).amplifyconn
+	conn := meta.(*AWSClient).amplifyconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 
@@ -265,7 +265,7 @@ func resourceAwsAmplifyBranchCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsAmplifyBranchRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).amplifyconn
+	conn := meta.(*AWSClient).amplifyconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
@@ -323,7 +323,7 @@ func resourceAwsAmplifyBranchRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsAmplifyBranchUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).amplifyconn
+	conn := meta.(*AWSClient).amplifyconn()
 
 	if d.HasChangesExcept("tags", "tags_all") {
 		appID, branchName, err := tfamplify.BranchParseResourceID(d.Id())
@@ -415,7 +415,7 @@ func resourceAwsAmplifyBranchUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsAmplifyBranchDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).amplifyconn
+	conn := meta.(*AWSClient).amplifyconn()
 
 	appID, branchName, err := tfamplify.BranchParseResourceID(d.Id())
 
@@ -296,7 +296,7 @@ func testAccCheckAWSAmplifyBranchExists(resourceName string, v *amplify.Branch)
 			return err
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).amplifyconn
+		conn := testAccProvider.Meta().(*AWSClient).amplifyconn()
 
 		branch, err := finder.BranchByAppIDAndBranchName(conn, appID, branchName)
 
@@ -311,7 +311,7 @@ func testAccCheckAWSAmplifyBranchExists(resourceName string, v *amplify.Branch)
 }
 
 func testAccCheckAWSAmplifyBranchDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).amplifyconn
+	conn := testAccProvider.Meta().(*AWSClient).amplifyconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_amplify_branch" {
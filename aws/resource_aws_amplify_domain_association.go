@@ -86,7 +86,7 @@ func resourceAwsAmplifyDomainAssociation() *schema.Resource {
 }
 
 func resourceAwsAmplifyDomainAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).amplifyconn
+	conn := meta.(*AWSClient).amplifyconn()
 
 	appID := d.Get("app_id").(string)
 	domainName := d.Get("domain_name").(string)
@@ -121,7 +121,7 @@ func resourceAwsAmplifyDomainAssociationCreate(d *schema.ResourceData, meta inte
 }
 
 func resourceAwsAmplifyDomainAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).amplifyconn
+	conn := meta.(*AWSClient).amplifyconn()
 
 	appID, domainName, err := tfamplify.DomainAssociationParseResourceID(d.Id())
 
@@ -153,7 +153,7 @@ func resourceAwsAmplifyDomainAssociationRead(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsAmplifyDomainAssociationUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).amplifyconn
+	conn := meta.(*AWSClient).amplifyconn()
 
 	appID, domainName, err := tfamplify.DomainAssociationParseResourceID(d.Id())
 
@@ -186,7 +186,7 @@ func resourceAwsAmplifyDomainAssociationUpdate(d *schema.ResourceData, meta inte
 }
 
 func resourceAwsAmplifyDomainAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).amplifyconn
+	conn := meta.(*AWSClient).amplifyconn()
 
 	appID, domainName, err := tfamplify.DomainAssociationParseResourceID(d.Id())
 
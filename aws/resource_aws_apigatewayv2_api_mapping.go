@@ -44,7 +44,7 @@ func resourceAwsApiGatewayV2ApiMapping() *schema.Resource {
 }
 
 func resourceAwsApiGatewayV2ApiMappingCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).apigatewayv2conn
+	conn := meta.(*AWSClient).apigatewayv2conn()
 
 	req := &apigatewayv2.CreateApiMappingInput{
 		ApiId:      aws.String(d.Get("api_id").(string)),
@@ -67,7 +67,7 @@ func resourceAwsApiGatewayV2ApiMappingCreate(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsApiGatewayV2ApiMappingRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).apigatewayv2conn
+	conn := meta.(*AWSClient).apigatewayv2conn()
 
 	resp, err := conn.GetApiMapping(&apigatewayv2.GetApiMappingInput{
 		ApiMappingId: aws.String(d.Id()),
@@ -90,7 +90,7 @@ func resourceAwsApiGatewayV2ApiMappingRead(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsApiGatewayV2ApiMappingUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).apigatewayv2conn
+	conn := meta.(*AWSClient).apigatewayv2conn()
 
 	req := &apigatewayv2.UpdateApiMappingInput{
 		ApiId:        aws.String(d.Get("api_id").(string)),
@@ -114,7 +114,7 @@ func resourceAwsApiGatewayV2ApiMappingUpdate(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsApiGatewayV2ApiMappingDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).apigatewayv2conn
+	conn := meta.(*AWSClient).apigatewayv2conn()
 
 	log.Printf("[DEBUG] Deleting API Gateway v2 API mapping (%s)", d.Id())
 	_, err := conn.DeleteApiMapping(&apigatewayv2.DeleteApiMappingInput{
@@ -127,7 +127,7 @@ func TestAccAWSAPIGatewayV2Deployment_Triggers(t *testing.T) {
 }
 
 func testAccCheckAWSAPIGatewayV2DeploymentDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn
+	conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_apigatewayv2_deployment" {
@@ -153,7 +153,7 @@ func testAccCheckAWSAPIGatewayV2DeploymentDestroy(s *terraform.State) error {
 
 func testAccCheckAWSAPIGatewayV2DeploymentDisappears(apiId *string, v *apigatewayv2.GetDeploymentOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn
+		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn()
 
 		_, err := conn.DeleteDeployment(&apigatewayv2.DeleteDeploymentInput{
 			ApiId:        apiId,
@@ -175,7 +175,7 @@ func testAccCheckAWSAPIGatewayV2DeploymentExists(n string, vApiId *string, v *ap
 			return fmt.Errorf("No API Gateway v2 deployment ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn
+		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn()
 
 		apiId := aws.String(rs.Primary.Attributes["api_id"])
 		resp, err := conn.GetDeployment(&apigatewayv2.GetDeploymentInput{
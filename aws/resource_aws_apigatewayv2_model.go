@@ -65,7 +65,7 @@ func resourceAwsApiGatewayV2Model() *schema.Resource {
 }
 
 func resourceAwsApiGatewayV2ModelCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).apigatewayv2conn
+	conn := meta.(*AWSClient).apigatewayv2conn()
 
 	req := &apigatewayv2.CreateModelInput{
 		ApiId:       aws.String(d.Get("api_id").(string)),
@@ -89,7 +89,7 @@ func resourceAwsApiGatewayV2ModelCreate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsApiGatewayV2ModelRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).apigatewayv2conn
+	conn := meta.(*AWSClient).apigatewayv2conn()
 
 	resp, err := conn.GetModel(&apigatewayv2.GetModelInput{
 		ApiId:   aws.String(d.Get("api_id").(string)),
@@ -113,7 +113,7 @@ func resourceAwsApiGatewayV2ModelRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsApiGatewayV2ModelUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).apigatewayv2conn
+	conn := meta.(*AWSClient).apigatewayv2conn()
 
 	req := &apigatewayv2.UpdateModelInput{
 		ApiId:   aws.String(d.Get("api_id").(string)),
@@ -142,7 +142,7 @@ func resourceAwsApiGatewayV2ModelUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsApiGatewayV2ModelDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).apigatewayv2conn
+	conn := meta.(*AWSClient).apigatewayv2conn()
 
 	log.Printf("[DEBUG] Deleting API Gateway v2 model (%s)", d.Id())
 	_, err := conn.DeleteModel(&apigatewayv2.DeleteModelInput{
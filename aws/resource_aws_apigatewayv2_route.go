@@ -97,7 +97,7 @@ func resourceAwsApiGatewayV2Route() *schema.Resource {
 }
 
 func resourceAwsApiGatewayV2RouteCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).apigatewayv2conn
+	conn := meta.(*AWSClient).apigatewayv2conn()
 
 	req := &apigatewayv2.CreateRouteInput{
 		ApiId:             aws.String(d.Get("api_id").(string)),
@@ -142,7 +142,7 @@ func resourceAwsApiGatewayV2RouteCreate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsApiGatewayV2RouteRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).apigatewayv2conn
+	conn := meta.(*AWSClient).apigatewayv2conn()
 
 	resp, err := conn.GetRoute(&apigatewayv2.GetRouteInput{
 		ApiId:   aws.String(d.Get("api_id").(string)),
@@ -181,7 +181,7 @@ func resourceAwsApiGatewayV2RouteRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsApiGatewayV2RouteUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).apigatewayv2conn
+	conn := meta.(*AWSClient).apigatewayv2conn()
 
 	var requestParameters map[string]*apigatewayv2.ParameterConstraints
 
@@ -269,7 +269,7 @@ func resourceAwsApiGatewayV2RouteUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsApiGatewayV2RouteDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).apigatewayv2conn
+	conn := meta.(*AWSClient).apigatewayv2conn()
 
 	log.Printf("[DEBUG] Deleting API Gateway v2 route (%s)", d.Id())
 	_, err := conn.DeleteRoute(&apigatewayv2.DeleteRouteInput{
@@ -297,7 +297,7 @@ func resourceAwsApiGatewayV2RouteImport(d *schema.ResourceData, meta interface{}
 	apiId := parts[0]
 	routeId := parts[1]
 
-	conn := meta.(*AWSClient).apigatewayv2conn
+	conn := meta.(*AWSClient).apigatewayv2conn()
 
 	resp, err := conn.GetRoute(&apigatewayv2.GetRouteInput{
 		ApiId:   aws.String(apiId),
@@ -46,7 +46,7 @@ func resourceAwsAppconfigApplication() *schema.Resource {
 }
 
 func resourceAwsAppconfigApplicationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appconfigconn
+	conn := meta.(*AWSClient).appconfigconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 
@@ -77,7 +77,7 @@ func resourceAwsAppconfigApplicationCreate(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsAppconfigApplicationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appconfigconn
+	conn := meta.(*AWSClient).appconfigconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
@@ -134,7 +134,7 @@ func resourceAwsAppconfigApplicationRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsAppconfigApplicationUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appconfigconn
+	conn := meta.(*AWSClient).appconfigconn()
 
 	if d.HasChangesExcept("tags", "tags_all") {
 
@@ -168,7 +168,7 @@ func resourceAwsAppconfigApplicationUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsAppconfigApplicationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appconfigconn
+	conn := meta.(*AWSClient).appconfigconn()
 
 	input := &appconfig.DeleteApplicationInput{
 		ApplicationId: aws.String(d.Id()),
@@ -33,7 +33,7 @@ func testSweepAppConfigApplications(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*AWSClient).appconfigconn
+	conn := client.(*AWSClient).appconfigconn()
 	sweepResources := make([]*testSweepResource, 0)
 	var errs *multierror.Error
 
@@ -252,7 +252,7 @@ func TestAccAWSAppConfigApplication_Tags(t *testing.T) {
 }
 
 func testAccCheckAppConfigApplicationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).appconfigconn
+	conn := testAccProvider.Meta().(*AWSClient).appconfigconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_appconfig_application" {
@@ -292,7 +292,7 @@ func testAccCheckAWSAppConfigApplicationExists(resourceName string) resource.Tes
 			return fmt.Errorf("Resource (%s) ID not set", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).appconfigconn
+		conn := testAccProvider.Meta().(*AWSClient).appconfigconn()
 
 		input := &appconfig.GetApplicationInput{
 			ApplicationId: aws.String(rs.Primary.ID),
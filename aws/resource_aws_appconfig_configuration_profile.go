@@ -93,7 +93,7 @@ func resourceAwsAppconfigConfigurationProfile() *schema.Resource {
 }
 
 func resourceAwsAppconfigConfigurationProfileCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appconfigconn
+	conn := meta.(*AWSClient).appconfigconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 
@@ -135,7 +135,7 @@ func resourceAwsAppconfigConfigurationProfileCreate(d *schema.ResourceData, meta
 }
 
 func resourceAwsAppconfigConfigurationProfileRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appconfigconn
+	conn := meta.(*AWSClient).appconfigconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
@@ -209,7 +209,7 @@ func resourceAwsAppconfigConfigurationProfileRead(d *schema.ResourceData, meta i
 }
 
 func resourceAwsAppconfigConfigurationProfileUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appconfigconn
+	conn := meta.(*AWSClient).appconfigconn()
 
 	if d.HasChangesExcept("tags", "tags_all") {
 		confProfID, appID, err := resourceAwsAppconfigConfigurationProfileParseID(d.Id())
@@ -257,7 +257,7 @@ func resourceAwsAppconfigConfigurationProfileUpdate(d *schema.ResourceData, meta
 }
 
 func resourceAwsAppconfigConfigurationProfileDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appconfigconn
+	conn := meta.(*AWSClient).appconfigconn()
 
 	confProfID, appID, err := resourceAwsAppconfigConfigurationProfileParseID(d.Id())
 
@@ -32,7 +32,7 @@ func testSweepAppConfigConfigurationProfiles(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*AWSClient).appconfigconn
+	conn := client.(*AWSClient).appconfigconn()
 	sweepResources := make([]*testSweepResource, 0)
 	var errs *multierror.Error
 
@@ -399,7 +399,7 @@ func TestAccAWSAppConfigConfigurationProfile_Tags(t *testing.T) {
 }
 
 func testAccCheckAppConfigConfigurationProfileDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).appconfigconn
+	conn := testAccProvider.Meta().(*AWSClient).appconfigconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_appconfig_configuration_profile" {
@@ -452,7 +452,7 @@ func testAccCheckAWSAppConfigConfigurationProfileExists(resourceName string) res
 			return err
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).appconfigconn
+		conn := testAccProvider.Meta().(*AWSClient).appconfigconn()
 
 		output, err := conn.GetConfigurationProfile(&appconfig.GetConfigurationProfileInput{
 			ApplicationId:          aws.String(appID),
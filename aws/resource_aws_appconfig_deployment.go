@@ -83,7 +83,7 @@ func resourceAwsAppconfigDeployment() *schema.Resource {
 }
 
 func resourceAwsAppconfigDeploymentCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appconfigconn
+	conn := meta.(*AWSClient).appconfigconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 
@@ -117,7 +117,7 @@ func resourceAwsAppconfigDeploymentCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsAppconfigDeploymentRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appconfigconn
+	conn := meta.(*AWSClient).appconfigconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
@@ -188,7 +188,7 @@ func resourceAwsAppconfigDeploymentRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsAppconfigDeploymentUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appconfigconn
+	conn := meta.(*AWSClient).appconfigconn()
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
@@ -29,7 +29,7 @@ func testSweepAppConfigDeploymentStrategies(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*AWSClient).appconfigconn
+	conn := client.(*AWSClient).appconfigconn()
 	sweepResources := make([]*testSweepResource, 0)
 	var errs *multierror.Error
 
@@ -257,7 +257,7 @@ func TestAccAWSAppConfigDeploymentStrategy_Tags(t *testing.T) {
 }
 
 func testAccCheckAppConfigDeploymentStrategyDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).appconfigconn
+	conn := testAccProvider.Meta().(*AWSClient).appconfigconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_appconfig_deployment_strategy" {
@@ -297,7 +297,7 @@ func testAccCheckAWSAppConfigDeploymentStrategyExists(resourceName string) resou
 			return fmt.Errorf("Resource (%s) ID not set", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).appconfigconn
+		conn := testAccProvider.Meta().(*AWSClient).appconfigconn()
 
 		input := &appconfig.GetDeploymentStrategyInput{
 			DeploymentStrategyId: aws.String(rs.Primary.ID),
@@ -144,7 +144,7 @@ func testAccCheckAWSAppConfigDeploymentExists(resourceName string) resource.Test
 			return err
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).appconfigconn
+		conn := testAccProvider.Meta().(*AWSClient).appconfigconn()
 
 		input := &appconfig.GetDeploymentInput{
 			ApplicationId:    aws.String(appID),
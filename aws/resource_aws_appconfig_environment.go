@@ -84,7 +84,7 @@ func resourceAwsAppconfigEnvironment() *schema.Resource {
 }
 
 func resourceAwsAppconfigEnvironmentCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appconfigconn
+	conn := meta.(*AWSClient).appconfigconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 
@@ -121,7 +121,7 @@ func resourceAwsAppconfigEnvironmentCreate(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsAppconfigEnvironmentRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appconfigconn
+	conn := meta.(*AWSClient).appconfigconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
@@ -193,7 +193,7 @@ func resourceAwsAppconfigEnvironmentRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsAppconfigEnvironmentUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appconfigconn
+	conn := meta.(*AWSClient).appconfigconn()
 
 	if d.HasChangesExcept("tags", "tags_all") {
 		envID, appID, err := resourceAwsAppconfigEnvironmentParseID(d.Id())
@@ -237,7 +237,7 @@ func resourceAwsAppconfigEnvironmentUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsAppconfigEnvironmentDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).appconfigconn
+	conn := meta.(*AWSClient).appconfigconn()
 
 	envID, appID, err := resourceAwsAppconfigEnvironmentParseID(d.Id())
 
@@ -29,7 +29,7 @@ func testSweepAppConfigEnvironments(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*AWSClient).appconfigconn
+	conn := client.(*AWSClient).appconfigconn()
 	sweepResources := make([]*testSweepResource, 0)
 	var errs *multierror.Error
 
@@ -375,7 +375,7 @@ func TestAccAWSAppConfigEnvironment_Tags(t *testing.T) {
 }
 
 func testAccCheckAppConfigEnvironmentDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).appconfigconn
+	conn := testAccProvider.Meta().(*AWSClient).appconfigconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_appconfig_environment" {
@@ -428,7 +428,7 @@ func testAccCheckAWSAppConfigEnvironmentExists(resourceName string) resource.Tes
 			return err
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).appconfigconn
+		conn := testAccProvider.Meta().(*AWSClient).appconfigconn()
 
 		input := &appconfig.GetEnvironmentInput{
 			ApplicationId: aws.String(appID),
@@ -32,7 +32,7 @@ func testSweepAppRunnerAutoScalingConfigurationVersions(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*AWSClient).apprunnerconn
+	conn := client.(*AWSClient).apprunnerconn()
 	sweepResources := make([]*testSweepResource, 0)
 	ctx := context.Background()
 	var errs *multierror.Error
@@ -371,7 +371,7 @@ func testAccCheckAwsAppRunnerAutoScalingConfigurationVersionDestroy(s *terraform
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).apprunnerconn
+		conn := testAccProvider.Meta().(*AWSClient).apprunnerconn()
 
 		input := &apprunner.DescribeAutoScalingConfigurationInput{
 			AutoScalingConfigurationArn: aws.String(rs.Primary.ID),
@@ -406,7 +406,7 @@ func testAccCheckAwsAppRunnerAutoScalingConfigurationVersionExists(n string) res
 			return fmt.Errorf("No App Runner Service ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).apprunnerconn
+		conn := testAccProvider.Meta().(*AWSClient).apprunnerconn()
 
 		input := &apprunner.DescribeAutoScalingConfigurationInput{
 			AutoScalingConfigurationArn: aws.String(rs.Primary.ID),
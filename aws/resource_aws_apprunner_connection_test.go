@@ -32,7 +32,7 @@ func testSweepAppRunnerConnections(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*AWSClient).apprunnerconn
+	conn := client.(*AWSClient).apprunnerconn()
 	sweepResources := make([]*testSweepResource, 0)
 	ctx := context.Background()
 
@@ -190,7 +190,7 @@ func testAccCheckAwsAppRunnerConnectionDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).apprunnerconn
+		conn := testAccProvider.Meta().(*AWSClient).apprunnerconn()
 
 		connection, err := finder.ConnectionSummaryByName(context.Background(), conn, rs.Primary.ID)
 
@@ -221,7 +221,7 @@ func testAccCheckAwsAppRunnerConnectionExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("No App Runner Connection ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).apprunnerconn
+		conn := testAccProvider.Meta().(*AWSClient).apprunnerconn()
 
 		connection, err := finder.ConnectionSummaryByName(context.Background(), conn, rs.Primary.ID)
 
@@ -82,7 +82,7 @@ func resourceAwsAppRunnerCustomDomainAssociation() *schema.Resource {
 }
 
 func resourceAwsAppRunnerCustomDomainAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).apprunnerconn
+	conn := meta.(*AWSClient).apprunnerconn()
 
 	domainName := d.Get("domain_name").(string)
 	serviceArn := d.Get("service_arn").(string)
@@ -114,7 +114,7 @@ func resourceAwsAppRunnerCustomDomainAssociationCreate(ctx context.Context, d *s
 }
 
 func resourceAwsAppRunnerCustomDomainAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).apprunnerconn
+	conn := meta.(*AWSClient).apprunnerconn()
 
 	domainName, serviceArn, err := tfapprunner.CustomDomainAssociationParseID(d.Id())
 
@@ -152,7 +152,7 @@ func resourceAwsAppRunnerCustomDomainAssociationRead(ctx context.Context, d *sch
 }
 
 func resourceAwsAppRunnerCustomDomainAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).apprunnerconn
+	conn := meta.(*AWSClient).apprunnerconn()
 
 	domainName, serviceArn, err := tfapprunner.CustomDomainAssociationParseID(d.Id())
 
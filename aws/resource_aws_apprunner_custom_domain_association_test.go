@@ -87,7 +87,7 @@ func testAccCheckAwsAppRunnerCustomDomainAssociationDestroy(s *terraform.State)
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).apprunnerconn
+		conn := testAccProvider.Meta().(*AWSClient).apprunnerconn()
 
 		domainName, serviceArn, err := tfapprunner.CustomDomainAssociationParseID(rs.Primary.ID)
 
@@ -130,7 +130,7 @@ func testAccCheckAwsAppRunnerCustomDomainAssociationExists(n string) resource.Te
 			return err
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).apprunnerconn
+		conn := testAccProvider.Meta().(*AWSClient).apprunnerconn()
 
 		customDomain, err := finder.CustomDomain(context.Background(), conn, domainName, serviceArn)
 
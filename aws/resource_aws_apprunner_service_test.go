@@ -30,7 +30,7 @@ func testSweepAppRunnerServices(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*AWSClient).apprunnerconn
+	conn := client.(*AWSClient).apprunnerconn()
 	sweepResources := make([]*testSweepResource, 0)
 	ctx := context.Background()
 	var errs *multierror.Error
@@ -403,7 +403,7 @@ func testAccCheckAwsAppRunnerServiceDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).apprunnerconn
+		conn := testAccProvider.Meta().(*AWSClient).apprunnerconn()
 
 		input := &apprunner.DescribeServiceInput{
 			ServiceArn: aws.String(rs.Primary.ID),
@@ -438,7 +438,7 @@ func testAccCheckAwsAppRunnerServiceExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("No App Runner Service ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).apprunnerconn
+		conn := testAccProvider.Meta().(*AWSClient).apprunnerconn()
 
 		input := &apprunner.DescribeServiceInput{
 			ServiceArn: aws.String(rs.Primary.ID),
@@ -459,7 +459,7 @@ func testAccCheckAwsAppRunnerServiceExists(n string) resource.TestCheckFunc {
 }
 
 func testAccPreCheckAppRunner(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).apprunnerconn
+	conn := testAccProvider.Meta().(*AWSClient).apprunnerconn()
 	ctx := context.Background()
 
 	input := &apprunner.ListServicesInput{}
@@ -184,7 +184,7 @@ func resourceAwsAppStreamFleet() *schema.Resource {
 }
 
 func resourceAwsAppStreamFleetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).appstreamconn
+	conn := meta.(*AWSClient).appstreamconn()
 	input := &appstream.CreateFleetInput{
 		Name:            aws.String(d.Get("name").(string)),
 		InstanceType:    aws.String(d.Get("instance_type").(string)),
@@ -282,7 +282,7 @@ func resourceAwsAppStreamFleetCreate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceAwsAppStreamFleetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).appstreamconn
+	conn := meta.(*AWSClient).appstreamconn()
 
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
@@ -367,7 +367,7 @@ func resourceAwsAppStreamFleetRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceAwsAppStreamFleetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).appstreamconn
+	conn := meta.(*AWSClient).appstreamconn()
 	input := &appstream.UpdateFleetInput{
 		Name: aws.String(d.Id()),
 	}
@@ -478,7 +478,7 @@ func resourceAwsAppStreamFleetUpdate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceAwsAppStreamFleetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).appstreamconn
+	conn := meta.(*AWSClient).appstreamconn()
 
 	// Stop fleet workflow
 	_, err := conn.StopFleetWithContext(ctx, &appstream.StopFleetInput{
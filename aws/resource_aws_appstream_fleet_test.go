@@ -35,7 +35,7 @@ func testSweepAppStreamFleet(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*AWSClient).appstreamconn
+	conn := client.(*AWSClient).appstreamconn()
 	sweepResources := make([]*testSweepResource, 0)
 	var errs *multierror.Error
 
@@ -315,7 +315,7 @@ func testAccCheckAwsAppStreamFleetExists(resourceName string, appStreamFleet *ap
 			return fmt.Errorf("not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).appstreamconn
+		conn := testAccProvider.Meta().(*AWSClient).appstreamconn()
 		resp, err := conn.DescribeFleets(&appstream.DescribeFleetsInput{Names: []*string{aws.String(rs.Primary.ID)}})
 
 		if err != nil {
@@ -333,7 +333,7 @@ func testAccCheckAwsAppStreamFleetExists(resourceName string, appStreamFleet *ap
 }
 
 func testAccCheckAwsAppStreamFleetDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).appstreamconn
+	conn := testAccProvider.Meta().(*AWSClient).appstreamconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_appstream_fleet" {
@@ -168,7 +168,7 @@ func resourceAwsAppStreamImageBuilder() *schema.Resource {
 }
 
 func resourceAwsAppStreamImageBuilderCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).appstreamconn
+	conn := meta.(*AWSClient).appstreamconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 
@@ -235,7 +235,7 @@ func resourceAwsAppStreamImageBuilderCreate(ctx context.Context, d *schema.Resou
 }
 
 func resourceAwsAppStreamImageBuilderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).appstreamconn
+	conn := meta.(*AWSClient).appstreamconn()
 
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
@@ -303,7 +303,7 @@ func resourceAwsAppStreamImageBuilderRead(ctx context.Context, d *schema.Resourc
 
 func resourceAwsAppStreamImageBuilderUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	if d.HasChange("tags_all") {
-		conn := meta.(*AWSClient).appstreamconn
+		conn := meta.(*AWSClient).appstreamconn()
 
 		o, n := d.GetChange("tags_all")
 
@@ -316,7 +316,7 @@ func resourceAwsAppStreamImageBuilderUpdate(ctx context.Context, d *schema.Resou
 }
 
 func resourceAwsAppStreamImageBuilderDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).appstreamconn
+	conn := meta.(*AWSClient).appstreamconn()
 
 	_, err := conn.DeleteImageBuilderWithContext(ctx, &appstream.DeleteImageBuilderInput{
 		Name: aws.String(d.Id()),
@@ -34,7 +34,7 @@ func testSweepAppStreamImageBuilder(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*AWSClient).appstreamconn
+	conn := client.(*AWSClient).appstreamconn()
 	sweepResources := make([]*testSweepResource, 0)
 	var errs *multierror.Error
 
@@ -236,7 +236,7 @@ func testAccCheckAwsAppStreamImageBuilderExists(resourceName string) resource.Te
 			return fmt.Errorf("not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).appstreamconn
+		conn := testAccProvider.Meta().(*AWSClient).appstreamconn()
 
 		imageBuilder, err := finder.ImageBuilderByName(context.Background(), conn, rs.Primary.ID)
 
@@ -253,7 +253,7 @@ func testAccCheckAwsAppStreamImageBuilderExists(resourceName string) resource.Te
 }
 
 func testAccCheckAwsAppStreamImageBuilderDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).appstreamconn
+	conn := testAccProvider.Meta().(*AWSClient).appstreamconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_appstream_image_builder" {
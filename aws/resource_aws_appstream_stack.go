@@ -184,7 +184,7 @@ func resourceAwsAppStreamStack() *schema.Resource {
 }
 
 func resourceAwsAppStreamStackCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).appstreamconn
+	conn := meta.(*AWSClient).appstreamconn()
 	input := &appstream.CreateStackInput{
 		Name: aws.String(d.Get("name").(string)),
 	}
@@ -261,7 +261,7 @@ func resourceAwsAppStreamStackCreate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceAwsAppStreamStackRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).appstreamconn
+	conn := meta.(*AWSClient).appstreamconn()
 
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
@@ -322,7 +322,7 @@ func resourceAwsAppStreamStackRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceAwsAppStreamStackUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).appstreamconn
+	conn := meta.(*AWSClient).appstreamconn()
 
 	input := &appstream.UpdateStackInput{
 		Name: aws.String(d.Id()),
@@ -375,7 +375,7 @@ func resourceAwsAppStreamStackUpdate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceAwsAppStreamStackDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).appstreamconn
+	conn := meta.(*AWSClient).appstreamconn()
 
 	_, err := conn.DeleteStackWithContext(ctx, &appstream.DeleteStackInput{
 		Name: aws.String(d.Id()),
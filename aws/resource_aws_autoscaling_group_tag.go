@@ -54,7 +54,7 @@ func resourceAwsAutoscalingGroupTag() *schema.Resource {
 }
 
 func resourceAwsAutoscalingGroupTagCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).autoscalingconn
+	conn := meta.(*AWSClient).autoscalingconn()
 
 	identifier := d.Get("autoscaling_group_name").(string)
 	tags := d.Get("tag").([]interface{})
@@ -70,7 +70,7 @@ func resourceAwsAutoscalingGroupTagCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsAutoscalingGroupTagRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).autoscalingconn
+	conn := meta.(*AWSClient).autoscalingconn()
 	identifier, key, err := tagresource.GetResourceID(d.Id())
 
 	if err != nil {
@@ -103,7 +103,7 @@ func resourceAwsAutoscalingGroupTagRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsAutoscalingGroupTagUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).autoscalingconn
+	conn := meta.(*AWSClient).autoscalingconn()
 	identifier, key, err := tagresource.GetResourceID(d.Id())
 
 	if err != nil {
@@ -118,7 +118,7 @@ func resourceAwsAutoscalingGroupTagUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsAutoscalingGroupTagDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).autoscalingconn
+	conn := meta.(*AWSClient).autoscalingconn()
 	identifier, key, err := tagresource.GetResourceID(d.Id())
 
 	if err != nil {
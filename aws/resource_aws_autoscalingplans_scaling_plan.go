@@ -319,7 +319,7 @@ func resourceAwsAutoScalingPlansScalingPlan() *schema.Resource {
 }
 
 func resourceAwsAutoScalingPlansScalingPlanCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).autoscalingplansconn
+	conn := meta.(*AWSClient).autoscalingplansconn()
 
 	scalingPlanName := d.Get("name").(string)
 	input := &autoscalingplans.CreateScalingPlanInput{
@@ -349,7 +349,7 @@ func resourceAwsAutoScalingPlansScalingPlanCreate(d *schema.ResourceData, meta i
 }
 
 func resourceAwsAutoScalingPlansScalingPlanRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).autoscalingplansconn
+	conn := meta.(*AWSClient).autoscalingplansconn()
 
 	scalingPlanName, scalingPlanVersion, err := tfautoscalingplans.ScalingPlanParseResourceID(d.Id())
 
@@ -384,7 +384,7 @@ func resourceAwsAutoScalingPlansScalingPlanRead(d *schema.ResourceData, meta int
 }
 
 func resourceAwsAutoScalingPlansScalingPlanUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).autoscalingplansconn
+	conn := meta.(*AWSClient).autoscalingplansconn()
 
 	scalingPlanName, scalingPlanVersion, err := tfautoscalingplans.ScalingPlanParseResourceID(d.Id())
 
@@ -416,7 +416,7 @@ func resourceAwsAutoScalingPlansScalingPlanUpdate(d *schema.ResourceData, meta i
 }
 
 func resourceAwsAutoScalingPlansScalingPlanDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).autoscalingplansconn
+	conn := meta.(*AWSClient).autoscalingplansconn()
 
 	scalingPlanName, scalingPlanVersion, err := tfautoscalingplans.ScalingPlanParseResourceID(d.Id())
 
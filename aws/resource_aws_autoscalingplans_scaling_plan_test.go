@@ -30,7 +30,7 @@ func testSweepAutoScalingPlansScalingPlans(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).autoscalingplansconn
+	conn := client.(*AWSClient).autoscalingplansconn()
 	input := &autoscalingplans.DescribeScalingPlansInput{}
 	sweepResources := make([]*testSweepResource, 0)
 
@@ -412,7 +412,7 @@ func TestAccAwsAutoScalingPlansScalingPlan_dynamicScaling_CustomizedScalingMetri
 }
 
 func testAccCheckAutoScalingPlansScalingPlanDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).autoscalingplansconn
+	conn := testAccProvider.Meta().(*AWSClient).autoscalingplansconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_autoscalingplans_scaling_plan" {
@@ -439,7 +439,7 @@ func testAccCheckAutoScalingPlansScalingPlanDestroy(s *terraform.State) error {
 
 func testAccCheckAutoScalingPlansScalingPlanExists(name string, v *autoscalingplans.ScalingPlan) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).autoscalingplansconn
+		conn := testAccProvider.Meta().(*AWSClient).autoscalingplansconn()
 
 		rs, ok := s.RootModule().Resources[name]
 		if !ok {
@@ -85,7 +85,7 @@ func resourceAwsBackupSelection() *schema.Resource {
 }
 
 func resourceAwsBackupSelectionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).backupconn
+	conn := meta.(*AWSClient).backupconn()
 
 	selection := &backup.Selection{
 		IamRoleArn:    aws.String(d.Get("iam_role_arn").(string)),
@@ -140,7 +140,7 @@ func resourceAwsBackupSelectionCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsBackupSelectionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).backupconn
+	conn := meta.(*AWSClient).backupconn()
 
 	input := &backup.GetBackupSelectionInput{
 		BackupPlanId: aws.String(d.Get("plan_id").(string)),
@@ -224,7 +224,7 @@ func resourceAwsBackupSelectionRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsBackupSelectionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).backupconn
+	conn := meta.(*AWSClient).backupconn()
 
 	input := &backup.DeleteBackupSelectionInput{
 		BackupPlanId: aws.String(d.Get("plan_id").(string)),
@@ -37,8 +37,8 @@ func testSweepBatchComputeEnvironments(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*AWSClient).batchconn
-	iamconn := client.(*AWSClient).iamconn
+	conn := client.(*AWSClient).batchconn()
+	iamconn := client.(*AWSClient).iamconn()
 
 	var sweeperErrs *multierror.Error
 
@@ -1468,7 +1468,7 @@ func TestAccAWSBatchComputeEnvironment_createSpotWithoutIamFleetRole(t *testing.
 }
 
 func testAccCheckBatchComputeEnvironmentDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).batchconn
+	conn := testAccProvider.Meta().(*AWSClient).batchconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_batch_compute_environment" {
@@ -1501,7 +1501,7 @@ func testAccCheckAwsBatchComputeEnvironmentExists(n string, v *batch.ComputeEnvi
 			return fmt.Errorf("No Batch Compute Environment ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).batchconn
+		conn := testAccProvider.Meta().(*AWSClient).batchconn()
 
 		computeEnvironment, err := finder.ComputeEnvironmentDetailByName(conn, rs.Primary.ID)
 
@@ -1516,7 +1516,7 @@ func testAccCheckAwsBatchComputeEnvironmentExists(n string, v *batch.ComputeEnvi
 }
 
 func testAccPreCheckAWSBatch(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).batchconn
+	conn := testAccProvider.Meta().(*AWSClient).batchconn()
 
 	input := &batch.DescribeComputeEnvironmentsInput{}
 
@@ -224,7 +224,7 @@ func resourceAwsBudgetsBudget() *schema.Resource {
 }
 
 func resourceAwsBudgetsBudgetCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).budgetconn
+	conn := meta.(*AWSClient).budgetconn()
 
 	budget, err := expandBudgetsBudgetUnmarshal(d)
 	if err != nil {
@@ -263,7 +263,7 @@ func resourceAwsBudgetsBudgetCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsBudgetsBudgetRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).budgetconn
+	conn := meta.(*AWSClient).budgetconn()
 
 	accountID, budgetName, err := tfbudgets.BudgetParseResourceID(d.Id())
 
@@ -383,7 +383,7 @@ func resourceAwsBudgetsBudgetRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsBudgetsBudgetUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).budgetconn
+	conn := meta.(*AWSClient).budgetconn()
 
 	accountID, _, err := tfbudgets.BudgetParseResourceID(d.Id())
 
@@ -414,7 +414,7 @@ func resourceAwsBudgetsBudgetUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsBudgetsBudgetDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).budgetconn
+	conn := meta.(*AWSClient).budgetconn()
 
 	accountID, budgetName, err := tfbudgets.BudgetParseResourceID(d.Id())
 
@@ -440,7 +440,7 @@ func resourceAwsBudgetsBudgetDelete(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsBudgetsBudgetNotificationsCreate(notifications []*budgets.Notification, subscribers [][]*budgets.Subscriber, budgetName string, accountID string, meta interface{}) error {
-	conn := meta.(*AWSClient).budgetconn
+	conn := meta.(*AWSClient).budgetconn()
 
 	for i, notification := range notifications {
 		subscribers := subscribers[i]
@@ -463,7 +463,7 @@ func resourceAwsBudgetsBudgetNotificationsCreate(notifications []*budgets.Notifi
 }
 
 func resourceAwsBudgetsBudgetNotificationsUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).budgetconn
+	conn := meta.(*AWSClient).budgetconn()
 
 	accountID, budgetName, err := tfbudgets.BudgetParseResourceID(d.Id())
 
@@ -208,7 +208,7 @@ func resourceAwsBudgetsBudgetAction() *schema.Resource {
 }
 
 func resourceAwsBudgetsBudgetActionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).budgetconn
+	conn := meta.(*AWSClient).budgetconn()
 
 	accountID := d.Get("account_id").(string)
 	if accountID == "" {
@@ -250,7 +250,7 @@ func resourceAwsBudgetsBudgetActionCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsBudgetsBudgetActionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).budgetconn
+	conn := meta.(*AWSClient).budgetconn()
 
 	accountID, actionID, budgetName, err := tfbudgets.BudgetActionParseResourceID(d.Id())
 
@@ -305,7 +305,7 @@ func resourceAwsBudgetsBudgetActionRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsBudgetsBudgetActionUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).budgetconn
+	conn := meta.(*AWSClient).budgetconn()
 
 	accountID, actionID, budgetName, err := tfbudgets.BudgetActionParseResourceID(d.Id())
 
@@ -358,7 +358,7 @@ func resourceAwsBudgetsBudgetActionUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsBudgetsBudgetActionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).budgetconn
+	conn := meta.(*AWSClient).budgetconn()
 
 	accountID, actionID, budgetName, err := tfbudgets.BudgetActionParseResourceID(d.Id())
 
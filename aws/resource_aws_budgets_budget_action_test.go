@@ -29,7 +29,7 @@ func testSweepBudgetsBudgetActionss(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).budgetconn
+	conn := client.(*AWSClient).budgetconn()
 	accountID := client.(*AWSClient).accountid
 	input := &budgets.DescribeBudgetActionsForAccountInput{
 		AccountId: aws.String(accountID),
@@ -148,7 +148,7 @@ func testAccAWSBudgetsBudgetActionExists(resourceName string, config *budgets.Ac
 			return fmt.Errorf("No Budget Action ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).budgetconn
+		conn := testAccProvider.Meta().(*AWSClient).budgetconn()
 
 		accountID, actionID, budgetName, err := tfbudgets.BudgetActionParseResourceID(rs.Primary.ID)
 
@@ -169,7 +169,7 @@ func testAccAWSBudgetsBudgetActionExists(resourceName string, config *budgets.Ac
 }
 
 func testAccAWSBudgetsBudgetActionDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).budgetconn
+	conn := testAccProvider.Meta().(*AWSClient).budgetconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_budgets_budget_action" {
@@ -31,7 +31,7 @@ func testSweepBudgetsBudgets(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).budgetconn
+	conn := client.(*AWSClient).budgetconn()
 	accountID := client.(*AWSClient).accountid
 	input := &budgets.DescribeBudgetsInput{
 		AccountId: aws.String(accountID),
@@ -415,7 +415,7 @@ func testAccAWSBudgetsBudgetExists(resourceName string, v *budgets.Budget) resou
 			return fmt.Errorf("No Budget ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).budgetconn
+		conn := testAccProvider.Meta().(*AWSClient).budgetconn()
 
 		accountID, budgetName, err := tfbudgets.BudgetParseResourceID(rs.Primary.ID)
 
@@ -436,7 +436,7 @@ func testAccAWSBudgetsBudgetExists(resourceName string, v *budgets.Budget) resou
 }
 
 func testAccAWSBudgetsBudgetDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).budgetconn
+	conn := testAccProvider.Meta().(*AWSClient).budgetconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_budgets_budget" {
@@ -48,7 +48,7 @@ func resourceAwsChimeVoiceConnector() *schema.Resource {
 }
 
 func resourceAwsChimeVoiceConnectorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).chimeconn
+	conn := meta.(*AWSClient).chimeconn()
 
 	createInput := &chime.CreateVoiceConnectorInput{
 		Name:              aws.String(d.Get("name").(string)),
@@ -70,7 +70,7 @@ func resourceAwsChimeVoiceConnectorCreate(ctx context.Context, d *schema.Resourc
 }
 
 func resourceAwsChimeVoiceConnectorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).chimeconn
+	conn := meta.(*AWSClient).chimeconn()
 
 	getInput := &chime.GetVoiceConnectorInput{
 		VoiceConnectorId: aws.String(d.Id()),
@@ -96,7 +96,7 @@ func resourceAwsChimeVoiceConnectorRead(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceAwsChimeVoiceConnectorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).chimeconn
+	conn := meta.(*AWSClient).chimeconn()
 
 	if d.HasChanges("name", "require_encryption") {
 		updateInput := &chime.UpdateVoiceConnectorInput{
@@ -118,7 +118,7 @@ func resourceAwsChimeVoiceConnectorUpdate(ctx context.Context, d *schema.Resourc
 }
 
 func resourceAwsChimeVoiceConnectorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).chimeconn
+	conn := meta.(*AWSClient).chimeconn()
 
 	input := &chime.DeleteVoiceConnectorInput{
 		VoiceConnectorId: aws.String(d.Id()),
@@ -52,7 +52,7 @@ func resourceAwsChimeVoiceConnectorGroup() *schema.Resource {
 }
 
 func resourceAwsChimeVoiceConnectorGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).chimeconn
+	conn := meta.(*AWSClient).chimeconn()
 
 	input := &chime.CreateVoiceConnectorGroupInput{
 		Name: aws.String(d.Get("name").(string)),
@@ -73,7 +73,7 @@ func resourceAwsChimeVoiceConnectorGroupCreate(ctx context.Context, d *schema.Re
 }
 
 func resourceAwsChimeVoiceConnectorGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).chimeconn
+	conn := meta.(*AWSClient).chimeconn()
 
 	getInput := &chime.GetVoiceConnectorGroupInput{
 		VoiceConnectorGroupId: aws.String(d.Id()),
@@ -98,7 +98,7 @@ func resourceAwsChimeVoiceConnectorGroupRead(ctx context.Context, d *schema.Reso
 }
 
 func resourceAwsChimeVoiceConnectorGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).chimeconn
+	conn := meta.(*AWSClient).chimeconn()
 
 	input := &chime.UpdateVoiceConnectorGroupInput{
 		Name:                  aws.String(d.Get("name").(string)),
@@ -126,7 +126,7 @@ func resourceAwsChimeVoiceConnectorGroupUpdate(ctx context.Context, d *schema.Re
 }
 
 func resourceAwsChimeVoiceConnectorGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).chimeconn
+	conn := meta.(*AWSClient).chimeconn()
 
 	if v, ok := d.GetOk("connector"); ok && v.(*schema.Set).Len() > 0 {
 		if err := resourceAwsChimeVoiceConnectorGroupUpdate(ctx, d, meta); err != nil {
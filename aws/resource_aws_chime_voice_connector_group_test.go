@@ -148,7 +148,7 @@ func testAccCheckAWSChimeVoiceConnectorGroupExists(name string, vc *chime.VoiceC
 			return fmt.Errorf("no Chime voice connector group ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).chimeconn
+		conn := testAccProvider.Meta().(*AWSClient).chimeconn()
 		input := &chime.GetVoiceConnectorGroupInput{
 			VoiceConnectorGroupId: aws.String(rs.Primary.ID),
 		}
@@ -168,7 +168,7 @@ func testAccCheckAWSChimeVoiceConnectorGroupDestroy(s *terraform.State) error {
 		if rs.Type != "aws_chime_voice_connector" {
 			continue
 		}
-		conn := testAccProvider.Meta().(*AWSClient).chimeconn
+		conn := testAccProvider.Meta().(*AWSClient).chimeconn()
 		input := &chime.GetVoiceConnectorGroupInput{
 			VoiceConnectorGroupId: aws.String(rs.Primary.ID),
 		}
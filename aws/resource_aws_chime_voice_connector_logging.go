@@ -37,7 +37,7 @@ func resourceAwsChimeVoiceConnectorLogging() *schema.Resource {
 }
 
 func resourceAwsChimeVoiceConnectorLoggingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).chimeconn
+	conn := meta.(*AWSClient).chimeconn()
 
 	vcId := d.Get("voice_connector_id").(string)
 	input := &chime.PutVoiceConnectorLoggingConfigurationInput{
@@ -56,7 +56,7 @@ func resourceAwsChimeVoiceConnectorLoggingCreate(ctx context.Context, d *schema.
 }
 
 func resourceAwsChimeVoiceConnectorLoggingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).chimeconn
+	conn := meta.(*AWSClient).chimeconn()
 
 	input := &chime.GetVoiceConnectorLoggingConfigurationInput{
 		VoiceConnectorId: aws.String(d.Id()),
@@ -80,7 +80,7 @@ func resourceAwsChimeVoiceConnectorLoggingRead(ctx context.Context, d *schema.Re
 }
 
 func resourceAwsChimeVoiceConnectorLoggingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).chimeconn
+	conn := meta.(*AWSClient).chimeconn()
 
 	if d.HasChange("enable_sip_logs") {
 		input := &chime.PutVoiceConnectorLoggingConfigurationInput{
@@ -104,7 +104,7 @@ func resourceAwsChimeVoiceConnectorLoggingUpdate(ctx context.Context, d *schema.
 }
 
 func resourceAwsChimeVoiceConnectorLoggingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).chimeconn
+	conn := meta.(*AWSClient).chimeconn()
 
 	input := &chime.PutVoiceConnectorLoggingConfigurationInput{
 		VoiceConnectorId: aws.String(d.Id()),
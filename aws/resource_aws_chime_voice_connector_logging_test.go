@@ -130,7 +130,7 @@ func testAccCheckAWSChimeVoiceConnectorLoggingExists(name string) resource.TestC
 			return fmt.Errorf("no Chime Voice Connector logging ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).chimeconn
+		conn := testAccProvider.Meta().(*AWSClient).chimeconn()
 		input := &chime.GetVoiceConnectorLoggingConfigurationInput{
 			VoiceConnectorId: aws.String(rs.Primary.ID),
 		}
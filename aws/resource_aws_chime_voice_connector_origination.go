@@ -73,7 +73,7 @@ func resourceAwsChimeVoiceConnectorOrigination() *schema.Resource {
 }
 
 func resourceAwsChimeVoiceConnectorOriginationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).chimeconn
+	conn := meta.(*AWSClient).chimeconn()
 
 	vcId := d.Get("voice_connector_id").(string)
 
@@ -98,7 +98,7 @@ func resourceAwsChimeVoiceConnectorOriginationCreate(ctx context.Context, d *sch
 }
 
 func resourceAwsChimeVoiceConnectorOriginationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).chimeconn
+	conn := meta.(*AWSClient).chimeconn()
 
 	input := &chime.GetVoiceConnectorOriginationInput{
 		VoiceConnectorId: aws.String(d.Id()),
@@ -131,7 +131,7 @@ func resourceAwsChimeVoiceConnectorOriginationRead(ctx context.Context, d *schem
 }
 
 func resourceAwsChimeVoiceConnectorOriginationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).chimeconn
+	conn := meta.(*AWSClient).chimeconn()
 
 	if d.HasChanges("route", "disabled") {
 		input := &chime.PutVoiceConnectorOriginationInput{
@@ -156,7 +156,7 @@ func resourceAwsChimeVoiceConnectorOriginationUpdate(ctx context.Context, d *sch
 }
 
 func resourceAwsChimeVoiceConnectorOriginationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).chimeconn
+	conn := meta.(*AWSClient).chimeconn()
 
 	input := &chime.DeleteVoiceConnectorOriginationInput{
 		VoiceConnectorId: aws.String(d.Id()),
@@ -116,7 +116,7 @@ func testAccCheckAWSChimeVoiceConnectorOriginationExists(name string) resource.T
 			return fmt.Errorf("no Chime voice connector origination ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).chimeconn
+		conn := testAccProvider.Meta().(*AWSClient).chimeconn()
 		input := &chime.GetVoiceConnectorOriginationInput{
 			VoiceConnectorId: aws.String(rs.Primary.ID),
 		}
@@ -139,7 +139,7 @@ func testAccCheckAWSChimeVoiceConnectorOriginationDestroy(s *terraform.State) er
 		if rs.Type != "aws_chime_voice_connector_origination" {
 			continue
 		}
-		conn := testAccProvider.Meta().(*AWSClient).chimeconn
+		conn := testAccProvider.Meta().(*AWSClient).chimeconn()
 		input := &chime.GetVoiceConnectorOriginationInput{
 			VoiceConnectorId: aws.String(rs.Primary.ID),
 		}
@@ -53,7 +53,7 @@ func resourceAwsChimeVoiceConnectorStreaming() *schema.Resource {
 }
 
 func resourceAwsChimeVoiceConnectorStreamingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).chimeconn
+	conn := meta.(*AWSClient).chimeconn()
 
 	vcId := d.Get("voice_connector_id").(string)
 	input := &chime.PutVoiceConnectorStreamingConfigurationInput{
@@ -81,7 +81,7 @@ func resourceAwsChimeVoiceConnectorStreamingCreate(ctx context.Context, d *schem
 }
 
 func resourceAwsChimeVoiceConnectorStreamingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).chimeconn
+	conn := meta.(*AWSClient).chimeconn()
 
 	input := &chime.GetVoiceConnectorStreamingConfigurationInput{
 		VoiceConnectorId: aws.String(d.Id()),
@@ -114,7 +114,7 @@ func resourceAwsChimeVoiceConnectorStreamingRead(ctx context.Context, d *schema.
 }
 
 func resourceAwsChimeVoiceConnectorStreamingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).chimeconn
+	conn := meta.(*AWSClient).chimeconn()
 
 	vcId := d.Get("voice_connector_id").(string)
 
@@ -148,7 +148,7 @@ func resourceAwsChimeVoiceConnectorStreamingUpdate(ctx context.Context, d *schem
 }
 
 func resourceAwsChimeVoiceConnectorStreamingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).chimeconn
+	conn := meta.(*AWSClient).chimeconn()
 
 	input := &chime.DeleteVoiceConnectorStreamingConfigurationInput{
 		VoiceConnectorId: aws.String(d.Id()),
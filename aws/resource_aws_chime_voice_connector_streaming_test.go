@@ -140,7 +140,7 @@ func testAccCheckAWSChimeVoiceConnectorStreamingExists(name string) resource.Tes
 			return fmt.Errorf("no Chime Voice Connector streaming configuration ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).chimeconn
+		conn := testAccProvider.Meta().(*AWSClient).chimeconn()
 		input := &chime.GetVoiceConnectorStreamingConfigurationInput{
 			VoiceConnectorId: aws.String(rs.Primary.ID),
 		}
@@ -163,7 +163,7 @@ func testAccCheckAWSChimeVoiceConnectorStreamingDestroy(s *terraform.State) erro
 		if rs.Type != "aws_chime_voice_connector_termination" {
 			continue
 		}
-		conn := testAccProvider.Meta().(*AWSClient).chimeconn
+		conn := testAccProvider.Meta().(*AWSClient).chimeconn()
 		input := &chime.GetVoiceConnectorStreamingConfigurationInput{
 			VoiceConnectorId: aws.String(rs.Primary.ID),
 		}
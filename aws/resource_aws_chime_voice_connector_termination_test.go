@@ -141,7 +141,7 @@ func testAccCheckAWSChimeVoiceConnectorTerminationExists(name string) resource.T
 			return fmt.Errorf("no Chime Voice Connector termination ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).chimeconn
+		conn := testAccProvider.Meta().(*AWSClient).chimeconn()
 		input := &chime.GetVoiceConnectorTerminationInput{
 			VoiceConnectorId: aws.String(rs.Primary.ID),
 		}
@@ -164,7 +164,7 @@ func testAccCheckAWSChimeVoiceConnectorTerminationDestroy(s *terraform.State) er
 		if rs.Type != "aws_chime_voice_connector_termination" {
 			continue
 		}
-		conn := testAccProvider.Meta().(*AWSClient).chimeconn
+		conn := testAccProvider.Meta().(*AWSClient).chimeconn()
 		input := &chime.GetVoiceConnectorTerminationInput{
 			VoiceConnectorId: aws.String(rs.Primary.ID),
 		}
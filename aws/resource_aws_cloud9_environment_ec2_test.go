@@ -188,7 +188,7 @@ func testAccCheckAWSCloud9EnvironmentEc2Exists(n string, res *cloud9.Environment
 			return fmt.Errorf("No Cloud9 Environment EC2 ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).cloud9conn
+		conn := testAccProvider.Meta().(*AWSClient).cloud9conn()
 
 		out, err := conn.DescribeEnvironments(&cloud9.DescribeEnvironmentsInput{
 			EnvironmentIds: []*string{aws.String(rs.Primary.ID)},
@@ -212,7 +212,7 @@ func testAccCheckAWSCloud9EnvironmentEc2Exists(n string, res *cloud9.Environment
 
 func testAccCheckAWSCloud9EnvironmentEc2Disappears(res *cloud9.Environment) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).cloud9conn
+		conn := testAccProvider.Meta().(*AWSClient).cloud9conn()
 
 		_, err := conn.DeleteEnvironment(&cloud9.DeleteEnvironmentInput{
 			EnvironmentId: res.Id,
@@ -248,7 +248,7 @@ func testAccCheckAWSCloud9EnvironmentEc2Disappears(res *cloud9.Environment) reso
 }
 
 func testAccCheckAWSCloud9EnvironmentEc2Destroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).cloud9conn
+	conn := testAccProvider.Meta().(*AWSClient).cloud9conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloud9_environment_ec2" {
@@ -78,7 +78,7 @@ func resourceAwsCloudControlApiResource() *schema.Resource {
 }
 
 func resourceAwsCloudControlApiResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).cloudcontrolapiconn
+	conn := meta.(*AWSClient).cloudcontrolapiconn()
 
 	typeName := d.Get("type_name").(string)
 	input := &cloudcontrolapi.CreateResourceInput{
@@ -123,7 +123,7 @@ func resourceAwsCloudControlApiResourceCreate(ctx context.Context, d *schema.Res
 }
 
 func resourceAwsCloudControlApiResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).cloudcontrolapiconn
+	conn := meta.(*AWSClient).cloudcontrolapiconn()
 
 	resourceDescription, err := finder.ResourceByID(ctx, conn,
 		d.Id(),
@@ -148,7 +148,7 @@ func resourceAwsCloudControlApiResourceRead(ctx context.Context, d *schema.Resou
 }
 
 func resourceAwsCloudControlApiResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).cloudcontrolapiconn
+	conn := meta.(*AWSClient).cloudcontrolapiconn()
 
 	if d.HasChange("desired_state") {
 		oldRaw, newRaw := d.GetChange("desired_state")
@@ -199,7 +199,7 @@ func resourceAwsCloudControlApiResourceUpdate(ctx context.Context, d *schema.Res
 }
 
 func resourceAwsCloudControlApiResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).cloudcontrolapiconn
+	conn := meta.(*AWSClient).cloudcontrolapiconn()
 
 	input := &cloudcontrolapi.DeleteResourceInput{
 		ClientToken: aws.String(resource.UniqueId()),
@@ -239,7 +239,7 @@ func resourceAwsCloudControlApiResourceDelete(ctx context.Context, d *schema.Res
 }
 
 func resourceAwsCloudControlApiResourceCustomizeDiffGetSchema(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
-	conn := meta.(*AWSClient).cfconn
+	conn := meta.(*AWSClient).cfconn()
 
 	resourceSchema := diff.Get("schema").(string)
 
@@ -3,14 +3,19 @@ package aws
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"reflect"
 	"regexp"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudcontrolapi"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/acctest/fake"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudcontrol/finder"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
@@ -25,6 +30,184 @@ func testAccErrorCheckSkipCloudControlAPI(t *testing.T) resource.ErrorCheckFunc
 	)
 }
 
+func TestResourceAwsCloudControlApiResourceCrud(t *testing.T) {
+	ctx := context.Background()
+	server := fake.NewServer(t)
+	var patchDocuments []string
+
+	server.Handle("cloudcontrolapi", "CreateResource", func(r *fake.Request) (interface{}, error) {
+		input := &cloudcontrolapi.CreateResourceInput{}
+
+		if err := r.DecodeJSON(input); err != nil {
+			return nil, err
+		}
+
+		// The identifier is only known once the request has succeeded.
+		r.State.Put("resource", "test", aws.StringValue(input.DesiredState))
+		r.State.Put("request", "create", 2)
+
+		return &cloudcontrolapi.CreateResourceOutput{
+			ProgressEvent: &cloudcontrolapi.ProgressEvent{
+				OperationStatus: aws.String(cloudcontrolapi.OperationStatusInProgress),
+				RequestToken:    aws.String("create"),
+			},
+		}, nil
+	})
+	server.Handle("cloudcontrolapi", "UpdateResource", func(r *fake.Request) (interface{}, error) {
+		input := &cloudcontrolapi.UpdateResourceInput{}
+
+		if err := r.DecodeJSON(input); err != nil {
+			return nil, err
+		}
+
+		patchDocuments = append(patchDocuments, aws.StringValue(input.PatchDocument))
+		r.State.Put("resource", aws.StringValue(input.Identifier), `{"LogGroupName":"test","RetentionInDays":7}`)
+		r.State.Put("request", "update", 1)
+
+		return &cloudcontrolapi.UpdateResourceOutput{
+			ProgressEvent: &cloudcontrolapi.ProgressEvent{
+				Identifier:      input.Identifier,
+				OperationStatus: aws.String(cloudcontrolapi.OperationStatusInProgress),
+				RequestToken:    aws.String("update"),
+			},
+		}, nil
+	})
+	server.Handle("cloudcontrolapi", "DeleteResource", func(r *fake.Request) (interface{}, error) {
+		input := &cloudcontrolapi.DeleteResourceInput{}
+
+		if err := r.DecodeJSON(input); err != nil {
+			return nil, err
+		}
+
+		r.State.Delete("resource", aws.StringValue(input.Identifier))
+		r.State.Put("request", "delete", 0)
+
+		return &cloudcontrolapi.DeleteResourceOutput{
+			ProgressEvent: &cloudcontrolapi.ProgressEvent{
+				Identifier:      input.Identifier,
+				OperationStatus: aws.String(cloudcontrolapi.OperationStatusInProgress),
+				RequestToken:    aws.String("delete"),
+			},
+		}, nil
+	})
+	server.Handle("cloudcontrolapi", "GetResourceRequestStatus", func(r *fake.Request) (interface{}, error) {
+		input := &cloudcontrolapi.GetResourceRequestStatusInput{}
+
+		if err := r.DecodeJSON(input); err != nil {
+			return nil, err
+		}
+
+		requestToken := aws.StringValue(input.RequestToken)
+		v, ok := r.State.Get("request", requestToken)
+
+		if !ok {
+			return nil, fake.NewError(http.StatusBadRequest, cloudcontrolapi.ErrCodeRequestTokenNotFoundException, requestToken)
+		}
+
+		progressEvent := &cloudcontrolapi.ProgressEvent{
+			Identifier:      aws.String("test"),
+			OperationStatus: aws.String(cloudcontrolapi.OperationStatusSuccess),
+			RequestToken:    input.RequestToken,
+		}
+
+		// Each request is in progress for its remaining number of polls.
+		if remaining := v.(int); remaining > 0 {
+			r.State.Put("request", requestToken, remaining-1)
+			progressEvent.OperationStatus = aws.String(cloudcontrolapi.OperationStatusInProgress)
+		}
+
+		return &cloudcontrolapi.GetResourceRequestStatusOutput{ProgressEvent: progressEvent}, nil
+	})
+	server.Handle("cloudcontrolapi", "GetResource", func(r *fake.Request) (interface{}, error) {
+		input := &cloudcontrolapi.GetResourceInput{}
+
+		if err := r.DecodeJSON(input); err != nil {
+			return nil, err
+		}
+
+		v, ok := r.State.Get("resource", aws.StringValue(input.Identifier))
+
+		if !ok {
+			return nil, fake.NewError(http.StatusBadRequest, cloudcontrolapi.ErrCodeResourceNotFoundException, "not found")
+		}
+
+		return &cloudcontrolapi.GetResourceOutput{
+			ResourceDescription: &cloudcontrolapi.ResourceDescription{
+				Identifier: input.Identifier,
+				Properties: aws.String(v.(string)),
+			},
+			TypeName: input.TypeName,
+		}, nil
+	})
+
+	meta := testFakeAWSClient(server)
+	r := resourceAwsCloudControlApiResource()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"desired_state": `{"LogGroupName":"test"}`,
+		"type_name":     "AWS::Logs::LogGroup",
+	})
+
+	if diags := resourceAwsCloudControlApiResourceCreate(ctx, d, meta); diags.HasError() {
+		t.Fatalf("unexpected create error: %v", diags)
+	}
+
+	if got, want := d.Id(), "test"; got != want {
+		t.Errorf("got ID %s, expected %s", got, want)
+	}
+
+	if got, want := d.Get("properties").(string), `{"LogGroupName":"test"}`; got != want {
+		t.Errorf("got properties %s, expected %s", got, want)
+	}
+
+	if got, want := server.Calls("cloudcontrolapi", "GetResourceRequestStatus"), 3; got != want {
+		t.Errorf("got %d GetResourceRequestStatus calls, expected %d", got, want)
+	}
+
+	state := d.State()
+	diff, err := schema.InternalMap(r.Schema).Diff(ctx, state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"desired_state": `{"LogGroupName":"test","RetentionInDays":7}`,
+		"type_name":     "AWS::Logs::LogGroup",
+	}), nil, nil, true)
+
+	if err != nil {
+		t.Fatalf("unexpected diff error: %s", err)
+	}
+
+	d, err = schema.InternalMap(r.Schema).Data(state, diff)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diags := resourceAwsCloudControlApiResourceUpdate(ctx, d, meta); diags.HasError() {
+		t.Fatalf("unexpected update error: %v", diags)
+	}
+
+	if got, want := patchDocuments, []string{`[{"op":"add","path":"/RetentionInDays","value":7}]`}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got patch documents %v, expected %v", got, want)
+	}
+
+	if got, want := d.Get("properties").(string), `{"LogGroupName":"test","RetentionInDays":7}`; got != want {
+		t.Errorf("got properties %s, expected %s", got, want)
+	}
+
+	if diags := resourceAwsCloudControlApiResourceDelete(ctx, d, meta); diags.HasError() {
+		t.Fatalf("unexpected delete error: %v", diags)
+	}
+
+	if _, ok := server.State.Get("resource", "test"); ok {
+		t.Error("resource not deleted")
+	}
+
+	if diags := resourceAwsCloudControlApiResourceRead(ctx, d, meta); diags.HasError() {
+		t.Fatalf("unexpected read error: %v", diags)
+	}
+
+	if got := d.Id(); got != "" {
+		t.Errorf("got ID %s for deleted resource, expected none", got)
+	}
+}
+
 func TestAccAwsCloudControlApiResource_basic(t *testing.T) {
 	rName := acctest.RandomWithPrefix("tf-acc-test")
 	resourceName := "aws_cloudcontrolapi_resource.test"
@@ -467,7 +650,7 @@ func TestAccAwsCloudControlApiResource_ResourceSchema(t *testing.T) {
 }
 
 func testAccCheckAwsCloudControlApiResourceDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).cloudcontrolapiconn
+	conn := testAccProvider.Meta().(*AWSClient).cloudcontrolapiconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudcontrolapi_resource" {
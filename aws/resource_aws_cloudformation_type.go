@@ -132,7 +132,7 @@ func resourceAwsCloudFormationType() *schema.Resource {
 }
 
 func resourceAwsCloudFormationTypeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).cfconn
+	conn := meta.(*AWSClient).cfconn()
 
 	typeName := d.Get("type_name").(string)
 	input := &cloudformation.RegisterTypeInput{
@@ -176,7 +176,7 @@ func resourceAwsCloudFormationTypeCreate(ctx context.Context, d *schema.Resource
 }
 
 func resourceAwsCloudFormationTypeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).cfconn
+	conn := meta.(*AWSClient).cfconn()
 
 	output, err := finder.TypeByARN(ctx, conn, d.Id())
 
@@ -223,7 +223,7 @@ func resourceAwsCloudFormationTypeRead(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceAwsCloudFormationTypeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).cfconn
+	conn := meta.(*AWSClient).cfconn()
 
 	input := &cloudformation.DeregisterTypeInput{
 		Arn: aws.String(d.Id()),
@@ -147,7 +147,7 @@ func testAccCheckAwsCloudformationTypeExists(resourceName string) resource.TestC
 			return fmt.Errorf("No CloudFormation Type ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).cfconn
+		conn := testAccProvider.Meta().(*AWSClient).cfconn()
 
 		_, err := finder.TypeByARN(context.TODO(), conn, rs.Primary.ID)
 
@@ -160,7 +160,7 @@ func testAccCheckAwsCloudformationTypeExists(resourceName string) resource.TestC
 }
 
 func testAccCheckAwsCloudformationTypeDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).cfconn
+	conn := testAccProvider.Meta().(*AWSClient).cfconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudformation_stack_set" {
@@ -73,7 +73,7 @@ func resourceAwsCloudFrontFunction() *schema.Resource {
 // resourceAwsCloudFrontFunction maps to:
 // CreateFunction in the API / SDK
 func resourceAwsCloudFrontFunctionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudfrontconn
+	conn := meta.(*AWSClient).cloudfrontconn()
 
 	functionName := d.Get("name").(string)
 	input := &cloudfront.CreateFunctionInput{
@@ -114,7 +114,7 @@ func resourceAwsCloudFrontFunctionCreate(d *schema.ResourceData, meta interface{
 // resourceAwsCloudFrontFunctionRead maps to:
 // GetFunction in the API / SDK
 func resourceAwsCloudFrontFunctionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudfrontconn
+	conn := meta.(*AWSClient).cloudfrontconn()
 
 	stage := cloudfront.FunctionStageDevelopment
 	if d.Get("publish").(bool) {
@@ -157,7 +157,7 @@ func resourceAwsCloudFrontFunctionRead(d *schema.ResourceData, meta interface{})
 // resourceAwsCloudFrontFunctionUpdate maps to:
 // UpdateFunctionCode in the API / SDK
 func resourceAwsCloudFrontFunctionUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudfrontconn
+	conn := meta.(*AWSClient).cloudfrontconn()
 	etag := d.Get("etag").(string)
 
 	if d.HasChanges("code", "comment", "runtime") {
@@ -201,7 +201,7 @@ func resourceAwsCloudFrontFunctionUpdate(d *schema.ResourceData, meta interface{
 // resourceAwsCloudFrontFunction maps to:
 // DeleteFunction in the API / SDK
 func resourceAwsCloudFrontFunctionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudfrontconn
+	conn := meta.(*AWSClient).cloudfrontconn()
 
 	log.Printf("[INFO] Deleting Cloudfront Function: %s", d.Id())
 	_, err := conn.DeleteFunction(&cloudfront.DeleteFunctionInput{
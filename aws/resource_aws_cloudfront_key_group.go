@@ -43,7 +43,7 @@ func resourceAwsCloudFrontKeyGroup() *schema.Resource {
 }
 
 func resourceAwsCloudFrontKeyGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudfrontconn
+	conn := meta.(*AWSClient).cloudfrontconn()
 
 	input := &cloudfront.CreateKeyGroupInput{
 		KeyGroupConfig: expandCloudFrontKeyGroupConfig(d),
@@ -65,7 +65,7 @@ func resourceAwsCloudFrontKeyGroupCreate(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsCloudFrontKeyGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudfrontconn
+	conn := meta.(*AWSClient).cloudfrontconn()
 	input := &cloudfront.GetKeyGroupInput{
 		Id: aws.String(d.Id()),
 	}
@@ -95,7 +95,7 @@ func resourceAwsCloudFrontKeyGroupRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsCloudFrontKeyGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudfrontconn
+	conn := meta.(*AWSClient).cloudfrontconn()
 
 	input := &cloudfront.UpdateKeyGroupInput{
 		Id:             aws.String(d.Id()),
@@ -112,7 +112,7 @@ func resourceAwsCloudFrontKeyGroupUpdate(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsCloudFrontKeyGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudfrontconn
+	conn := meta.(*AWSClient).cloudfrontconn()
 
 	input := &cloudfront.DeleteKeyGroupInput{
 		Id:      aws.String(d.Id()),
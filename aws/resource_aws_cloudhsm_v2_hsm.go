@@ -74,7 +74,7 @@ func resourceAwsCloudHsmV2Hsm() *schema.Resource {
 }
 
 func resourceAwsCloudHsmV2HsmCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudhsmv2conn
+	conn := meta.(*AWSClient).cloudhsmv2conn()
 
 	input := &cloudhsmv2.CreateHsmInput{
 		ClusterId: aws.String(d.Get("cluster_id").(string)),
@@ -123,7 +123,7 @@ func resourceAwsCloudHsmV2HsmCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsCloudHsmV2HsmRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudhsmv2conn
+	conn := meta.(*AWSClient).cloudhsmv2conn()
 
 	hsm, err := finder.Hsm(conn, d.Id(), d.Get("hsm_eni_id").(string))
 
@@ -160,7 +160,7 @@ func resourceAwsCloudHsmV2HsmRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsCloudHsmV2HsmDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudhsmv2conn
+	conn := meta.(*AWSClient).cloudhsmv2conn()
 	clusterId := d.Get("cluster_id").(string)
 
 	log.Printf("[DEBUG] CloudHSMv2 HSM delete %s %s", clusterId, d.Id())
@@ -25,7 +25,7 @@ func testSweepCloudhsmv2Hsms(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).cloudhsmv2conn
+	conn := client.(*AWSClient).cloudhsmv2conn()
 	input := &cloudhsmv2.DescribeClustersInput{}
 	sweepResources := make([]*testSweepResource, 0)
 
@@ -261,7 +261,7 @@ resource "aws_cloudhsm_v2_hsm" "test" {
 }
 
 func testAccCheckAWSCloudHsmV2HsmDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).cloudhsmv2conn
+	conn := testAccProvider.Meta().(*AWSClient).cloudhsmv2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudhsm_v2_hsm" {
@@ -284,7 +284,7 @@ func testAccCheckAWSCloudHsmV2HsmDestroy(s *terraform.State) error {
 
 func testAccCheckAWSCloudHsmV2HsmExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).cloudhsmv2conn
+		conn := testAccProvider.Meta().(*AWSClient).cloudhsmv2conn()
 
 		it, ok := s.RootModule().Resources[name]
 		if !ok {
@@ -31,7 +31,7 @@ func testSweepCloudWatchCompositeAlarms(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*AWSClient).cloudwatchconn
+	conn := client.(*AWSClient).cloudwatchconn()
 	ctx := context.Background()
 
 	input := &cloudwatch.DescribeAlarmsInput{
@@ -445,7 +445,7 @@ func TestAccAwsCloudWatchCompositeAlarm_updateAlarmRule(t *testing.T) {
 }
 
 func testAccCheckAwsCloudWatchCompositeAlarmDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).cloudwatchconn
+	conn := testAccProvider.Meta().(*AWSClient).cloudwatchconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudwatch_composite_alarm" {
@@ -480,7 +480,7 @@ func testAccCheckAwsCloudWatchCompositeAlarmExists(resourceName string) resource
 			return fmt.Errorf("resource %s has not set its id", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).cloudwatchconn
+		conn := testAccProvider.Meta().(*AWSClient).cloudwatchconn()
 
 		alarm, err := finder.CompositeAlarmByName(context.Background(), conn, rs.Primary.ID)
 
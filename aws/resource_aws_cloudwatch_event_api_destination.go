@@ -65,7 +65,7 @@ func resourceAwsCloudWatchEventApiDestination() *schema.Resource {
 }
 
 func resourceAwsCloudWatchEventApiDestinationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatcheventsconn
+	conn := meta.(*AWSClient).cloudwatcheventsconn()
 
 	input := &events.CreateApiDestinationInput{}
 
@@ -103,7 +103,7 @@ func resourceAwsCloudWatchEventApiDestinationCreate(d *schema.ResourceData, meta
 }
 
 func resourceAwsCloudWatchEventApiDestinationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatcheventsconn
+	conn := meta.(*AWSClient).cloudwatcheventsconn()
 
 	input := &events.DescribeApiDestinationInput{
 		Name: aws.String(d.Id()),
@@ -134,7 +134,7 @@ func resourceAwsCloudWatchEventApiDestinationRead(d *schema.ResourceData, meta i
 }
 
 func resourceAwsCloudWatchEventApiDestinationUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatcheventsconn
+	conn := meta.(*AWSClient).cloudwatcheventsconn()
 
 	input := &events.UpdateApiDestinationInput{}
 
@@ -166,7 +166,7 @@ func resourceAwsCloudWatchEventApiDestinationUpdate(d *schema.ResourceData, meta
 }
 
 func resourceAwsCloudWatchEventApiDestinationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatcheventsconn
+	conn := meta.(*AWSClient).cloudwatcheventsconn()
 
 	log.Printf("[INFO] Deleting CloudWatchEvent API Destination (%s)", d.Id())
 	input := &events.DeleteApiDestinationInput{
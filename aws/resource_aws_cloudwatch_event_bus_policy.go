@@ -45,7 +45,7 @@ func resourceAwsCloudWatchEventBusPolicy() *schema.Resource {
 }
 
 func resourceAwsCloudWatchEventBusPolicyCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatcheventsconn
+	conn := meta.(*AWSClient).cloudwatcheventsconn()
 
 	eventBusName := d.Get("event_bus_name").(string)
 	policy := d.Get("policy").(string)
@@ -68,7 +68,7 @@ func resourceAwsCloudWatchEventBusPolicyCreate(d *schema.ResourceData, meta inte
 
 // See also: https://docs.aws.amazon.com/AmazonCloudWatchEvents/latest/APIReference/API_DescribeEventBus.html
 func resourceAwsCloudWatchEventBusPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatcheventsconn
+	conn := meta.(*AWSClient).cloudwatcheventsconn()
 
 	eventBusName := d.Id()
 
@@ -133,7 +133,7 @@ func getEventBusPolicy(output *events.DescribeEventBusOutput) (*string, error) {
 }
 
 func resourceAwsCloudWatchEventBusPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatcheventsconn
+	conn := meta.(*AWSClient).cloudwatcheventsconn()
 
 	eventBusName := d.Id()
 
@@ -157,7 +157,7 @@ func resourceAwsCloudWatchEventBusPolicyUpdate(d *schema.ResourceData, meta inte
 }
 
 func resourceAwsCloudWatchEventBusPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatcheventsconn
+	conn := meta.(*AWSClient).cloudwatcheventsconn()
 
 	eventBusName := d.Id()
 	removeAllPermissions := true
@@ -85,7 +85,7 @@ func testAccCheckAWSCloudwatchEventBusPolicyExists(pr string) resource.TestCheck
 			Name: aws.String(eventBusName),
 		}
 
-		cloudWatchEventsConnection := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
+		cloudWatchEventsConnection := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn()
 		describedEventBus, err := cloudWatchEventsConnection.DescribeEventBus(input)
 
 		if err != nil {
@@ -122,7 +122,7 @@ func testAccAWSCloudwatchEventBusPolicyDocument(pr string) resource.TestCheckFun
 			Name: aws.String(eventBusName),
 		}
 
-		cloudWatchEventsConnection := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
+		cloudWatchEventsConnection := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn()
 		describedEventBus, err := cloudWatchEventsConnection.DescribeEventBus(input)
 		if err != nil {
 			return fmt.Errorf("Reading CloudWatch Events bus policy for '%s' failed: %w", pr, err)
@@ -237,7 +237,7 @@ func resourceAwsCloudWatchEventConnection() *schema.Resource {
 }
 
 func resourceAwsCloudWatchEventConnectionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatcheventsconn
+	conn := meta.(*AWSClient).cloudwatcheventsconn()
 
 	name := d.Get("name").(string)
 	input := &events.CreateConnectionInput{
@@ -270,7 +270,7 @@ func resourceAwsCloudWatchEventConnectionCreate(d *schema.ResourceData, meta int
 }
 
 func resourceAwsCloudWatchEventConnectionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatcheventsconn
+	conn := meta.(*AWSClient).cloudwatcheventsconn()
 
 	output, err := finder.ConnectionByName(conn, d.Id())
 
@@ -301,7 +301,7 @@ func resourceAwsCloudWatchEventConnectionRead(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsCloudWatchEventConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatcheventsconn
+	conn := meta.(*AWSClient).cloudwatcheventsconn()
 
 	input := &events.UpdateConnectionInput{
 		Name: aws.String(d.Id()),
@@ -336,7 +336,7 @@ func resourceAwsCloudWatchEventConnectionUpdate(d *schema.ResourceData, meta int
 }
 
 func resourceAwsCloudWatchEventConnectionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatcheventsconn
+	conn := meta.(*AWSClient).cloudwatcheventsconn()
 
 	log.Printf("[INFO] Deleting CloudWatch Events connection (%s)", d.Id())
 	_, err := conn.DeleteConnection(&events.DeleteConnectionInput{
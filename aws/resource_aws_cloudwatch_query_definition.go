@@ -55,7 +55,7 @@ func resourceAwsCloudWatchQueryDefinition() *schema.Resource {
 }
 
 func resourceAwsCloudWatchQueryDefinitionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).cloudwatchlogsconn
+	conn := meta.(*AWSClient).cloudwatchlogsconn()
 
 	input := getAwsCloudWatchQueryDefinitionInput(d)
 	r, err := conn.PutQueryDefinitionWithContext(ctx, input)
@@ -84,7 +84,7 @@ func getAwsCloudWatchQueryDefinitionInput(d *schema.ResourceData) *cloudwatchlog
 }
 
 func resourceAwsCloudWatchQueryDefinitionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).cloudwatchlogsconn
+	conn := meta.(*AWSClient).cloudwatchlogsconn()
 
 	result, err := finder.QueryDefinition(ctx, conn, d.Get("name").(string), d.Id())
 
@@ -109,7 +109,7 @@ func resourceAwsCloudWatchQueryDefinitionRead(ctx context.Context, d *schema.Res
 }
 
 func resourceAwsCloudWatchQueryDefinitionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).cloudwatchlogsconn
+	conn := meta.(*AWSClient).cloudwatchlogsconn()
 
 	_, err := conn.PutQueryDefinitionWithContext(ctx, getAwsCloudWatchQueryDefinitionInput(d))
 	if err != nil {
@@ -120,7 +120,7 @@ func resourceAwsCloudWatchQueryDefinitionUpdate(ctx context.Context, d *schema.R
 }
 
 func resourceAwsCloudWatchQueryDefinitionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).cloudwatchlogsconn
+	conn := meta.(*AWSClient).cloudwatchlogsconn()
 
 	input := &cloudwatchlogs.DeleteQueryDefinitionInput{
 		QueryDefinitionId: aws.String(d.Id()),
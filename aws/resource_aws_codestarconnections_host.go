@@ -80,7 +80,7 @@ func resourceAwsCodeStarConnectionsHost() *schema.Resource {
 }
 
 func resourceAwsCodeStarConnectionsHostCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).codestarconnectionsconn
+	conn := meta.(*AWSClient).codestarconnectionsconn()
 
 	params := &codestarconnections.CreateHostInput{
 		Name:             aws.String(d.Get("name").(string)),
@@ -105,7 +105,7 @@ func resourceAwsCodeStarConnectionsHostCreate(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsCodeStarConnectionsHostRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).codestarconnectionsconn
+	conn := meta.(*AWSClient).codestarconnectionsconn()
 
 	input := &codestarconnections.GetHostInput{
 		HostArn: aws.String(d.Id()),
@@ -138,7 +138,7 @@ func resourceAwsCodeStarConnectionsHostRead(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsCodeStarConnectionsHostUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).codestarconnectionsconn
+	conn := meta.(*AWSClient).codestarconnectionsconn()
 
 	if d.HasChanges("provider_endpoint", "vpc_configuration") {
 		input := codestarconnections.UpdateHostInput{
@@ -162,7 +162,7 @@ func resourceAwsCodeStarConnectionsHostUpdate(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsCodeStarConnectionsHostDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).codestarconnectionsconn
+	conn := meta.(*AWSClient).codestarconnectionsconn()
 
 	input := &codestarconnections.DeleteHostInput{
 		HostArn: aws.String(d.Id()),
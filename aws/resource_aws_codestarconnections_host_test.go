@@ -114,7 +114,7 @@ func testAccCheckAWSCodeStarConnectionsHostExists(n string, v *codestarconnectio
 			return errors.New("No CodeStar host ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).codestarconnectionsconn
+		conn := testAccProvider.Meta().(*AWSClient).codestarconnectionsconn()
 
 		resp, err := conn.GetHost(&codestarconnections.GetHostInput{
 			HostArn: aws.String(rs.Primary.ID),
@@ -130,7 +130,7 @@ func testAccCheckAWSCodeStarConnectionsHostExists(n string, v *codestarconnectio
 }
 
 func testAccCheckAWSCodeStarConnectionsHostDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).codestarconnectionsconn
+	conn := testAccProvider.Meta().(*AWSClient).codestarconnectionsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		switch rs.Type {
@@ -70,7 +70,7 @@ func resourceAwsCognitoResourceServer() *schema.Resource {
 }
 
 func resourceAwsCognitoResourceServerCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cognitoidpconn
+	conn := meta.(*AWSClient).cognitoidpconn()
 
 	identifier := d.Get("identifier").(string)
 	userPoolID := d.Get("user_pool_id").(string)
@@ -100,7 +100,7 @@ func resourceAwsCognitoResourceServerCreate(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsCognitoResourceServerRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cognitoidpconn
+	conn := meta.(*AWSClient).cognitoidpconn()
 
 	userPoolID, identifier, err := decodeCognitoResourceServerID(d.Id())
 	if err != nil {
@@ -153,7 +153,7 @@ func resourceAwsCognitoResourceServerRead(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsCognitoResourceServerUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cognitoidpconn
+	conn := meta.(*AWSClient).cognitoidpconn()
 
 	userPoolID, identifier, err := decodeCognitoResourceServerID(d.Id())
 	if err != nil {
@@ -178,7 +178,7 @@ func resourceAwsCognitoResourceServerUpdate(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsCognitoResourceServerDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cognitoidpconn
+	conn := meta.(*AWSClient).cognitoidpconn()
 
 	userPoolID, identifier, err := decodeCognitoResourceServerID(d.Id())
 	if err != nil {
@@ -92,7 +92,7 @@ func resourceAwsConfigConformancePack() *schema.Resource {
 }
 
 func resourceAwsConfigConformancePackPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).configconn
+	conn := meta.(*AWSClient).configconn()
 
 	name := d.Get("name").(string)
 
@@ -135,7 +135,7 @@ func resourceAwsConfigConformancePackPut(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsConfigConformancePackRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).configconn
+	conn := meta.(*AWSClient).configconn()
 
 	pack, err := configDescribeConformancePack(conn, d.Id())
 
@@ -172,7 +172,7 @@ func resourceAwsConfigConformancePackRead(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsConfigConformancePackDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).configconn
+	conn := meta.(*AWSClient).configconn()
 
 	input := &configservice.DeleteConformancePackInput{
 		ConformancePackName: aws.String(d.Id()),
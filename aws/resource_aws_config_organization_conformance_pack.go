@@ -108,7 +108,7 @@ func resourceAwsConfigOrganizationConformancePack() *schema.Resource {
 }
 
 func resourceAwsConfigOrganizationConformancePackCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).configconn
+	conn := meta.(*AWSClient).configconn()
 
 	name := d.Get("name").(string)
 
@@ -156,7 +156,7 @@ func resourceAwsConfigOrganizationConformancePackCreate(d *schema.ResourceData,
 }
 
 func resourceAwsConfigOrganizationConformancePackRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).configconn
+	conn := meta.(*AWSClient).configconn()
 
 	pack, err := configDescribeOrganizationConformancePack(conn, d.Id())
 
@@ -197,7 +197,7 @@ func resourceAwsConfigOrganizationConformancePackRead(d *schema.ResourceData, me
 }
 
 func resourceAwsConfigOrganizationConformancePackUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).configconn
+	conn := meta.(*AWSClient).configconn()
 
 	input := &configservice.PutOrganizationConformancePackInput{
 		OrganizationConformancePackName: aws.String(d.Id()),
@@ -241,7 +241,7 @@ func resourceAwsConfigOrganizationConformancePackUpdate(d *schema.ResourceData,
 }
 
 func resourceAwsConfigOrganizationConformancePackDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).configconn
+	conn := meta.(*AWSClient).configconn()
 
 	input := &configservice.DeleteOrganizationConformancePackInput{
 		OrganizationConformancePackName: aws.String(d.Id()),
@@ -411,7 +411,7 @@ func testAccConfigOrganizationConformancePack_updateTemplateBody(t *testing.T) {
 }
 
 func testAccCheckConfigOrganizationConformancePackDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).configconn
+	conn := testAccProvider.Meta().(*AWSClient).configconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_config_organization_conformance_pack" {
@@ -449,7 +449,7 @@ func testAccCheckConfigOrganizationConformancePackExists(resourceName string, oc
 			return fmt.Errorf("Not Found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).configconn
+		conn := testAccProvider.Meta().(*AWSClient).configconn()
 
 		pack, err := configDescribeOrganizationConformancePack(conn, rs.Primary.ID)
 
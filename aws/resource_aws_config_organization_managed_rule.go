@@ -107,7 +107,7 @@ func resourceAwsConfigOrganizationManagedRule() *schema.Resource {
 }
 
 func resourceAwsConfigOrganizationManagedRuleCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).configconn
+	conn := meta.(*AWSClient).configconn()
 	name := d.Get("name").(string)
 
 	input := &configservice.PutOrganizationConfigRuleInput{
@@ -165,7 +165,7 @@ func resourceAwsConfigOrganizationManagedRuleCreate(d *schema.ResourceData, meta
 }
 
 func resourceAwsConfigOrganizationManagedRuleRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).configconn
+	conn := meta.(*AWSClient).configconn()
 
 	rule, err := configDescribeOrganizationConfigRule(conn, d.Id())
 
@@ -217,7 +217,7 @@ func resourceAwsConfigOrganizationManagedRuleRead(d *schema.ResourceData, meta i
 }
 
 func resourceAwsConfigOrganizationManagedRuleUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).configconn
+	conn := meta.(*AWSClient).configconn()
 
 	input := &configservice.PutOrganizationConfigRuleInput{
 		OrganizationConfigRuleName: aws.String(d.Id()),
@@ -272,7 +272,7 @@ func resourceAwsConfigOrganizationManagedRuleUpdate(d *schema.ResourceData, meta
 }
 
 func resourceAwsConfigOrganizationManagedRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).configconn
+	conn := meta.(*AWSClient).configconn()
 
 	input := &configservice.DeleteOrganizationConfigRuleInput{
 		OrganizationConfigRuleName: aws.String(d.Id()),
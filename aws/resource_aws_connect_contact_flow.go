@@ -89,7 +89,7 @@ func resourceAwsConnectContactFlow() *schema.Resource {
 }
 
 func resourceAwsConnectContactFlowCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).connectconn
+	conn := meta.(*AWSClient).connectconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 
@@ -142,7 +142,7 @@ func resourceAwsConnectContactFlowCreate(ctx context.Context, d *schema.Resource
 }
 
 func resourceAwsConnectContactFlowRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).connectconn
+	conn := meta.(*AWSClient).connectconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
@@ -194,7 +194,7 @@ func resourceAwsConnectContactFlowRead(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceAwsConnectContactFlowUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).connectconn
+	conn := meta.(*AWSClient).connectconn()
 
 	instanceID, contactFlowID, err := resourceAwsConnectContactFlowParseID(d.Id())
 
@@ -258,7 +258,7 @@ func resourceAwsConnectContactFlowUpdate(ctx context.Context, d *schema.Resource
 
 //Contact Flows do not support deletion today. We will NoOp the Delete method. Users can rename their flows manually if they want.
 // func resourceAwsConnectContactFlowDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-// 	conn := meta.(*AWSClient).connectconn
+// 	conn := meta.(*AWSClient).connectconn()
 
 // 	instanceID, contactFlowID, err := resourceAwsConnectContactFlowParseID(d.Id())
 
@@ -171,7 +171,7 @@ func testAccCheckAwsConnectContactFlowExists(resourceName string, function *conn
 			return err
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).connectconn
+		conn := testAccProvider.Meta().(*AWSClient).connectconn()
 
 		params := &connect.DescribeContactFlowInput{
 			ContactFlowId: aws.String(contactFlowID),
@@ -195,7 +195,7 @@ func testAccCheckAwsConnectContactFlowDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).connectconn
+		conn := testAccProvider.Meta().(*AWSClient).connectconn()
 
 		instanceID, contactFlowID, err := resourceAwsConnectContactFlowParseID(rs.Primary.ID)
 
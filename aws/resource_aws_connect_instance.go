@@ -112,7 +112,7 @@ func resourceAwsConnectInstance() *schema.Resource {
 }
 
 func resourceAwsConnectInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).connectconn
+	conn := meta.(*AWSClient).connectconn()
 
 	input := &connect.CreateInstanceInput{
 		ClientToken:            aws.String(resource.UniqueId()),
@@ -159,7 +159,7 @@ func resourceAwsConnectInstanceCreate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceAwsConnectInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).connectconn
+	conn := meta.(*AWSClient).connectconn()
 
 	for att := range tfconnect.InstanceAttributeMapping() {
 		rKey := tfconnect.InstanceAttributeMapping()[att]
@@ -178,7 +178,7 @@ func resourceAwsConnectInstanceUpdate(ctx context.Context, d *schema.ResourceDat
 	return nil
 }
 func resourceAwsConnectInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).connectconn
+	conn := meta.(*AWSClient).connectconn()
 
 	input := connect.DescribeInstanceInput{
 		InstanceId: aws.String(d.Id()),
@@ -221,7 +221,7 @@ func resourceAwsConnectInstanceRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceAwsConnectInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).connectconn
+	conn := meta.(*AWSClient).connectconn()
 
 	input := &connect.DeleteInstanceInput{
 		InstanceId: aws.String(d.Id()),
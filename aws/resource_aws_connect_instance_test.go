@@ -30,7 +30,7 @@ func testSweepConnectInstance(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*AWSClient).connectconn
+	conn := client.(*AWSClient).connectconn()
 	ctx := context.Background()
 	var errs *multierror.Error
 	sweepResources := make([]*testSweepResource, 0)
@@ -214,7 +214,7 @@ func testAccCheckAwsConnectInstanceExists(resourceName string, instance *connect
 			return fmt.Errorf("Connect instance ID not set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).connectconn
+		conn := testAccProvider.Meta().(*AWSClient).connectconn()
 
 		input := &connect.DescribeInstanceInput{
 			InstanceId: aws.String(rs.Primary.ID),
@@ -240,7 +240,7 @@ func testAccCheckAwsConnectInstanceDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).connectconn
+		conn := testAccProvider.Meta().(*AWSClient).connectconn()
 
 		instanceID := rs.Primary.ID
 
@@ -33,7 +33,7 @@ func testSweepCurReportDefinitions(region string) error {
 		return nil
 	}
 
-	conn := client.costandusagereportconn
+	conn := client.costandusagereportconn()
 
 	input := &cur.DescribeReportDefinitionsInput{}
 	var sweeperErrs *multierror.Error
@@ -366,7 +366,7 @@ func testAccAwsCurReportDefinition_disappears(t *testing.T) {
 }
 
 func testAccCheckAwsCurReportDefinitionDestroy(s *terraform.State) error {
-	conn := testAccProviderCur.Meta().(*AWSClient).costandusagereportconn
+	conn := testAccProviderCur.Meta().(*AWSClient).costandusagereportconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cur_report_definition" {
@@ -390,7 +390,7 @@ func testAccCheckAwsCurReportDefinitionDestroy(s *terraform.State) error {
 
 func testAccCheckAwsCurReportDefinitionExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProviderCur.Meta().(*AWSClient).costandusagereportconn
+		conn := testAccProviderCur.Meta().(*AWSClient).costandusagereportconn()
 
 		rs, ok := s.RootModule().Resources[resourceName]
 		if !ok {
@@ -44,7 +44,7 @@ func resourceAwsDataPipelinePipeline() *schema.Resource {
 }
 
 func resourceAwsDataPipelinePipelineCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).datapipelineconn
+	conn := meta.(*AWSClient).datapipelineconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 
@@ -72,7 +72,7 @@ func resourceAwsDataPipelinePipelineCreate(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsDataPipelinePipelineRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).datapipelineconn
+	conn := meta.(*AWSClient).datapipelineconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
@@ -103,7 +103,7 @@ func resourceAwsDataPipelinePipelineRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsDataPipelinePipelineUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).datapipelineconn
+	conn := meta.(*AWSClient).datapipelineconn()
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
@@ -117,7 +117,7 @@ func resourceAwsDataPipelinePipelineUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsDataPipelinePipelineDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).datapipelineconn
+	conn := meta.(*AWSClient).datapipelineconn()
 
 	opts := datapipeline.DeletePipelineInput{
 		PipelineId: aws.String(d.Id()),
@@ -83,7 +83,7 @@ func resourceAwsDbProxyTarget() *schema.Resource {
 }
 
 func resourceAwsDbProxyTargetCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).rdsconn
+	conn := meta.(*AWSClient).rdsconn()
 
 	dbProxyName := d.Get("db_proxy_name").(string)
 	targetGroupName := d.Get("target_group_name").(string)
@@ -123,7 +123,7 @@ func resourceAwsDbProxyTargetParseID(id string) (string, string, string, string,
 }
 
 func resourceAwsDbProxyTargetRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).rdsconn
+	conn := meta.(*AWSClient).rdsconn()
 
 	dbProxyName, targetGroupName, targetType, rdsResourceId, err := resourceAwsDbProxyTargetParseID(d.Id())
 	if err != nil {
@@ -173,7 +173,7 @@ func resourceAwsDbProxyTargetRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsDbProxyTargetDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).rdsconn
+	conn := meta.(*AWSClient).rdsconn()
 
 	params := rds.DeregisterDBProxyTargetsInput{
 		DBProxyName:     aws.String(d.Get("db_proxy_name").(string)),
@@ -43,7 +43,7 @@ func resourceAwsDefaultVpcDhcpOptions() *schema.Resource {
 }
 
 func resourceAwsDefaultVpcDhcpOptionsCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	filters := []*ec2.Filter{
 		{
@@ -52,7 +52,7 @@ func TestAccAWSDirectoryServiceConditionForwarder_basic(t *testing.T) {
 }
 
 func testAccCheckAwsDirectoryServiceConditionalForwarderDestroy(s *terraform.State) error {
-	dsconn := testAccProvider.Meta().(*AWSClient).dsconn
+	dsconn := testAccProvider.Meta().(*AWSClient).dsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_directory_service_conditional_forwarder" {
@@ -101,7 +101,7 @@ func testAccCheckAwsDirectoryServiceConditionalForwarderExists(name string, dnsI
 			return err
 		}
 
-		dsconn := testAccProvider.Meta().(*AWSClient).dsconn
+		dsconn := testAccProvider.Meta().(*AWSClient).dsconn()
 
 		res, err := dsconn.DescribeConditionalForwarders(&directoryservice.DescribeConditionalForwardersInput{
 			DirectoryId:       aws.String(directoryId),
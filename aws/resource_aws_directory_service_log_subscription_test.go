@@ -41,7 +41,7 @@ func TestAccAWSDirectoryServiceLogSubscription_basic(t *testing.T) {
 }
 
 func testAccCheckAwsDirectoryServiceLogSubscriptionDestroy(s *terraform.State) error {
-	dsconn := testAccProvider.Meta().(*AWSClient).dsconn
+	dsconn := testAccProvider.Meta().(*AWSClient).dsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_directory_service_log_subscription" {
@@ -79,7 +79,7 @@ func testAccCheckAwsDirectoryServiceLogSubscriptionExists(name string, logGroupN
 			return fmt.Errorf("No ID is set")
 		}
 
-		dsconn := testAccProvider.Meta().(*AWSClient).dsconn
+		dsconn := testAccProvider.Meta().(*AWSClient).dsconn()
 
 		res, err := dsconn.ListLogSubscriptions(&directoryservice.ListLogSubscriptionsInput{
 			DirectoryId: aws.String(rs.Primary.ID),
@@ -566,7 +566,7 @@ func TestAccAwsDmsEndpoint_Db2(t *testing.T) {
 }
 
 func testAccCheckAWSDmsEndpointDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).dmsconn
+	conn := testAccProvider.Meta().(*AWSClient).dmsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_dms_endpoint" {
@@ -600,7 +600,7 @@ func testAccCheckAWSDmsEndpointExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("No DMS Endpoint ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).dmsconn
+		conn := testAccProvider.Meta().(*AWSClient).dmsconn()
 
 		_, err := finder.EndpointByID(conn, rs.Primary.ID)
 
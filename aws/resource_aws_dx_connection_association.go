@@ -35,7 +35,7 @@ func resourceAwsDxConnectionAssociation() *schema.Resource {
 }
 
 func resourceAwsDxConnectionAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).dxconn()
 
 	connectionID := d.Get("connection_id").(string)
 	lagID := d.Get("lag_id").(string)
@@ -57,7 +57,7 @@ func resourceAwsDxConnectionAssociationCreate(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsDxConnectionAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).dxconn()
 
 	lagID := d.Get("lag_id").(string)
 	err := finder.ConnectionAssociationExists(conn, d.Id(), lagID)
@@ -76,7 +76,7 @@ func resourceAwsDxConnectionAssociationRead(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsDxConnectionAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).dxconn()
 
 	return deleteDirectConnectConnectionLAGAssociation(conn, d.Id(), d.Get("lag_id").(string))
 }
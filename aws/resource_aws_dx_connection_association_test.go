@@ -75,7 +75,7 @@ func TestAccAWSDxConnectionAssociation_Multiple(t *testing.T) {
 }
 
 func testAccCheckAwsDxConnectionAssociationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).dxconn
+	conn := testAccProvider.Meta().(*AWSClient).dxconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_dx_connection_association" {
@@ -100,7 +100,7 @@ func testAccCheckAwsDxConnectionAssociationDestroy(s *terraform.State) error {
 
 func testAccCheckAwsDxConnectionAssociationExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).dxconn
+		conn := testAccProvider.Meta().(*AWSClient).dxconn()
 
 		rs, ok := s.RootModule().Resources[name]
 		if !ok {
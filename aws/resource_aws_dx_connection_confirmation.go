@@ -29,7 +29,7 @@ func resourceAwsDxConnectionConfirmation() *schema.Resource {
 }
 
 func resourceAwsDxConnectionConfirmationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).dxconn()
 
 	connectionID := d.Get("connection_id").(string)
 	input := &directconnect.ConfirmConnectionInput{
@@ -53,7 +53,7 @@ func resourceAwsDxConnectionConfirmationCreate(d *schema.ResourceData, meta inte
 }
 
 func resourceAwsDxConnectionConfirmationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).dxconn()
 
 	_, err := finder.ConnectionByID(conn, d.Id())
 
@@ -56,7 +56,7 @@ func testAccCheckAwsDxConnectionConfirmationExists(name string, providerFunc fun
 		}
 
 		provider := providerFunc()
-		conn := provider.Meta().(*AWSClient).dxconn
+		conn := provider.Meta().(*AWSClient).dxconn()
 
 		connection, err := finder.ConnectionByID(conn, rs.Primary.ID)
 
@@ -30,7 +30,7 @@ func testSweepDxConnections(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*AWSClient).dxconn
+	conn := client.(*AWSClient).dxconn()
 
 	var sweeperErrs *multierror.Error
 
@@ -221,7 +221,7 @@ func TestAccAwsDxConnection_Tags(t *testing.T) {
 }
 
 func testAccCheckAwsDxConnectionDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).dxconn
+	conn := testAccProvider.Meta().(*AWSClient).dxconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_dx_connection" {
@@ -246,7 +246,7 @@ func testAccCheckAwsDxConnectionDestroy(s *terraform.State) error {
 
 func testAccCheckAwsDxConnectionExists(name string, v *directconnect.Connection) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).dxconn
+		conn := testAccProvider.Meta().(*AWSClient).dxconn()
 
 		rs, ok := s.RootModule().Resources[name]
 		if !ok {
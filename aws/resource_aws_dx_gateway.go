@@ -53,7 +53,7 @@ func resourceAwsDxGateway() *schema.Resource {
 }
 
 func resourceAwsDxGatewayCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).dxconn()
 
 	name := d.Get("name").(string)
 	input := &directconnect.CreateDirectConnectGatewayInput{
@@ -83,7 +83,7 @@ func resourceAwsDxGatewayCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsDxGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).dxconn()
 
 	output, err := finder.GatewayByID(conn, d.Id())
 
@@ -105,7 +105,7 @@ func resourceAwsDxGatewayRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsDxGatewayDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).dxconn()
 
 	log.Printf("[DEBUG] Deleting Direct Connect Gateway: %s", d.Id())
 	_, err := conn.DeleteDirectConnectGateway(&directconnect.DeleteDirectConnectGatewayInput{
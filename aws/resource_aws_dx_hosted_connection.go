@@ -94,7 +94,7 @@ func resourceAwsDxHostedConnection() *schema.Resource {
 }
 
 func resourceAwsDxHostedConnectionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).dxconn()
 
 	name := d.Get("name").(string)
 	input := &directconnect.AllocateHostedConnectionInput{
@@ -118,7 +118,7 @@ func resourceAwsDxHostedConnectionCreate(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsDxHostedConnectionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).dxconn()
 
 	connection, err := finder.HostedConnectionByID(conn, d.Id())
 
@@ -153,7 +153,7 @@ func resourceAwsDxHostedConnectionRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsDxHostedConnectionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).dxconn()
 
 	return deleteDirectConnectConnection(conn, d.Id(), waiter.HostedConnectionDeleted)
 }
@@ -66,7 +66,7 @@ func testAccCheckAwsDxHostedConnectionEnv() (*testAccDxHostedConnectionEnv, erro
 func testAccCheckAwsDxHostedConnectionDestroy(providerFunc func() *schema.Provider) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		provider := providerFunc()
-		conn := provider.Meta().(*AWSClient).dxconn
+		conn := provider.Meta().(*AWSClient).dxconn()
 
 		for _, rs := range s.RootModule().Resources {
 			if rs.Type != "aws_dx_hosted_connection" {
@@ -92,7 +92,7 @@ func testAccCheckAwsDxHostedConnectionDestroy(providerFunc func() *schema.Provid
 
 func testAccCheckAwsDxHostedConnectionExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).dxconn
+		conn := testAccProvider.Meta().(*AWSClient).dxconn()
 
 		rs, ok := s.RootModule().Resources[name]
 		if !ok {
@@ -31,7 +31,7 @@ func testSweepDxLags(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*AWSClient).dxconn
+	conn := client.(*AWSClient).dxconn()
 
 	var sweeperErrs *multierror.Error
 
@@ -287,7 +287,7 @@ func TestAccAwsDxLag_Tags(t *testing.T) {
 }
 
 func testAccCheckAwsDxLagDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).dxconn
+	conn := testAccProvider.Meta().(*AWSClient).dxconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_dx_lag" {
@@ -312,7 +312,7 @@ func testAccCheckAwsDxLagDestroy(s *terraform.State) error {
 
 func testAccCheckAwsDxLagExists(name string, v *directconnect.Lag) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).dxconn
+		conn := testAccProvider.Meta().(*AWSClient).dxconn()
 
 		rs, ok := s.RootModule().Resources[name]
 		if !ok {
@@ -42,7 +42,7 @@ func resourceAwsDynamoDbKinesisStreamingDestination() *schema.Resource {
 }
 
 func resourceAwsDynamoDbKinesisStreamingDestinationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).dynamodbconn
+	conn := meta.(*AWSClient).dynamodbconn()
 
 	streamArn := d.Get("stream_arn").(string)
 	tableName := d.Get("table_name").(string)
@@ -72,7 +72,7 @@ func resourceAwsDynamoDbKinesisStreamingDestinationCreate(ctx context.Context, d
 }
 
 func resourceAwsDynamoDbKinesisStreamingDestinationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).dynamodbconn
+	conn := meta.(*AWSClient).dynamodbconn()
 
 	tableName, streamArn, err := dynamoDbKinesisStreamingDestinationParseId(d.Id())
 
@@ -108,7 +108,7 @@ func resourceAwsDynamoDbKinesisStreamingDestinationRead(ctx context.Context, d *
 }
 
 func resourceAwsDynamoDbKinesisStreamingDestinationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).dynamodbconn
+	conn := meta.(*AWSClient).dynamodbconn()
 
 	tableName, streamArn, err := dynamoDbKinesisStreamingDestinationParseId(d.Id())
 
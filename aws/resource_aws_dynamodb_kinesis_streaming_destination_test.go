@@ -130,7 +130,7 @@ func testAccCheckDynamoDbKinesisStreamingDestinationExists(resourceName string)
 			return err
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).dynamodbconn
+		conn := testAccProvider.Meta().(*AWSClient).dynamodbconn()
 
 		output, err := finder.DynamoDBKinesisDataStreamDestination(context.Background(), conn, streamArn, tableName)
 
@@ -147,7 +147,7 @@ func testAccCheckDynamoDbKinesisStreamingDestinationExists(resourceName string)
 }
 
 func testAccCheckAWSDynamoDbKinesisStreamingDestinationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).dynamodbconn
+	conn := testAccProvider.Meta().(*AWSClient).dynamodbconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_dynamodb_kinesis_streaming_destination" {
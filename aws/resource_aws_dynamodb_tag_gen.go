@@ -43,7 +43,7 @@ func resourceAwsDynamodbTag() *schema.Resource {
 }
 
 func resourceAwsDynamodbTagCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dynamodbconn
+	conn := meta.(*AWSClient).dynamodbconn()
 
 	identifier := d.Get("resource_arn").(string)
 	key := d.Get("key").(string)
@@ -59,7 +59,7 @@ func resourceAwsDynamodbTagCreate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsDynamodbTagRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dynamodbconn
+	conn := meta.(*AWSClient).dynamodbconn()
 	identifier, key, err := tagresource.GetResourceID(d.Id())
 
 	if err != nil {
@@ -86,7 +86,7 @@ func resourceAwsDynamodbTagRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsDynamodbTagUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dynamodbconn
+	conn := meta.(*AWSClient).dynamodbconn()
 	identifier, key, err := tagresource.GetResourceID(d.Id())
 
 	if err != nil {
@@ -101,7 +101,7 @@ func resourceAwsDynamodbTagUpdate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsDynamodbTagDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dynamodbconn
+	conn := meta.(*AWSClient).dynamodbconn()
 	identifier, key, err := tagresource.GetResourceID(d.Id())
 
 	if err != nil {
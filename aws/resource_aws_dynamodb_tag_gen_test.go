@@ -13,8 +13,19 @@ import (
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
+func init() {
+	resource.AddTestSweepers("aws_dynamodb_tag", &resource.Sweeper{
+		Name: "aws_dynamodb_tag",
+		F:    testSweepDynamodbTags,
+	})
+}
+
+func testSweepDynamodbTags(region string) error {
+	return testSweepTagResources(region, "dynamodb")
+}
+
 func testAccCheckDynamodbTagDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).dynamodbconn
+	conn := testAccProvider.Meta().(*AWSClient).dynamodbconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_dynamodb_tag" {
@@ -60,7 +71,7 @@ func testAccCheckDynamodbTagExists(resourceName string) resource.TestCheckFunc {
 			return err
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).dynamodbconn
+		conn := testAccProvider.Meta().(*AWSClient).dynamodbconn()
 
 		_, err = keyvaluetags.DynamodbGetTag(conn, identifier, key)
 
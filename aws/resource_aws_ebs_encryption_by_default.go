@@ -26,7 +26,7 @@ func resourceAwsEbsEncryptionByDefault() *schema.Resource {
 }
 
 func resourceAwsEbsEncryptionByDefaultCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	enabled := d.Get("enabled").(bool)
 	if err := setEbsEncryptionByDefault(conn, enabled); err != nil {
@@ -40,7 +40,7 @@ func resourceAwsEbsEncryptionByDefaultCreate(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsEbsEncryptionByDefaultRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	resp, err := conn.GetEbsEncryptionByDefault(&ec2.GetEbsEncryptionByDefaultInput{})
 	if err != nil {
@@ -53,7 +53,7 @@ func resourceAwsEbsEncryptionByDefaultRead(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsEbsEncryptionByDefaultUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	enabled := d.Get("enabled").(bool)
 	if err := setEbsEncryptionByDefault(conn, enabled); err != nil {
@@ -64,7 +64,7 @@ func resourceAwsEbsEncryptionByDefaultUpdate(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsEbsEncryptionByDefaultDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	// Removing the resource disables default encryption.
 	if err := setEbsEncryptionByDefault(conn, false); err != nil {
@@ -157,7 +157,7 @@ func resourceAwsEbsSnapshotImport() *schema.Resource {
 }
 
 func resourceAwsEbsSnapshotImportCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 
 	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
@@ -250,7 +250,7 @@ func resourceAwsEbsSnapshotImportCreate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsEbsSnapshotImportRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
@@ -306,7 +306,7 @@ func resourceAwsEbsSnapshotImportRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsEbsSnapshotImportUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
@@ -319,7 +319,7 @@ func resourceAwsEbsSnapshotImportUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsEbsSnapshotImportDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 	input := &ec2.DeleteSnapshotInput{
 		SnapshotId: aws.String(d.Id()),
 	}
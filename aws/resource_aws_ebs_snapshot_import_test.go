@@ -151,7 +151,7 @@ func testAccCheckSnapshotImportExists(n string, v *ec2.Snapshot) resource.TestCh
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 		request := &ec2.DescribeSnapshotsInput{
 			SnapshotIds: []*string{aws.String(rs.Primary.ID)},
@@ -169,7 +169,7 @@ func testAccCheckSnapshotImportExists(n string, v *ec2.Snapshot) resource.TestCh
 }
 
 func testAccCheckAwsEbsSnapshotImportDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ebs_snapshot_import" {
@@ -47,7 +47,7 @@ func resourceAwsEc2ManagedPrefixListEntry() *schema.Resource {
 }
 
 func resourceAwsEc2ManagedPrefixListEntryCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	cidr := d.Get("cidr").(string)
 	plID := d.Get("prefix_list_id").(string)
@@ -87,7 +87,7 @@ func resourceAwsEc2ManagedPrefixListEntryCreate(d *schema.ResourceData, meta int
 }
 
 func resourceAwsEc2ManagedPrefixListEntryRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	plID, cidr, err := tfec2.ManagedPrefixListEntryParseID(d.Id())
 
@@ -118,7 +118,7 @@ func resourceAwsEc2ManagedPrefixListEntryRead(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsEc2ManagedPrefixListEntryDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	plID, cidr, err := tfec2.ManagedPrefixListEntryParseID(d.Id())
 
@@ -169,7 +169,7 @@ func TestAccAwsEc2ManagedPrefixListEntry_disappears(t *testing.T) {
 }
 
 func testAccCheckAWSEc2ManagedPrefixListEntryDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ec2_managed_prefix_list_entry" {
@@ -209,7 +209,7 @@ func testAccCheckAWSEc2ManagedPrefixListEntryExists(n string, v *ec2.PrefixListE
 			return fmt.Errorf("No EC2 Managed Prefix List Entry ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 		plID, cidr, err := tfec2.ManagedPrefixListEntryParseID(rs.Primary.ID)
 
@@ -43,7 +43,7 @@ func resourceAwsEc2Tag() *schema.Resource {
 }
 
 func resourceAwsEc2TagCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	identifier := d.Get("resource_id").(string)
 	key := d.Get("key").(string)
@@ -59,7 +59,7 @@ func resourceAwsEc2TagCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsEc2TagRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 	identifier, key, err := tagresource.GetResourceID(d.Id())
 
 	if err != nil {
@@ -86,7 +86,7 @@ func resourceAwsEc2TagRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsEc2TagUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 	identifier, key, err := tagresource.GetResourceID(d.Id())
 
 	if err != nil {
@@ -101,7 +101,7 @@ func resourceAwsEc2TagUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsEc2TagDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 	identifier, key, err := tagresource.GetResourceID(d.Id())
 
 	if err != nil {
@@ -13,8 +13,19 @@ import (
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
+func init() {
+	resource.AddTestSweepers("aws_ec2_tag", &resource.Sweeper{
+		Name: "aws_ec2_tag",
+		F:    testSweepEc2Tags,
+	})
+}
+
+func testSweepEc2Tags(region string) error {
+	return testSweepTagResources(region, "ec2")
+}
+
 func testAccCheckEc2TagDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ec2_tag" {
@@ -60,7 +71,7 @@ func testAccCheckEc2TagExists(resourceName string) resource.TestCheckFunc {
 			return err
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 		_, err = keyvaluetags.Ec2GetTag(conn, identifier, key)
 
@@ -37,7 +37,7 @@ func resourceAwsEcrRegistryPolicy() *schema.Resource {
 }
 
 func resourceAwsEcrRegistryPolicyPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ecrconn
+	conn := meta.(*AWSClient).ecrconn()
 
 	input := ecr.PutRegistryPolicyInput{
 		PolicyText: aws.String(d.Get("policy").(string)),
@@ -56,7 +56,7 @@ func resourceAwsEcrRegistryPolicyPut(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsEcrRegistryPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ecrconn
+	conn := meta.(*AWSClient).ecrconn()
 
 	log.Printf("[DEBUG] Reading registry policy %s", d.Id())
 	out, err := conn.GetRegistryPolicy(&ecr.GetRegistryPolicyInput{})
@@ -76,7 +76,7 @@ func resourceAwsEcrRegistryPolicyRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsEcrRegistryPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ecrconn
+	conn := meta.(*AWSClient).ecrconn()
 
 	_, err := conn.DeleteRegistryPolicy(&ecr.DeleteRegistryPolicyInput{})
 	if err != nil {
@@ -85,7 +85,7 @@ func testAccAWSEcrRegistryPolicy_disappears(t *testing.T) {
 }
 
 func testAccCheckAWSEcrRegistryPolicyDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ecrconn
+	conn := testAccProvider.Meta().(*AWSClient).ecrconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ecr_registry_policy" {
@@ -115,7 +115,7 @@ func testAccCheckAWSEcrRegistryPolicyExists(name string, res *ecr.GetRegistryPol
 			return fmt.Errorf("No ECR registry policy ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ecrconn
+		conn := testAccProvider.Meta().(*AWSClient).ecrconn()
 
 		output, err := conn.GetRegistryPolicy(&ecr.GetRegistryPolicyInput{})
 		if err != nil {
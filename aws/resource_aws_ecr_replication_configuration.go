@@ -64,7 +64,7 @@ func resourceAwsEcrReplicationConfiguration() *schema.Resource {
 }
 
 func resourceAwsEcrReplicationConfigurationPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ecrconn
+	conn := meta.(*AWSClient).ecrconn()
 
 	input := ecr.PutReplicationConfigurationInput{
 		ReplicationConfiguration: expandEcrReplicationConfigurationReplicationConfiguration(d.Get("replication_configuration").([]interface{})),
@@ -81,7 +81,7 @@ func resourceAwsEcrReplicationConfigurationPut(d *schema.ResourceData, meta inte
 }
 
 func resourceAwsEcrReplicationConfigurationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ecrconn
+	conn := meta.(*AWSClient).ecrconn()
 
 	log.Printf("[DEBUG] Reading ECR Replication Configuration %s", d.Id())
 	out, err := conn.DescribeRegistry(&ecr.DescribeRegistryInput{})
@@ -99,7 +99,7 @@ func resourceAwsEcrReplicationConfigurationRead(d *schema.ResourceData, meta int
 }
 
 func resourceAwsEcrReplicationConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ecrconn
+	conn := meta.(*AWSClient).ecrconn()
 
 	input := ecr.PutReplicationConfigurationInput{
 		ReplicationConfiguration: &ecr.ReplicationConfiguration{
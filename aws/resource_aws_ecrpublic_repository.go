@@ -106,7 +106,7 @@ func resourceAwsEcrPublicRepository() *schema.Resource {
 }
 
 func resourceAwsEcrPublicRepositoryCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ecrpublicconn
+	conn := meta.(*AWSClient).ecrpublicconn()
 
 	input := ecrpublic.CreateRepositoryInput{
 		RepositoryName: aws.String(d.Get("repository_name").(string)),
@@ -137,7 +137,7 @@ func resourceAwsEcrPublicRepositoryCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsEcrPublicRepositoryRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ecrpublicconn
+	conn := meta.(*AWSClient).ecrpublicconn()
 
 	log.Printf("[DEBUG] Reading ECR Public repository %s", d.Id())
 	var out *ecrpublic.DescribeRepositoriesOutput
@@ -217,7 +217,7 @@ func resourceAwsEcrPublicRepositoryRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsEcrPublicRepositoryDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ecrpublicconn
+	conn := meta.(*AWSClient).ecrpublicconn()
 
 	deleteInput := &ecrpublic.DeleteRepositoryInput{
 		RepositoryName: aws.String(d.Id()),
@@ -270,7 +270,7 @@ func resourceAwsEcrPublicRepositoryDelete(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsEcrPublicRepositoryUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ecrpublicconn
+	conn := meta.(*AWSClient).ecrpublicconn()
 
 	if d.HasChange("catalog_data") {
 		if err := resourceAwsEcrPublicRepositoryUpdateCatalogData(conn, d); err != nil {
@@ -28,7 +28,7 @@ func testSweepEcrPublicRepositories(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*AWSClient).ecrpublicconn
+	conn := client.(*AWSClient).ecrpublicconn()
 	sweepResources := make([]*testSweepResource, 0)
 	var errs *multierror.Error
 
@@ -368,7 +368,7 @@ func testAccCheckAWSEcrPublicRepositoryExists(name string, res *ecrpublic.Reposi
 			return fmt.Errorf("No ECR Public repository ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ecrpublicconn
+		conn := testAccProvider.Meta().(*AWSClient).ecrpublicconn()
 
 		output, err := conn.DescribeRepositories(&ecrpublic.DescribeRepositoriesInput{
 			RepositoryNames: aws.StringSlice([]string{rs.Primary.ID}),
@@ -387,7 +387,7 @@ func testAccCheckAWSEcrPublicRepositoryExists(name string, res *ecrpublic.Reposi
 }
 
 func testAccCheckAWSEcrPublicRepositoryDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ecrpublicconn
+	conn := testAccProvider.Meta().(*AWSClient).ecrpublicconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ecrpublic_repository" {
@@ -510,7 +510,7 @@ func testAccPreCheckAwsEcrPublic(t *testing.T) {
 		t.Skipf("skipping acceptance testing: region (%s) does not support ECR Public repositories", region)
 	}
 
-	conn := testAccProvider.Meta().(*AWSClient).ecrpublicconn
+	conn := testAccProvider.Meta().(*AWSClient).ecrpublicconn()
 	input := &ecrpublic.DescribeRepositoriesInput{}
 	_, err := conn.DescribeRepositories(input)
 	if testAccPreCheckSkipError(err) {
@@ -43,7 +43,7 @@ func resourceAwsEcsTag() *schema.Resource {
 }
 
 func resourceAwsEcsTagCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ecsconn
+	conn := meta.(*AWSClient).ecsconn()
 
 	identifier := d.Get("resource_arn").(string)
 	key := d.Get("key").(string)
@@ -59,7 +59,7 @@ func resourceAwsEcsTagCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsEcsTagRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ecsconn
+	conn := meta.(*AWSClient).ecsconn()
 	identifier, key, err := tagresource.GetResourceID(d.Id())
 
 	if err != nil {
@@ -86,7 +86,7 @@ func resourceAwsEcsTagRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsEcsTagUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ecsconn
+	conn := meta.(*AWSClient).ecsconn()
 	identifier, key, err := tagresource.GetResourceID(d.Id())
 
 	if err != nil {
@@ -101,7 +101,7 @@ func resourceAwsEcsTagUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsEcsTagDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ecsconn
+	conn := meta.(*AWSClient).ecsconn()
 	identifier, key, err := tagresource.GetResourceID(d.Id())
 
 	if err != nil {
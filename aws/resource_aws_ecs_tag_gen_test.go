@@ -13,8 +13,19 @@ import (
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
+func init() {
+	resource.AddTestSweepers("aws_ecs_tag", &resource.Sweeper{
+		Name: "aws_ecs_tag",
+		F:    testSweepEcsTags,
+	})
+}
+
+func testSweepEcsTags(region string) error {
+	return testSweepTagResources(region, "ecs")
+}
+
 func testAccCheckEcsTagDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ecsconn
+	conn := testAccProvider.Meta().(*AWSClient).ecsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ecs_tag" {
@@ -60,7 +71,7 @@ func testAccCheckEcsTagExists(resourceName string) resource.TestCheckFunc {
 			return err
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ecsconn
+		conn := testAccProvider.Meta().(*AWSClient).ecsconn()
 
 		_, err = keyvaluetags.EcsGetTag(conn, identifier, key)
 
@@ -53,7 +53,7 @@ func resourceAwsEfsBackupPolicy() *schema.Resource {
 }
 
 func resourceAwsEfsBackupPolicyCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).efsconn
+	conn := meta.(*AWSClient).efsconn()
 
 	fsID := d.Get("file_system_id").(string)
 
@@ -67,7 +67,7 @@ func resourceAwsEfsBackupPolicyCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsEfsBackupPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).efsconn
+	conn := meta.(*AWSClient).efsconn()
 
 	output, err := finder.BackupPolicyByID(conn, d.Id())
 
@@ -91,7 +91,7 @@ func resourceAwsEfsBackupPolicyRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsEfsBackupPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).efsconn
+	conn := meta.(*AWSClient).efsconn()
 
 	if err := efsBackupPolicyPut(conn, d.Id(), d.Get("backup_policy").([]interface{})[0].(map[string]interface{})); err != nil {
 		return err
@@ -101,7 +101,7 @@ func resourceAwsEfsBackupPolicyUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsEfsBackupPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).efsconn
+	conn := meta.(*AWSClient).efsconn()
 
 	err := efsBackupPolicyPut(conn, d.Id(), map[string]interface{}{
 		"status": efs.StatusDisabled,
@@ -45,7 +45,7 @@ func resourceAwsEfsFileSystemPolicy() *schema.Resource {
 }
 
 func resourceAwsEfsFileSystemPolicyPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).efsconn
+	conn := meta.(*AWSClient).efsconn()
 
 	fsID := d.Get("file_system_id").(string)
 	input := &efs.PutFileSystemPolicyInput{
@@ -67,7 +67,7 @@ func resourceAwsEfsFileSystemPolicyPut(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsEfsFileSystemPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).efsconn
+	conn := meta.(*AWSClient).efsconn()
 
 	output, err := finder.FileSystemPolicyByID(conn, d.Id())
 
@@ -88,7 +88,7 @@ func resourceAwsEfsFileSystemPolicyRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsEfsFileSystemPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).efsconn
+	conn := meta.(*AWSClient).efsconn()
 
 	log.Printf("[DEBUG] Deleting EFS File System Policy: %s", d.Id())
 	_, err := conn.DeleteFileSystemPolicy(&efs.DeleteFileSystemPolicyInput{
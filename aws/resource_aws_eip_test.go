@@ -40,7 +40,7 @@ func testSweepEC2Eips(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*AWSClient).ec2conn
+	conn := client.(*AWSClient).ec2conn()
 
 	// There is currently no paginator or Marker/NextToken
 	input := &ec2.DescribeAddressesInput{}
@@ -748,7 +748,7 @@ func TestAccAWSEIP_BYOIPAddress_custom_with_PublicIpv4Pool(t *testing.T) {
 }
 
 func testAccCheckAWSEIPDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_eip" {
@@ -824,10 +824,10 @@ func testAccCheckAWSEIPExists(n string, ec2classic bool, res *ec2.Address) resou
 			return fmt.Errorf("No EIP ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 		if ec2classic {
-			conn = testAccProviderEc2Classic.Meta().(*AWSClient).ec2conn
+			conn = testAccProviderEc2Classic.Meta().(*AWSClient).ec2conn()
 		}
 
 		input := &ec2.DescribeAddressesInput{}
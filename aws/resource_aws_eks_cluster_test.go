@@ -34,7 +34,7 @@ func testSweepEksClusters(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).eksconn
+	conn := client.(*AWSClient).eksconn()
 	input := &eks.ListClustersInput{}
 	sweepResources := make([]*testSweepResource, 0)
 
@@ -613,7 +613,7 @@ func testAccCheckAWSEksClusterExists(resourceName string, cluster *eks.Cluster)
 			return fmt.Errorf("No EKS Cluster ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).eksconn
+		conn := testAccProvider.Meta().(*AWSClient).eksconn()
 
 		output, err := finder.ClusterByName(conn, rs.Primary.ID)
 
@@ -633,7 +633,7 @@ func testAccCheckAWSEksClusterDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).eksconn
+		conn := testAccProvider.Meta().(*AWSClient).eksconn()
 
 		_, err := finder.ClusterByName(conn, rs.Primary.ID)
 
@@ -672,7 +672,7 @@ func testAccCheckAWSEksClusterNotRecreated(i, j *eks.Cluster) resource.TestCheck
 }
 
 func testAccPreCheckAWSEks(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).eksconn
+	conn := testAccProvider.Meta().(*AWSClient).eksconn()
 
 	input := &eks.ListClustersInput{}
 
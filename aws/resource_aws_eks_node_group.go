@@ -285,7 +285,7 @@ func resourceAwsEksNodeGroup() *schema.Resource {
 }
 
 func resourceAwsEksNodeGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).eksconn
+	conn := meta.(*AWSClient).eksconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 
@@ -371,7 +371,7 @@ func resourceAwsEksNodeGroupCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceAwsEksNodeGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).eksconn
+	conn := meta.(*AWSClient).eksconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
@@ -467,7 +467,7 @@ func resourceAwsEksNodeGroupRead(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceAwsEksNodeGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).eksconn
+	conn := meta.(*AWSClient).eksconn()
 
 	clusterName, nodeGroupName, err := tfeks.NodeGroupParseResourceID(d.Id())
 
@@ -577,7 +577,7 @@ func resourceAwsEksNodeGroupUpdate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceAwsEksNodeGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).eksconn
+	conn := meta.(*AWSClient).eksconn()
 
 	clusterName, nodeGroupName, err := tfeks.NodeGroupParseResourceID(d.Id())
 
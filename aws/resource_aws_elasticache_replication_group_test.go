@@ -38,7 +38,7 @@ func testSweepElasticacheReplicationGroups(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*AWSClient).elasticacheconn
+	conn := client.(*AWSClient).elasticacheconn()
 	sweepResources := make([]*testSweepResource, 0)
 	var errs *multierror.Error
 
@@ -1113,7 +1113,7 @@ func TestAccAWSElasticacheReplicationGroup_NumberCacheClusters_Failover_AutoFail
 			{
 				PreConfig: func() {
 					// Ensure that primary is on the node we are trying to delete
-					conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+					conn := testAccProvider.Meta().(*AWSClient).elasticacheconn()
 					timeout := 40 * time.Minute
 
 					if err := resourceAwsElasticacheReplicationGroupSetPrimaryClusterID(conn, rName, formatReplicationGroupClusterID(rName, 3), timeout); err != nil {
@@ -1160,7 +1160,7 @@ func TestAccAWSElasticacheReplicationGroup_NumberCacheClusters_Failover_AutoFail
 			{
 				PreConfig: func() {
 					// Ensure that primary is on the node we are trying to delete
-					conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+					conn := testAccProvider.Meta().(*AWSClient).elasticacheconn()
 					timeout := 40 * time.Minute
 
 					// Must disable automatic failover first
@@ -1218,7 +1218,7 @@ func TestAccAWSElasticacheReplicationGroup_NumberCacheClusters_MultiAZEnabled(t
 			{
 				PreConfig: func() {
 					// Ensure that primary is on the node we are trying to delete
-					conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+					conn := testAccProvider.Meta().(*AWSClient).elasticacheconn()
 					timeout := 40 * time.Minute
 
 					// Must disable automatic failover first
@@ -1271,7 +1271,7 @@ func TestAccAWSElasticacheReplicationGroup_NumberCacheClusters_MemberClusterDisa
 			{
 				PreConfig: func() {
 					// Remove one of the Cache Clusters
-					conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+					conn := testAccProvider.Meta().(*AWSClient).elasticacheconn()
 					timeout := 40 * time.Minute
 
 					cacheClusterID := formatReplicationGroupClusterID(rName, 2)
@@ -1317,7 +1317,7 @@ func TestAccAWSElasticacheReplicationGroup_NumberCacheClusters_MemberClusterDisa
 			{
 				PreConfig: func() {
 					// Remove one of the Cache Clusters
-					conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+					conn := testAccProvider.Meta().(*AWSClient).elasticacheconn()
 					timeout := 40 * time.Minute
 
 					cacheClusterID := formatReplicationGroupClusterID(rName, 2)
@@ -1363,7 +1363,7 @@ func TestAccAWSElasticacheReplicationGroup_NumberCacheClusters_MemberClusterDisa
 			{
 				PreConfig: func() {
 					// Remove one of the Cache Clusters
-					conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+					conn := testAccProvider.Meta().(*AWSClient).elasticacheconn()
 					timeout := 40 * time.Minute
 
 					cacheClusterID := formatReplicationGroupClusterID(rName, 2)
@@ -1409,7 +1409,7 @@ func TestAccAWSElasticacheReplicationGroup_NumberCacheClusters_MemberClusterDisa
 			{
 				PreConfig: func() {
 					// Remove one of the Cache Clusters
-					conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+					conn := testAccProvider.Meta().(*AWSClient).elasticacheconn()
 					timeout := 40 * time.Minute
 
 					cacheClusterID := formatReplicationGroupClusterID(rName, 2)
@@ -1760,7 +1760,7 @@ func testAccCheckAWSElasticacheReplicationGroupExists(n string, v *elasticache.R
 			return fmt.Errorf("No replication group ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+		conn := testAccProvider.Meta().(*AWSClient).elasticacheconn()
 		rg, err := finder.ReplicationGroupByID(conn, rs.Primary.ID)
 		if err != nil {
 			return fmt.Errorf("ElastiCache error: %w", err)
@@ -1773,7 +1773,7 @@ func testAccCheckAWSElasticacheReplicationGroupExists(n string, v *elasticache.R
 }
 
 func testAccCheckAWSElasticacheReplicationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+	conn := testAccProvider.Meta().(*AWSClient).elasticacheconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_elasticache_replication_group" {
@@ -1800,7 +1800,7 @@ func testAccCheckAWSElastiCacheReplicationGroupMemberClusters(n string, v *map[s
 			return err
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+		conn := testAccProvider.Meta().(*AWSClient).elasticacheconn()
 
 		clusters := make(map[string]*elasticache.CacheCluster, len(rg.MemberClusters))
 		for _, clusterID := range rg.MemberClusters {
@@ -75,7 +75,7 @@ func resourceAwsElasticacheUser() *schema.Resource {
 }
 
 func resourceAwsElasticacheUserCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elasticacheconn
+	conn := meta.(*AWSClient).elasticacheconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 
@@ -108,7 +108,7 @@ func resourceAwsElasticacheUserCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsElasticacheUserRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elasticacheconn
+	conn := meta.(*AWSClient).elasticacheconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
@@ -156,7 +156,7 @@ func resourceAwsElasticacheUserRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsElasticacheUserUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elasticacheconn
+	conn := meta.(*AWSClient).elasticacheconn()
 	hasChange := false
 
 	if d.HasChangesExcept("tags", "tags_all") {
@@ -204,7 +204,7 @@ func resourceAwsElasticacheUserUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsElasticacheUserDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elasticacheconn
+	conn := meta.(*AWSClient).elasticacheconn()
 
 	input := &elasticache.DeleteUserInput{
 		UserId: aws.String(d.Id()),
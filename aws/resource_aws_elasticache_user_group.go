@@ -65,7 +65,7 @@ var resourceAwsElasticacheUserGroupPendingStates = []string{
 }
 
 func resourceAwsElasticacheUserGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elasticacheconn
+	conn := meta.(*AWSClient).elasticacheconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 
@@ -110,7 +110,7 @@ func resourceAwsElasticacheUserGroupCreate(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsElasticacheUserGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elasticacheconn
+	conn := meta.(*AWSClient).elasticacheconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
@@ -157,7 +157,7 @@ func resourceAwsElasticacheUserGroupRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsElasticacheUserGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elasticacheconn
+	conn := meta.(*AWSClient).elasticacheconn()
 	hasChange := false
 
 	if d.HasChangesExcept("tags", "tags_all") {
@@ -215,7 +215,7 @@ func resourceAwsElasticacheUserGroupUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsElasticacheUserGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elasticacheconn
+	conn := meta.(*AWSClient).elasticacheconn()
 
 	input := &elasticache.DeleteUserGroupInput{
 		UserGroupId: aws.String(d.Id()),
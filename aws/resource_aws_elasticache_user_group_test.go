@@ -152,7 +152,7 @@ func testAccCheckAWSElasticacheUserGroupDestroy(s *terraform.State) error {
 }
 
 func testAccCheckAWSElasticacheUserGroupDestroyWithProvider(s *terraform.State, provider *schema.Provider) error {
-	conn := provider.Meta().(*AWSClient).elasticacheconn
+	conn := provider.Meta().(*AWSClient).elasticacheconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_elasticache_user_group" {
@@ -188,7 +188,7 @@ func testAccCheckAWSElasticacheUserGroupExistsWithProvider(n string, v *elastica
 		}
 
 		provider := providerF()
-		conn := provider.Meta().(*AWSClient).elasticacheconn
+		conn := provider.Meta().(*AWSClient).elasticacheconn()
 		resp, err := finder.ElastiCacheUserGroupById(conn, rs.Primary.ID)
 		if err != nil {
 			return fmt.Errorf("ElastiCache User Group (%s) not found: %w", rs.Primary.ID, err)
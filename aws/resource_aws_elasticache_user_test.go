@@ -163,7 +163,7 @@ func testAccCheckAWSElasticacheUserDestroy(s *terraform.State) error {
 }
 
 func testAccCheckAWSElasticacheUserDestroyWithProvider(s *terraform.State, provider *schema.Provider) error {
-	conn := provider.Meta().(*AWSClient).elasticacheconn
+	conn := provider.Meta().(*AWSClient).elasticacheconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_elasticache_user" {
@@ -204,7 +204,7 @@ func testAccCheckAWSElasticacheUserExistsWithProvider(n string, v *elasticache.U
 		}
 
 		provider := providerF()
-		conn := provider.Meta().(*AWSClient).elasticacheconn
+		conn := provider.Meta().(*AWSClient).elasticacheconn()
 		resp, err := finder.ElastiCacheUserById(conn, rs.Primary.ID)
 		if err != nil {
 			return fmt.Errorf("ElastiCache User (%s) not found: %w", rs.Primary.ID, err)
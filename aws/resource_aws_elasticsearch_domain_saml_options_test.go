@@ -158,7 +158,7 @@ func TestAccAWSElasticSearchDomainSAMLOptions_Disabled(t *testing.T) {
 }
 
 func testAccCheckESDomainSAMLOptionsDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).esconn
+	conn := testAccProvider.Meta().(*AWSClient).esconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_elasticsearch_domain_saml_options" {
@@ -202,7 +202,7 @@ func testAccCheckESDomainSAMLOptions(esResource string, samlOptionsResource stri
 			return fmt.Errorf("Not found: %s", samlOptionsResource)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).esconn
+		conn := testAccProvider.Meta().(*AWSClient).esconn()
 		_, err := conn.DescribeElasticsearchDomain(&elasticsearch.DescribeElasticsearchDomainInput{
 			DomainName: aws.String(options.Primary.Attributes["domain_name"]),
 		})
@@ -34,7 +34,7 @@ func testAccAwsFmsAdminAccount_basic(t *testing.T) {
 }
 
 func testAccCheckFmsAdminAccountDestroy(s *terraform.State) error {
-	conn := testAccProviderFmsAdmin.Meta().(*AWSClient).fmsconn
+	conn := testAccProviderFmsAdmin.Meta().(*AWSClient).fmsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_fms_admin_account" {
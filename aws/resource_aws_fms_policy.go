@@ -147,7 +147,7 @@ func resourceAwsFmsPolicy() *schema.Resource {
 }
 
 func resourceAwsFmsPolicyCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).fmsconn
+	conn := meta.(*AWSClient).fmsconn()
 
 	fmsPolicy := resourceAwsFmsPolicyExpandPolicy(d)
 
@@ -170,7 +170,7 @@ func resourceAwsFmsPolicyCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsFmsPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).fmsconn
+	conn := meta.(*AWSClient).fmsconn()
 
 	var resp *fms.GetPolicyOutput
 	var req = &fms.GetPolicyInput{
@@ -259,7 +259,7 @@ func resourceAwsFmsPolicyExpandPolicy(d *schema.ResourceData) *fms.Policy {
 }
 
 func resourceAwsFmsPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).fmsconn
+	conn := meta.(*AWSClient).fmsconn()
 
 	fmsPolicy := resourceAwsFmsPolicyExpandPolicy(d)
 
@@ -274,7 +274,7 @@ func resourceAwsFmsPolicyUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsFmsPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).fmsconn
+	conn := meta.(*AWSClient).fmsconn()
 	log.Printf("[DEBUG] Delete FMS Policy: %s", d.Id())
 
 	_, err := conn.DeletePolicy(&fms.DeletePolicyInput{
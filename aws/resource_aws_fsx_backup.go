@@ -65,7 +65,7 @@ func resourceAwsFsxBackup() *schema.Resource {
 }
 
 func resourceAwsFsxBackupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).fsxconn
+	conn := meta.(*AWSClient).fsxconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 
@@ -94,7 +94,7 @@ func resourceAwsFsxBackupCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsFsxBackupUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).fsxconn
+	conn := meta.(*AWSClient).fsxconn()
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
@@ -108,7 +108,7 @@ func resourceAwsFsxBackupUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsFsxBackupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).fsxconn
+	conn := meta.(*AWSClient).fsxconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
@@ -148,7 +148,7 @@ func resourceAwsFsxBackupRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsFsxBackupDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).fsxconn
+	conn := meta.(*AWSClient).fsxconn()
 
 	request := &fsx.DeleteBackupInput{
 		BackupId: aws.String(d.Id()),
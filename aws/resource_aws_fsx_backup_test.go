@@ -29,7 +29,7 @@ func testSweepFSXBackups(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*AWSClient).fsxconn
+	conn := client.(*AWSClient).fsxconn()
 	sweepResources := make([]*testSweepResource, 0)
 	var errs *multierror.Error
 	input := &fsx.DescribeBackupsInput{}
@@ -216,7 +216,7 @@ func testAccCheckFsxBackupExists(resourceName string, fs *fsx.Backup) resource.T
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).fsxconn
+		conn := testAccProvider.Meta().(*AWSClient).fsxconn()
 
 		output, err := finder.BackupByID(conn, rs.Primary.ID)
 		if err != nil {
@@ -234,7 +234,7 @@ func testAccCheckFsxBackupExists(resourceName string, fs *fsx.Backup) resource.T
 }
 
 func testAccCheckFsxBackupDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).fsxconn
+	conn := testAccProvider.Meta().(*AWSClient).fsxconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_fsx_backup" {
@@ -158,7 +158,7 @@ func resourceAwsGlueClassifier() *schema.Resource {
 }
 
 func resourceAwsGlueClassifierCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).glueconn
+	conn := meta.(*AWSClient).glueconn()
 	name := d.Get("name").(string)
 
 	input := &glue.CreateClassifierInput{}
@@ -195,7 +195,7 @@ func resourceAwsGlueClassifierCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsGlueClassifierRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).glueconn
+	conn := meta.(*AWSClient).glueconn()
 
 	input := &glue.GetClassifierInput{
 		Name: aws.String(d.Id()),
@@ -241,7 +241,7 @@ func resourceAwsGlueClassifierRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsGlueClassifierUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).glueconn
+	conn := meta.(*AWSClient).glueconn()
 
 	input := &glue.UpdateClassifierInput{}
 
@@ -275,7 +275,7 @@ func resourceAwsGlueClassifierUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsGlueClassifierDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).glueconn
+	conn := meta.(*AWSClient).glueconn()
 
 	log.Printf("[DEBUG] Deleting Glue Classifier: %s", d.Id())
 	err := deleteGlueClassifier(conn, d.Id())
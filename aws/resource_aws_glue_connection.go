@@ -94,7 +94,7 @@ func resourceAwsGlueConnection() *schema.Resource {
 }
 
 func resourceAwsGlueConnectionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).glueconn
+	conn := meta.(*AWSClient).glueconn()
 	var catalogID string
 	if v, ok := d.GetOkExists("catalog_id"); ok {
 		catalogID = v.(string)
@@ -120,7 +120,7 @@ func resourceAwsGlueConnectionCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsGlueConnectionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).glueconn
+	conn := meta.(*AWSClient).glueconn()
 
 	catalogID, connectionName, err := decodeGlueConnectionID(d.Id())
 	if err != nil {
@@ -177,7 +177,7 @@ func resourceAwsGlueConnectionRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsGlueConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).glueconn
+	conn := meta.(*AWSClient).glueconn()
 
 	catalogID, connectionName, err := decodeGlueConnectionID(d.Id())
 	if err != nil {
@@ -200,7 +200,7 @@ func resourceAwsGlueConnectionUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsGlueConnectionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).glueconn
+	conn := meta.(*AWSClient).glueconn()
 
 	catalogID, connectionName, err := decodeGlueConnectionID(d.Id())
 	if err != nil {
@@ -38,7 +38,7 @@ func testAccAwsGuardDutyOrganizationAdminAccount_basic(t *testing.T) {
 }
 
 func testAccCheckAwsGuardDutyOrganizationAdminAccountDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).guarddutyconn
+	conn := testAccProvider.Meta().(*AWSClient).guarddutyconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_guardduty_organization_admin_account" {
@@ -72,7 +72,7 @@ func testAccCheckAwsGuardDutyOrganizationAdminAccountExists(resourceName string)
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).guarddutyconn
+		conn := testAccProvider.Meta().(*AWSClient).guarddutyconn()
 
 		adminAccount, err := getGuardDutyOrganizationAdminAccount(conn, rs.Primary.ID)
 
@@ -52,7 +52,7 @@ func resourceAwsIamGroup() *schema.Resource {
 }
 
 func resourceAwsIamGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).iamconn()
 	name := d.Get("name").(string)
 	path := d.Get("path").(string)
 
@@ -71,7 +71,7 @@ func resourceAwsIamGroupCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsIamGroupRead(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).iamconn()
 
 	request := &iam.GetGroupInput{
 		GroupName: aws.String(d.Id()),
@@ -132,7 +132,7 @@ func resourceAwsIamGroupRead(d *schema.ResourceData, meta interface{}) error {
 
 func resourceAwsIamGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChanges("name", "path") {
-		iamconn := meta.(*AWSClient).iamconn
+		iamconn := meta.(*AWSClient).iamconn()
 		on, nn := d.GetChange("name")
 		_, np := d.GetChange("path")
 
@@ -152,7 +152,7 @@ func resourceAwsIamGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsIamGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).iamconn()
 
 	request := &iam.DeleteGroupInput{
 		GroupName: aws.String(d.Id()),
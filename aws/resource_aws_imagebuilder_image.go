@@ -147,7 +147,7 @@ func resourceAwsImageBuilderImage() *schema.Resource {
 }
 
 func resourceAwsImageBuilderImageCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).imagebuilderconn
+	conn := meta.(*AWSClient).imagebuilderconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 
@@ -196,7 +196,7 @@ func resourceAwsImageBuilderImageCreate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsImageBuilderImageRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).imagebuilderconn
+	conn := meta.(*AWSClient).imagebuilderconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
@@ -272,7 +272,7 @@ func resourceAwsImageBuilderImageRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsImageBuilderImageUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).imagebuilderconn
+	conn := meta.(*AWSClient).imagebuilderconn()
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
@@ -286,7 +286,7 @@ func resourceAwsImageBuilderImageUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsImageBuilderImageDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).imagebuilderconn
+	conn := meta.(*AWSClient).imagebuilderconn()
 
 	input := &imagebuilder.DeleteImageInput{
 		ImageBuildVersionArn: aws.String(d.Id()),
@@ -77,7 +77,7 @@ func resourceAwsIoTAuthorizer() *schema.Resource {
 }
 
 func resourceAwsIotAuthorizerCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).iotconn()
 
 	name := d.Get("name").(string)
 	input := &iot.CreateAuthorizerInput{
@@ -108,7 +108,7 @@ func resourceAwsIotAuthorizerCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsIotAuthorizerRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).iotconn()
 
 	authorizer, err := finder.AuthorizerByName(conn, d.Id())
 
@@ -134,7 +134,7 @@ func resourceAwsIotAuthorizerRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsIotAuthorizerUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).iotconn()
 
 	input := iot.UpdateAuthorizerInput{
 		AuthorizerName: aws.String(d.Id()),
@@ -167,7 +167,7 @@ func resourceAwsIotAuthorizerUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsIotAuthorizerDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).iotconn()
 
 	// In order to delete an IoT Authorizer, you must set it inactive first.
 	if d.Get("status").(string) == iot.AuthorizerStatusActive {
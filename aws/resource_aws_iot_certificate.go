@@ -49,7 +49,7 @@ func resourceAwsIotCertificate() *schema.Resource {
 }
 
 func resourceAwsIotCertificateCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).iotconn()
 
 	if _, ok := d.GetOk("csr"); ok {
 		log.Printf("[DEBUG] Creating certificate from CSR")
@@ -82,7 +82,7 @@ func resourceAwsIotCertificateCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsIotCertificateRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).iotconn()
 
 	out, err := conn.DescribeCertificate(&iot.DescribeCertificateInput{
 		CertificateId: aws.String(d.Id()),
@@ -99,7 +99,7 @@ func resourceAwsIotCertificateRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsIotCertificateUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).iotconn()
 
 	if d.HasChange("active") {
 		status := iot.CertificateStatusInactive
@@ -120,7 +120,7 @@ func resourceAwsIotCertificateUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsIotCertificateDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iotconn
+	conn := meta.(*AWSClient).iotconn()
 
 	_, err := conn.UpdateCertificate(&iot.UpdateCertificateInput{
 		CertificateId: aws.String(d.Id()),
@@ -29,7 +29,7 @@ func testSweepKinesisFirehoseDeliveryStreams(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).firehoseconn
+	conn := client.(*AWSClient).firehoseconn()
 	input := &firehose.ListDeliveryStreamsInput{}
 	sweepResources := make([]*testSweepResource, 0)
 
@@ -558,7 +558,7 @@ func TestAccAWSKinesisFirehoseDeliveryStream_ExtendedS3_ExternalUpdate(t *testin
 			},
 			{
 				PreConfig: func() {
-					conn := testAccProvider.Meta().(*AWSClient).firehoseconn
+					conn := testAccProvider.Meta().(*AWSClient).firehoseconn()
 					udi := firehose.UpdateDestinationInput{
 						DeliveryStreamName:             aws.String(rName),
 						DestinationId:                  aws.String("destinationId-000000000001"),
@@ -1483,7 +1483,7 @@ func testAccCheckKinesisFirehoseDeliveryStreamExists(n string, v *firehose.Deliv
 			return fmt.Errorf("No Kinesis Firehose Delivery Stream ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).firehoseconn
+		conn := testAccProvider.Meta().(*AWSClient).firehoseconn()
 
 		sn := rs.Primary.Attributes["name"]
 		output, err := finder.DeliveryStreamByName(conn, sn)
@@ -1687,7 +1687,7 @@ func testAccCheckKinesisFirehoseDeliveryStreamDestroy(s *terraform.State) error
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).firehoseconn
+		conn := testAccProvider.Meta().(*AWSClient).firehoseconn()
 
 		sn := rs.Primary.Attributes["name"]
 		_, err := finder.DeliveryStreamByName(conn, sn)
@@ -1707,7 +1707,7 @@ func testAccCheckKinesisFirehoseDeliveryStreamDestroy(s *terraform.State) error
 }
 
 func testAccCheckFirehoseLambdaFunctionDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).lambdaconn
+	conn := testAccProvider.Meta().(*AWSClient).lambdaconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_lambda_function" {
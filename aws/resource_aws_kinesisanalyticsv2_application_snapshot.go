@@ -62,7 +62,7 @@ func resourceAwsKinesisAnalyticsV2ApplicationSnapshot() *schema.Resource {
 }
 
 func resourceAwsKinesisAnalyticsV2ApplicationSnapshotCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kinesisanalyticsv2conn
+	conn := meta.(*AWSClient).kinesisanalyticsv2conn()
 	applicationName := d.Get("application_name").(string)
 	snapshotName := d.Get("snapshot_name").(string)
 
@@ -91,7 +91,7 @@ func resourceAwsKinesisAnalyticsV2ApplicationSnapshotCreate(d *schema.ResourceDa
 }
 
 func resourceAwsKinesisAnalyticsV2ApplicationSnapshotRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kinesisanalyticsv2conn
+	conn := meta.(*AWSClient).kinesisanalyticsv2conn()
 
 	applicationName, snapshotName, err := tfkinesisanalyticsv2.ApplicationSnapshotParseID(d.Id())
 
@@ -120,7 +120,7 @@ func resourceAwsKinesisAnalyticsV2ApplicationSnapshotRead(d *schema.ResourceData
 }
 
 func resourceAwsKinesisAnalyticsV2ApplicationSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kinesisanalyticsv2conn
+	conn := meta.(*AWSClient).kinesisanalyticsv2conn()
 
 	applicationName, snapshotName, err := tfkinesisanalyticsv2.ApplicationSnapshotParseID(d.Id())
 
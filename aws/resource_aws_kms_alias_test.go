@@ -229,7 +229,7 @@ func TestAccAWSKmsAlias_ArnDiffSuppress(t *testing.T) {
 }
 
 func testAccCheckAWSKmsAliasDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).kmsconn
+	conn := testAccProvider.Meta().(*AWSClient).kmsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_kms_alias" {
@@ -263,7 +263,7 @@ func testAccCheckAWSKmsAliasExists(name string, v *kms.AliasListEntry) resource.
 			return fmt.Errorf("No KMS Alias ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).kmsconn
+		conn := testAccProvider.Meta().(*AWSClient).kmsconn()
 
 		output, err := finder.AliasByName(conn, rs.Primary.ID)
 
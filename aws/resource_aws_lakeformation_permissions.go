@@ -266,7 +266,7 @@ func resourceAwsLakeFormationPermissions() *schema.Resource {
 // returns.
 
 func resourceAwsLakeFormationPermissionsCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lakeformationconn
+	conn := meta.(*AWSClient).lakeformationconn()
 
 	input := &lakeformation.GrantPermissionsInput{
 		Permissions: expandStringList(d.Get("permissions").([]interface{})),
@@ -348,7 +348,7 @@ func resourceAwsLakeFormationPermissionsCreate(d *schema.ResourceData, meta inte
 }
 
 func resourceAwsLakeFormationPermissionsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lakeformationconn
+	conn := meta.(*AWSClient).lakeformationconn()
 
 	input := &lakeformation.ListPermissionsInput{
 		Principal: &lakeformation.DataLakePrincipal{
@@ -533,7 +533,7 @@ func resourceAwsLakeFormationPermissionsRead(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsLakeFormationPermissionsDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lakeformationconn
+	conn := meta.(*AWSClient).lakeformationconn()
 
 	input := &lakeformation.RevokePermissionsInput{
 		Permissions:                expandStringList(d.Get("permissions").([]interface{})),
@@ -76,7 +76,7 @@ func resourceAwsLambdaAlias() *schema.Resource {
 // resourceAwsLambdaAliasCreate maps to:
 // CreateAlias in the API / SDK
 func resourceAwsLambdaAliasCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lambdaconn
+	conn := meta.(*AWSClient).lambdaconn()
 
 	functionName := d.Get("function_name").(string)
 	aliasName := d.Get("name").(string)
@@ -104,7 +104,7 @@ func resourceAwsLambdaAliasCreate(d *schema.ResourceData, meta interface{}) erro
 // resourceAwsLambdaAliasRead maps to:
 // GetAlias in the API / SDK
 func resourceAwsLambdaAliasRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lambdaconn
+	conn := meta.(*AWSClient).lambdaconn()
 
 	log.Printf("[DEBUG] Fetching Lambda alias: %s:%s", d.Get("function_name"), d.Get("name"))
 
@@ -143,7 +143,7 @@ func resourceAwsLambdaAliasRead(d *schema.ResourceData, meta interface{}) error
 // resourceAwsLambdaAliasDelete maps to:
 // DeleteAlias in the API / SDK
 func resourceAwsLambdaAliasDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lambdaconn
+	conn := meta.(*AWSClient).lambdaconn()
 
 	log.Printf("[INFO] Deleting Lambda alias: %s:%s", d.Get("function_name"), d.Get("name"))
 
@@ -163,7 +163,7 @@ func resourceAwsLambdaAliasDelete(d *schema.ResourceData, meta interface{}) erro
 // resourceAwsLambdaAliasUpdate maps to:
 // UpdateAlias in the API / SDK
 func resourceAwsLambdaAliasUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lambdaconn
+	conn := meta.(*AWSClient).lambdaconn()
 
 	log.Printf("[DEBUG] Updating Lambda alias: %s:%s", d.Get("function_name"), d.Get("name"))
 
@@ -350,8 +350,8 @@ func resourceAwsLaunchConfiguration() *schema.Resource {
 }
 
 func resourceAwsLaunchConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
-	autoscalingconn := meta.(*AWSClient).autoscalingconn
-	ec2conn := meta.(*AWSClient).ec2conn
+	autoscalingconn := meta.(*AWSClient).autoscalingconn()
+	ec2conn := meta.(*AWSClient).ec2conn()
 
 	lcName := naming.Generate(d.Get("name").(string), d.Get("name_prefix").(string))
 
@@ -559,8 +559,8 @@ func resourceAwsLaunchConfigurationCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsLaunchConfigurationRead(d *schema.ResourceData, meta interface{}) error {
-	autoscalingconn := meta.(*AWSClient).autoscalingconn
-	ec2conn := meta.(*AWSClient).ec2conn
+	autoscalingconn := meta.(*AWSClient).autoscalingconn()
+	ec2conn := meta.(*AWSClient).ec2conn()
 
 	describeOpts := autoscaling.DescribeLaunchConfigurationsInput{
 		LaunchConfigurationNames: []*string{aws.String(d.Id())},
@@ -628,7 +628,7 @@ func resourceAwsLaunchConfigurationRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsLaunchConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
-	autoscalingconn := meta.(*AWSClient).autoscalingconn
+	autoscalingconn := meta.(*AWSClient).autoscalingconn()
 	input := &autoscaling.DeleteLaunchConfigurationInput{
 		LaunchConfigurationName: aws.String(d.Id()),
 	}
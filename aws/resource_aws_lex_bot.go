@@ -218,7 +218,7 @@ var validateLexBotVersion = validation.All(
 )
 
 func resourceAwsLexBotCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lexmodelconn
+	conn := meta.(*AWSClient).lexmodelconn()
 
 	name := d.Get("name").(string)
 	input := &lexmodelbuildingservice.PutBotInput{
@@ -274,7 +274,7 @@ func resourceAwsLexBotCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsLexBotRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lexmodelconn
+	conn := meta.(*AWSClient).lexmodelconn()
 
 	output, err := finder.BotVersionByName(conn, d.Id(), tflex.LexBotVersionLatest)
 
@@ -344,7 +344,7 @@ func resourceAwsLexBotRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsLexBotUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lexmodelconn
+	conn := meta.(*AWSClient).lexmodelconn()
 
 	input := &lexmodelbuildingservice.PutBotInput{
 		Checksum:                     aws.String(d.Get("checksum").(string)),
@@ -389,7 +389,7 @@ func resourceAwsLexBotUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsLexBotDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lexmodelconn
+	conn := meta.(*AWSClient).lexmodelconn()
 
 	input := &lexmodelbuildingservice.DeleteBotInput{
 		Name: aws.String(d.Id()),
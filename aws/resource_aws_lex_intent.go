@@ -282,7 +282,7 @@ func hasIntentConfigChanges(d resourceDiffer) bool {
 }
 
 func resourceAwsLexIntentCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lexmodelconn
+	conn := meta.(*AWSClient).lexmodelconn()
 	name := d.Get("name").(string)
 
 	input := &lexmodelbuildingservice.PutIntentInput{
@@ -355,7 +355,7 @@ func resourceAwsLexIntentCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsLexIntentRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lexmodelconn
+	conn := meta.(*AWSClient).lexmodelconn()
 
 	resp, err := conn.GetIntent(&lexmodelbuildingservice.GetIntentInput{
 		Name:    aws.String(d.Id()),
@@ -433,7 +433,7 @@ func resourceAwsLexIntentRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsLexIntentUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lexmodelconn
+	conn := meta.(*AWSClient).lexmodelconn()
 
 	input := &lexmodelbuildingservice.PutIntentInput{
 		Checksum:      aws.String(d.Get("checksum").(string)),
@@ -503,7 +503,7 @@ func resourceAwsLexIntentUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsLexIntentDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lexmodelconn
+	conn := meta.(*AWSClient).lexmodelconn()
 
 	input := &lexmodelbuildingservice.DeleteIntentInput{
 		Name: aws.String(d.Id()),
@@ -38,7 +38,7 @@ func TestAccAWSLicenseManagerAssociation_basic(t *testing.T) {
 
 func testAccCheckLicenseManagerAssociationExists(resourceName string, licenseSpecification *licensemanager.LicenseSpecification) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).licensemanagerconn
+		conn := testAccProvider.Meta().(*AWSClient).licensemanagerconn()
 
 		rs, ok := s.RootModule().Resources[resourceName]
 		if !ok {
@@ -69,7 +69,7 @@ func testAccCheckLicenseManagerAssociationExists(resourceName string, licenseSpe
 }
 
 func testAccCheckLicenseManagerAssociationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).licensemanagerconn
+	conn := testAccProvider.Meta().(*AWSClient).licensemanagerconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_licensemanager_association" {
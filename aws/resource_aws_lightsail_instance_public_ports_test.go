@@ -117,7 +117,7 @@ func testAccCheckAWSLightsailInstancePublicPortsExists(resourceName string) reso
 			return fmt.Errorf("resource not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+		conn := testAccProvider.Meta().(*AWSClient).lightsailconn()
 
 		input := &lightsail.GetInstancePortStatesInput{
 			InstanceName: aws.String(rs.Primary.Attributes["instance_name"]),
@@ -134,7 +134,7 @@ func testAccCheckAWSLightsailInstancePublicPortsExists(resourceName string) reso
 }
 
 func testAccCheckAWSLightsailInstancePublicPortsDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+	conn := testAccProvider.Meta().(*AWSClient).lightsailconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_lightsail_instance_public_ports" {
@@ -55,7 +55,7 @@ func resourceAwsMacie2Account() *schema.Resource {
 }
 
 func resourceMacie2AccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).macie2conn
+	conn := meta.(*AWSClient).macie2conn()
 
 	input := &macie2.EnableMacieInput{
 		ClientToken: aws.String(resource.UniqueId()),
@@ -95,7 +95,7 @@ func resourceMacie2AccountCreate(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceMacie2AccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).macie2conn
+	conn := meta.(*AWSClient).macie2conn()
 
 	input := &macie2.GetMacieSessionInput{}
 
@@ -122,7 +122,7 @@ func resourceMacie2AccountRead(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceMacie2AccountUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).macie2conn
+	conn := meta.(*AWSClient).macie2conn()
 
 	input := &macie2.UpdateMacieSessionInput{}
 
@@ -143,7 +143,7 @@ func resourceMacie2AccountUpdate(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceMacie2AccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).macie2conn
+	conn := meta.(*AWSClient).macie2conn()
 
 	input := &macie2.DisableMacieInput{}
 
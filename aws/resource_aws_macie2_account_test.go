@@ -186,7 +186,7 @@ func testAccAwsMacie2Account_disappears(t *testing.T) {
 }
 
 func testAccCheckAwsMacie2AccountDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).macie2conn
+	conn := testAccProvider.Meta().(*AWSClient).macie2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_macie2_account" {
@@ -220,7 +220,7 @@ func testAccCheckAwsMacie2AccountExists(resourceName string, macie2Session *maci
 			return fmt.Errorf("not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).macie2conn
+		conn := testAccProvider.Meta().(*AWSClient).macie2conn()
 		input := &macie2.GetMacieSessionInput{}
 
 		resp, err := conn.GetMacieSession(input)
@@ -275,7 +275,7 @@ func testAccCheckAwsMacie2ClassificationJobExists(resourceName string, macie2Ses
 			return fmt.Errorf("not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).macie2conn
+		conn := testAccProvider.Meta().(*AWSClient).macie2conn()
 		input := &macie2.DescribeClassificationJobInput{JobId: aws.String(rs.Primary.ID)}
 
 		resp, err := conn.DescribeClassificationJob(input)
@@ -295,7 +295,7 @@ func testAccCheckAwsMacie2ClassificationJobExists(resourceName string, macie2Ses
 }
 
 func testAccCheckAwsMacie2ClassificationJobDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).macie2conn
+	conn := testAccProvider.Meta().(*AWSClient).macie2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_macie2_classification_job" {
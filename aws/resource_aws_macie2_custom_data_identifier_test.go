@@ -216,7 +216,7 @@ func testAccCheckAwsMacie2CustomDataIdentifierExists(resourceName string, macie2
 			return fmt.Errorf("not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).macie2conn
+		conn := testAccProvider.Meta().(*AWSClient).macie2conn()
 		input := &macie2.GetCustomDataIdentifierInput{Id: aws.String(rs.Primary.ID)}
 
 		resp, err := conn.GetCustomDataIdentifier(input)
@@ -236,7 +236,7 @@ func testAccCheckAwsMacie2CustomDataIdentifierExists(resourceName string, macie2
 }
 
 func testAccCheckAwsMacie2CustomDataIdentifierDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).macie2conn
+	conn := testAccProvider.Meta().(*AWSClient).macie2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_macie2_custom_data_identifier" {
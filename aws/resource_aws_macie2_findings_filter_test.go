@@ -395,7 +395,7 @@ func testAccCheckAwsMacie2FindingsFilterExists(resourceName string, macie2Sessio
 			return fmt.Errorf("not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).macie2conn
+		conn := testAccProvider.Meta().(*AWSClient).macie2conn()
 		input := &macie2.GetFindingsFilterInput{Id: aws.String(rs.Primary.ID)}
 
 		resp, err := conn.GetFindingsFilter(input)
@@ -415,7 +415,7 @@ func testAccCheckAwsMacie2FindingsFilterExists(resourceName string, macie2Sessio
 }
 
 func testAccCheckAwsMacie2FindingsFilterDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).macie2conn
+	conn := testAccProvider.Meta().(*AWSClient).macie2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_macie2_findings_filter" {
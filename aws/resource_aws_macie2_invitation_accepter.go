@@ -41,7 +41,7 @@ func resourceAwsMacie2InvitationAccepter() *schema.Resource {
 }
 
 func resourceMacie2InvitationAccepterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).macie2conn
+	conn := meta.(*AWSClient).macie2conn()
 
 	adminAccountID := d.Get("administrator_account_id").(string)
 	var invitationID string
@@ -102,7 +102,7 @@ func resourceMacie2InvitationAccepterCreate(ctx context.Context, d *schema.Resou
 }
 
 func resourceMacie2InvitationAccepterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).macie2conn
+	conn := meta.(*AWSClient).macie2conn()
 
 	var err error
 
@@ -131,7 +131,7 @@ func resourceMacie2InvitationAccepterRead(ctx context.Context, d *schema.Resourc
 }
 
 func resourceMacie2InvitationAccepterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).macie2conn
+	conn := meta.(*AWSClient).macie2conn()
 
 	input := &macie2.DisassociateFromAdministratorAccountInput{}
 
@@ -297,7 +297,7 @@ func testAccCheckAwsMacie2MemberExists(resourceName string, macie2Session *macie
 			return fmt.Errorf("not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).macie2conn
+		conn := testAccProvider.Meta().(*AWSClient).macie2conn()
 		input := &macie2.GetMemberInput{Id: aws.String(rs.Primary.ID)}
 
 		resp, err := conn.GetMember(input)
@@ -317,7 +317,7 @@ func testAccCheckAwsMacie2MemberExists(resourceName string, macie2Session *macie
 }
 
 func testAccCheckAwsMacie2MemberDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).macie2conn
+	conn := testAccProvider.Meta().(*AWSClient).macie2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_macie2_member" {
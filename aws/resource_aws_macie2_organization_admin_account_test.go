@@ -75,7 +75,7 @@ func testAccCheckAwsMacie2OrganizationAdminAccountExists(resourceName string) re
 			return fmt.Errorf("not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).macie2conn
+		conn := testAccProvider.Meta().(*AWSClient).macie2conn()
 
 		adminAccount, err := getMacie2OrganizationAdminAccount(conn, rs.Primary.ID)
 
@@ -92,7 +92,7 @@ func testAccCheckAwsMacie2OrganizationAdminAccountExists(resourceName string) re
 }
 
 func testAccCheckAwsMacie2OrganizationAdminAccountDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).macie2conn
+	conn := testAccProvider.Meta().(*AWSClient).macie2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_macie2_organization_admin_account" {
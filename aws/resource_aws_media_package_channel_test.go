@@ -121,7 +121,7 @@ func TestAccAWSMediaPackageChannel_tags(t *testing.T) {
 }
 
 func testAccCheckAwsMediaPackageChannelDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).mediapackageconn
+	conn := testAccProvider.Meta().(*AWSClient).mediapackageconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_media_package_channel" {
@@ -152,7 +152,7 @@ func testAccCheckAwsMediaPackageChannelExists(name string) resource.TestCheckFun
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).mediapackageconn
+		conn := testAccProvider.Meta().(*AWSClient).mediapackageconn()
 
 		input := &mediapackage.DescribeChannelInput{
 			Id: aws.String(rs.Primary.ID),
@@ -165,7 +165,7 @@ func testAccCheckAwsMediaPackageChannelExists(name string) resource.TestCheckFun
 }
 
 func testAccPreCheckAWSMediaPackage(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).mediapackageconn
+	conn := testAccProvider.Meta().(*AWSClient).mediapackageconn()
 
 	input := &mediapackage.ListChannelsInput{}
 
@@ -56,7 +56,7 @@ func resourceAwsMskConfiguration() *schema.Resource {
 }
 
 func resourceAwsMskConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kafkaconn
+	conn := meta.(*AWSClient).kafkaconn()
 
 	input := &kafka.CreateConfigurationInput{
 		Name:             aws.String(d.Get("name").(string)),
@@ -83,7 +83,7 @@ func resourceAwsMskConfigurationCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsMskConfigurationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kafkaconn
+	conn := meta.(*AWSClient).kafkaconn()
 
 	configurationInput := &kafka.DescribeConfigurationInput{
 		Arn: aws.String(d.Id()),
@@ -140,7 +140,7 @@ func resourceAwsMskConfigurationRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsMskConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kafkaconn
+	conn := meta.(*AWSClient).kafkaconn()
 
 	input := &kafka.UpdateConfigurationInput{
 		Arn:              aws.String(d.Id()),
@@ -161,7 +161,7 @@ func resourceAwsMskConfigurationUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsMskConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kafkaconn
+	conn := meta.(*AWSClient).kafkaconn()
 
 	input := &kafka.DeleteConfigurationInput{
 		Arn: aws.String(d.Id()),
@@ -234,7 +234,7 @@ func resourceAwsMwaaEnvironment() *schema.Resource {
 }
 
 func resourceAwsMwaaEnvironmentCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).mwaaconn
+	conn := meta.(*AWSClient).mwaaconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 
@@ -318,7 +318,7 @@ func resourceAwsMwaaEnvironmentCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsMwaaEnvironmentRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).mwaaconn
+	conn := meta.(*AWSClient).mwaaconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
@@ -386,7 +386,7 @@ func resourceAwsMwaaEnvironmentRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsMwaaEnvironmentUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).mwaaconn
+	conn := meta.(*AWSClient).mwaaconn()
 
 	input := mwaa.UpdateEnvironmentInput{
 		Name: aws.String(d.Get("name").(string)),
@@ -486,7 +486,7 @@ func resourceAwsMwaaEnvironmentUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsMwaaEnvironmentDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).mwaaconn
+	conn := meta.(*AWSClient).mwaaconn()
 
 	log.Printf("[INFO] Deleting MWAA Environment: %s", d.Id())
 	_, err := conn.DeleteEnvironment(&mwaa.DeleteEnvironmentInput{
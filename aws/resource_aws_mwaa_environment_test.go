@@ -24,7 +24,7 @@ func testSweepMwaaEnvironment(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).mwaaconn
+	conn := client.(*AWSClient).mwaaconn()
 
 	listOutput, err := conn.ListEnvironments(&mwaa.ListEnvironmentsInput{})
 	if err != nil {
@@ -391,7 +391,7 @@ func testAccCheckAWSMwaaEnvironmentExists(resourceName string, environment *mwaa
 			return fmt.Errorf("No MWAA Environment ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).mwaaconn
+		conn := testAccProvider.Meta().(*AWSClient).mwaaconn()
 		resp, err := conn.GetEnvironment(&mwaa.GetEnvironmentInput{
 			Name: aws.String(rs.Primary.ID),
 		})
@@ -407,7 +407,7 @@ func testAccCheckAWSMwaaEnvironmentExists(resourceName string, environment *mwaa
 }
 
 func testAccCheckAWSMwaaEnvironmentDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).mwaaconn
+	conn := testAccProvider.Meta().(*AWSClient).mwaaconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_mwaa_environment" {
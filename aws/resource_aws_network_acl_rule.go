@@ -125,7 +125,7 @@ func resourceAwsNetworkAclRule() *schema.Resource {
 }
 
 func resourceAwsNetworkAclRuleCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 	egress := d.Get("egress").(bool)
 	networkAclID := d.Get("network_acl_id").(string)
 	ruleNumber := d.Get("rule_number").(int)
@@ -203,7 +203,7 @@ func resourceAwsNetworkAclRuleCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsNetworkAclRuleRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 	egress := d.Get("egress").(bool)
 	networkAclID := d.Get("network_acl_id").(string)
 	ruleNumber := d.Get("rule_number").(int)
@@ -287,7 +287,7 @@ func resourceAwsNetworkAclRuleRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsNetworkAclRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	params := &ec2.DeleteNetworkAclEntryInput{
 		NetworkAclId: aws.String(d.Get("network_acl_id").(string)),
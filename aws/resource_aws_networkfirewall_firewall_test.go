@@ -31,7 +31,7 @@ func testSweepNetworkFirewallFirewalls(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).networkfirewallconn
+	conn := client.(*AWSClient).networkfirewallconn()
 	ctx := context.TODO()
 	input := &networkfirewall.ListFirewallsInput{MaxResults: aws.Int64(100)}
 	var sweeperErrs *multierror.Error
@@ -377,7 +377,7 @@ func testAccCheckAwsNetworkFirewallFirewallDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).networkfirewallconn
+		conn := testAccProvider.Meta().(*AWSClient).networkfirewallconn()
 		output, err := finder.Firewall(context.Background(), conn, rs.Primary.ID)
 		if tfawserr.ErrCodeEquals(err, networkfirewall.ErrCodeResourceNotFoundException) {
 			continue
@@ -404,7 +404,7 @@ func testAccCheckAwsNetworkFirewallFirewallExists(n string) resource.TestCheckFu
 			return fmt.Errorf("No NetworkFirewall Firewall ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).networkfirewallconn
+		conn := testAccProvider.Meta().(*AWSClient).networkfirewallconn()
 		output, err := finder.Firewall(context.Background(), conn, rs.Primary.ID)
 		if err != nil {
 			return err
@@ -419,7 +419,7 @@ func testAccCheckAwsNetworkFirewallFirewallExists(n string) resource.TestCheckFu
 }
 
 func testAccPreCheckAwsNetworkFirewall(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).networkfirewallconn
+	conn := testAccProvider.Meta().(*AWSClient).networkfirewallconn()
 
 	input := &networkfirewall.ListFirewallsInput{}
 
@@ -250,7 +250,7 @@ func resourceAwsOpsworksApplicationValidate(d *schema.ResourceData) error {
 }
 
 func resourceAwsOpsworksApplicationRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).opsworksconn
+	client := meta.(*AWSClient).opsworksconn()
 
 	req := &opsworks.DescribeAppsInput{
 		AppIds: []*string{
@@ -295,7 +295,7 @@ func resourceAwsOpsworksApplicationRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsOpsworksApplicationCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).opsworksconn
+	client := meta.(*AWSClient).opsworksconn()
 
 	err := resourceAwsOpsworksApplicationValidate(d)
 	if err != nil {
@@ -329,7 +329,7 @@ func resourceAwsOpsworksApplicationCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsOpsworksApplicationUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).opsworksconn
+	client := meta.(*AWSClient).opsworksconn()
 
 	err := resourceAwsOpsworksApplicationValidate(d)
 	if err != nil {
@@ -361,7 +361,7 @@ func resourceAwsOpsworksApplicationUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsOpsworksApplicationDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).opsworksconn
+	client := meta.(*AWSClient).opsworksconn()
 
 	req := &opsworks.DeleteAppInput{
 		AppId: aws.String(d.Id()),
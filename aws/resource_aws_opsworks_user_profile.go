@@ -43,7 +43,7 @@ func resourceAwsOpsworksUserProfile() *schema.Resource {
 }
 
 func resourceAwsOpsworksUserProfileRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).opsworksconn
+	client := meta.(*AWSClient).opsworksconn()
 
 	req := &opsworks.DescribeUserProfilesInput{
 		IamUserArns: []*string{
@@ -77,7 +77,7 @@ func resourceAwsOpsworksUserProfileRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsOpsworksUserProfileCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).opsworksconn
+	client := meta.(*AWSClient).opsworksconn()
 
 	req := &opsworks.CreateUserProfileInput{
 		AllowSelfManagement: aws.Bool(d.Get("allow_self_management").(bool)),
@@ -97,7 +97,7 @@ func resourceAwsOpsworksUserProfileCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsOpsworksUserProfileUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).opsworksconn
+	client := meta.(*AWSClient).opsworksconn()
 
 	req := &opsworks.UpdateUserProfileInput{
 		AllowSelfManagement: aws.Bool(d.Get("allow_self_management").(bool)),
@@ -117,7 +117,7 @@ func resourceAwsOpsworksUserProfileUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsOpsworksUserProfileDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*AWSClient).opsworksconn
+	client := meta.(*AWSClient).opsworksconn()
 
 	req := &opsworks.DeleteUserProfileInput{
 		IamUserArn: aws.String(d.Id()),
@@ -68,7 +68,7 @@ func resourceAwsOrganizationsDelegatedAdministrator() *schema.Resource {
 }
 
 func resourceAwsOrganizationsDelegatedAdministratorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).organizationsconn
+	conn := meta.(*AWSClient).organizationsconn()
 
 	accountID := d.Get("account_id").(string)
 	servicePrincipal := d.Get("service_principal").(string)
@@ -88,7 +88,7 @@ func resourceAwsOrganizationsDelegatedAdministratorCreate(ctx context.Context, d
 }
 
 func resourceAwsOrganizationsDelegatedAdministratorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).organizationsconn
+	conn := meta.(*AWSClient).organizationsconn()
 
 	accountID, servicePrincipal, err := decodeOrganizationDelegatedAdministratorID(d.Id())
 	if err != nil {
@@ -131,7 +131,7 @@ func resourceAwsOrganizationsDelegatedAdministratorRead(ctx context.Context, d *
 }
 
 func resourceAwsOrganizationsDelegatedAdministratorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).organizationsconn
+	conn := meta.(*AWSClient).organizationsconn()
 
 	accountID, servicePrincipal, err := decodeOrganizationDelegatedAdministratorID(d.Id())
 	if err != nil {
@@ -74,7 +74,7 @@ func testAccAwsOrganizationsDelegatedAdministrator_disappears(t *testing.T) {
 }
 
 func testAccCheckAwsOrganizationsDelegatedAdministratorDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).organizationsconn
+	conn := testAccProvider.Meta().(*AWSClient).organizationsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_organizations_delegated_administrator" {
@@ -127,7 +127,7 @@ func testAccCheckAwsOrganizationsDelegatedAdministratorExists(n string, org *org
 		if err != nil {
 			return err
 		}
-		conn := testAccProvider.Meta().(*AWSClient).organizationsconn
+		conn := testAccProvider.Meta().(*AWSClient).organizationsconn()
 		input := &organizations.ListDelegatedAdministratorsInput{
 			ServicePrincipal: aws.String(servicePrincipal),
 		}
@@ -45,7 +45,7 @@ func resourceAwsPinpointADMChannel() *schema.Resource {
 }
 
 func resourceAwsPinpointADMChannelUpsert(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).pinpointconn
+	conn := meta.(*AWSClient).pinpointconn()
 
 	applicationId := d.Get("application_id").(string)
 
@@ -71,7 +71,7 @@ func resourceAwsPinpointADMChannelUpsert(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsPinpointADMChannelRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).pinpointconn
+	conn := meta.(*AWSClient).pinpointconn()
 
 	log.Printf("[INFO] Reading Pinpoint ADM Channel for application %s", d.Id())
 
@@ -96,7 +96,7 @@ func resourceAwsPinpointADMChannelRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsPinpointADMChannelDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).pinpointconn
+	conn := meta.(*AWSClient).pinpointconn()
 
 	log.Printf("[DEBUG] Pinpoint Delete ADM Channel: %s", d.Id())
 	_, err := conn.DeleteAdmChannel(&pinpoint.DeleteAdmChannelInput{
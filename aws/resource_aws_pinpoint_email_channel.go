@@ -57,7 +57,7 @@ func resourceAwsPinpointEmailChannel() *schema.Resource {
 }
 
 func resourceAwsPinpointEmailChannelUpsert(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).pinpointconn
+	conn := meta.(*AWSClient).pinpointconn()
 
 	applicationId := d.Get("application_id").(string)
 
@@ -91,7 +91,7 @@ func resourceAwsPinpointEmailChannelUpsert(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsPinpointEmailChannelRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).pinpointconn
+	conn := meta.(*AWSClient).pinpointconn()
 
 	log.Printf("[INFO] Reading Pinpoint Email Channel for application %s", d.Id())
 
@@ -121,7 +121,7 @@ func resourceAwsPinpointEmailChannelRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsPinpointEmailChannelDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).pinpointconn
+	conn := meta.(*AWSClient).pinpointconn()
 
 	log.Printf("[DEBUG] Deleting Pinpoint Email Channel for application %s", d.Id())
 	_, err := conn.DeleteEmailChannel(&pinpoint.DeleteEmailChannelInput{
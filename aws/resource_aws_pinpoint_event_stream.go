@@ -44,7 +44,7 @@ func resourceAwsPinpointEventStream() *schema.Resource {
 }
 
 func resourceAwsPinpointEventStreamUpsert(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).pinpointconn
+	conn := meta.(*AWSClient).pinpointconn()
 
 	applicationId := d.Get("application_id").(string)
 
@@ -87,7 +87,7 @@ func resourceAwsPinpointEventStreamUpsert(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsPinpointEventStreamRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).pinpointconn
+	conn := meta.(*AWSClient).pinpointconn()
 
 	log.Printf("[INFO] Reading Pinpoint Event Stream for application %s", d.Id())
 
@@ -113,7 +113,7 @@ func resourceAwsPinpointEventStreamRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsPinpointEventStreamDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).pinpointconn
+	conn := meta.(*AWSClient).pinpointconn()
 
 	log.Printf("[DEBUG] Pinpoint Delete Event Stream: %s", d.Id())
 	_, err := conn.DeleteEventStream(&pinpoint.DeleteEventStreamInput{
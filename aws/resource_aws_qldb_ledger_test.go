@@ -28,7 +28,7 @@ func testSweepQLDBLedgers(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*AWSClient).qldbconn
+	conn := client.(*AWSClient).qldbconn()
 	input := &qldb.ListLedgersInput{}
 	page, err := conn.ListLedgers(input)
 
@@ -136,7 +136,7 @@ func testAccCheckAWSQLDBLedgerDestroy(s *terraform.State) error {
 }
 
 func testAccCheckAWSLedgerDestroyWithProvider(s *terraform.State, provider *schema.Provider) error {
-	conn := provider.Meta().(*AWSClient).qldbconn
+	conn := provider.Meta().(*AWSClient).qldbconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_qldb_ledger" {
@@ -180,7 +180,7 @@ func testAccCheckAWSQLDBLedgerExists(n string, v *qldb.DescribeLedgerOutput) res
 			return fmt.Errorf("No QLDB Ledger ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).qldbconn
+		conn := testAccProvider.Meta().(*AWSClient).qldbconn()
 		resp, err := conn.DescribeLedger(&qldb.DescribeLedgerInput{
 			Name: aws.String(rs.Primary.ID),
 		})
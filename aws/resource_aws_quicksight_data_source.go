@@ -601,7 +601,7 @@ func resourceAwsQuickSightDataSource() *schema.Resource {
 }
 
 func resourceAwsQuickSightDataSourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).quicksightconn
+	conn := meta.(*AWSClient).quicksightconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 
@@ -655,7 +655,7 @@ func resourceAwsQuickSightDataSourceCreate(ctx context.Context, d *schema.Resour
 }
 
 func resourceAwsQuickSightDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).quicksightconn
+	conn := meta.(*AWSClient).quicksightconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
@@ -740,7 +740,7 @@ func resourceAwsQuickSightDataSourceRead(ctx context.Context, d *schema.Resource
 }
 
 func resourceAwsQuickSightDataSourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).quicksightconn
+	conn := meta.(*AWSClient).quicksightconn()
 
 	if d.HasChangesExcept("permission", "tags", "tags_all") {
 		awsAccountId, dataSourceId, err := resourceAwsQuickSightDataSourceParseID(d.Id())
@@ -825,7 +825,7 @@ func resourceAwsQuickSightDataSourceUpdate(ctx context.Context, d *schema.Resour
 }
 
 func resourceAwsQuickSightDataSourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).quicksightconn
+	conn := meta.(*AWSClient).quicksightconn()
 
 	awsAccountId, dataSourceId, err := resourceAwsQuickSightDataSourceParseID(d.Id())
 	if err != nil {
@@ -31,7 +31,7 @@ func testSweepQuickSightDataSources(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*AWSClient).quicksightconn
+	conn := client.(*AWSClient).quicksightconn()
 	sweepResources := make([]*testSweepResource, 0)
 	var errs *multierror.Error
 
@@ -467,7 +467,7 @@ func testAccCheckQuickSightDataSourceExists(resourceName string, dataSource *qui
 			return err
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).quicksightconn
+		conn := testAccProvider.Meta().(*AWSClient).quicksightconn()
 
 		input := &quicksight.DescribeDataSourceInput{
 			AwsAccountId: aws.String(awsAccountID),
@@ -491,7 +491,7 @@ func testAccCheckQuickSightDataSourceExists(resourceName string, dataSource *qui
 }
 
 func testAccCheckQuickSightDataSourceDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).quicksightconn
+	conn := testAccProvider.Meta().(*AWSClient).quicksightconn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_quicksight_data_source" {
 			continue
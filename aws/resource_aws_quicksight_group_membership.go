@@ -63,7 +63,7 @@ func resourceAwsQuickSightGroupMembership() *schema.Resource {
 }
 
 func resourceAwsQuickSightGroupMembershipCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).quicksightconn
+	conn := meta.(*AWSClient).quicksightconn()
 
 	awsAccountID := meta.(*AWSClient).accountid
 	namespace := d.Get("namespace").(string)
@@ -92,7 +92,7 @@ func resourceAwsQuickSightGroupMembershipCreate(ctx context.Context, d *schema.R
 }
 
 func resourceAwsQuickSightGroupMembershipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).quicksightconn
+	conn := meta.(*AWSClient).quicksightconn()
 
 	awsAccountID, namespace, groupName, userName, err := resourceAwsQuickSightGroupMembershipParseID(d.Id())
 	if err != nil {
@@ -125,7 +125,7 @@ func resourceAwsQuickSightGroupMembershipRead(ctx context.Context, d *schema.Res
 }
 
 func resourceAwsQuickSightGroupMembershipDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).quicksightconn
+	conn := meta.(*AWSClient).quicksightconn()
 
 	awsAccountID, namespace, groupName, userName, err := resourceAwsQuickSightGroupMembershipParseID(d.Id())
 	if err != nil {
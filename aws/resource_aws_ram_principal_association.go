@@ -48,7 +48,7 @@ func resourceAwsRamPrincipalAssociation() *schema.Resource {
 }
 
 func resourceAwsRamPrincipalAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ramconn
+	conn := meta.(*AWSClient).ramconn()
 
 	resourceShareArn := d.Get("resource_share_arn").(string)
 	principal := d.Get("principal").(string)
@@ -80,7 +80,7 @@ func resourceAwsRamPrincipalAssociationCreate(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsRamPrincipalAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ramconn
+	conn := meta.(*AWSClient).ramconn()
 
 	resourceShareArn, principal, err := resourceAwsRamPrincipalAssociationParseId(d.Id())
 	if err != nil {
@@ -123,7 +123,7 @@ func resourceAwsRamPrincipalAssociationRead(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsRamPrincipalAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ramconn
+	conn := meta.(*AWSClient).ramconn()
 
 	resourceShareArn, principal, err := resourceAwsRamPrincipalAssociationParseId(d.Id())
 	if err != nil {
@@ -46,7 +46,7 @@ func resourceAwsRDSClusterRoleAssociation() *schema.Resource {
 }
 
 func resourceAwsRDSClusterRoleAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).rdsconn
+	conn := meta.(*AWSClient).rdsconn()
 
 	dbClusterID := d.Get("db_cluster_identifier").(string)
 	roleARN := d.Get("role_arn").(string)
@@ -75,7 +75,7 @@ func resourceAwsRDSClusterRoleAssociationCreate(d *schema.ResourceData, meta int
 }
 
 func resourceAwsRDSClusterRoleAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).rdsconn
+	conn := meta.(*AWSClient).rdsconn()
 
 	dbClusterID, roleARN, err := tfrds.ClusterRoleAssociationParseResourceID(d.Id())
 
@@ -103,7 +103,7 @@ func resourceAwsRDSClusterRoleAssociationRead(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsRDSClusterRoleAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).rdsconn
+	conn := meta.(*AWSClient).rdsconn()
 
 	dbClusterID, roleARN, err := tfrds.ClusterRoleAssociationParseResourceID(d.Id())
 
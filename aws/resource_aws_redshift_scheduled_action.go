@@ -145,7 +145,7 @@ func resourceAwsRedshiftScheduledAction() *schema.Resource {
 }
 
 func resourceAwsRedshiftScheduledActionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).redshiftconn
+	conn := meta.(*AWSClient).redshiftconn()
 
 	name := d.Get("name").(string)
 	input := &redshift.CreateScheduledActionInput{
@@ -197,7 +197,7 @@ func resourceAwsRedshiftScheduledActionCreate(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsRedshiftScheduledActionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).redshiftconn
+	conn := meta.(*AWSClient).redshiftconn()
 
 	scheduledAction, err := finder.ScheduledActionByName(conn, d.Id())
 
@@ -243,7 +243,7 @@ func resourceAwsRedshiftScheduledActionRead(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsRedshiftScheduledActionUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).redshiftconn
+	conn := meta.(*AWSClient).redshiftconn()
 
 	input := &redshift.ModifyScheduledActionInput{
 		ScheduledActionName: aws.String(d.Get("name").(string)),
@@ -292,7 +292,7 @@ func resourceAwsRedshiftScheduledActionUpdate(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsRedshiftScheduledActionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).redshiftconn
+	conn := meta.(*AWSClient).redshiftconn()
 
 	log.Printf("[DEBUG] Deleting Redshift Scheduled Action: %s", d.Id())
 	_, err := conn.DeleteScheduledAction(&redshift.DeleteScheduledActionInput{
@@ -27,7 +27,7 @@ func testSweepRedshiftScheduledActions(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).redshiftconn
+	conn := client.(*AWSClient).redshiftconn()
 	input := &redshift.DescribeScheduledActionsInput{}
 	sweepResources := make([]*testSweepResource, 0)
 
@@ -331,7 +331,7 @@ func TestAccAWSRedshiftScheduledAction_disappears(t *testing.T) {
 }
 
 func testAccCheckAWSRedshiftScheduledActionDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).redshiftconn
+	conn := testAccProvider.Meta().(*AWSClient).redshiftconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_redshift_scheduled_action" {
@@ -365,7 +365,7 @@ func testAccCheckAWSRedshiftScheduledActionExists(n string, v *redshift.Schedule
 			return fmt.Errorf("No Redshift Scheduled Action ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).redshiftconn
+		conn := testAccProvider.Meta().(*AWSClient).redshiftconn()
 
 		output, err := finder.ScheduledActionByName(conn, rs.Primary.ID)
 
@@ -122,7 +122,7 @@ func testAccCheckAWSResourceGroupExists(n string, v *resourcegroups.Group) resou
 			return fmt.Errorf("No resource group name is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).resourcegroupsconn
+		conn := testAccProvider.Meta().(*AWSClient).resourcegroupsconn()
 
 		resp, err := conn.GetGroup(&resourcegroups.GetGroupInput{
 			GroupName: aws.String(rs.Primary.ID),
@@ -147,7 +147,7 @@ func testAccCheckAWSResourceGroupDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).resourcegroupsconn
+		conn := testAccProvider.Meta().(*AWSClient).resourcegroupsconn()
 		resp, err := conn.GetGroup(&resourcegroups.GetGroupInput{
 			GroupName: aws.String(rs.Primary.ID),
 		})
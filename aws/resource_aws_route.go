@@ -170,7 +170,7 @@ func resourceAwsRoute() *schema.Resource {
 }
 
 func resourceAwsRouteCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	destinationAttributeKey, destination, err := routeDestinationAttribute(d)
 
@@ -256,7 +256,7 @@ func resourceAwsRouteCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsRouteRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	destinationAttributeKey, destination, err := routeDestinationAttribute(d)
 
@@ -318,7 +318,7 @@ func resourceAwsRouteRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsRouteUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	destinationAttributeKey, destination, err := routeDestinationAttribute(d)
 
@@ -395,7 +395,7 @@ func resourceAwsRouteUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsRouteDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	destinationAttributeKey, destination, err := routeDestinationAttribute(d)
 
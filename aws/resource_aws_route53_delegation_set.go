@@ -44,7 +44,7 @@ func resourceAwsRoute53DelegationSet() *schema.Resource {
 }
 
 func resourceAwsRoute53DelegationSetCreate(d *schema.ResourceData, meta interface{}) error {
-	r53 := meta.(*AWSClient).r53conn
+	r53 := meta.(*AWSClient).r53conn()
 
 	callerRef := resource.UniqueId()
 	if v, ok := d.GetOk("reference_name"); ok {
@@ -70,7 +70,7 @@ func resourceAwsRoute53DelegationSetCreate(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsRoute53DelegationSetRead(d *schema.ResourceData, meta interface{}) error {
-	r53 := meta.(*AWSClient).r53conn
+	r53 := meta.(*AWSClient).r53conn()
 
 	input := &route53.GetReusableDelegationSetInput{
 		Id: aws.String(cleanDelegationSetId(d.Id())),
@@ -101,7 +101,7 @@ func resourceAwsRoute53DelegationSetRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsRoute53DelegationSetDelete(d *schema.ResourceData, meta interface{}) error {
-	r53 := meta.(*AWSClient).r53conn
+	r53 := meta.(*AWSClient).r53conn()
 
 	input := &route53.DeleteReusableDelegationSetInput{
 		Id: aws.String(cleanDelegationSetId(d.Id())),
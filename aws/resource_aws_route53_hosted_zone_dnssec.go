@@ -45,7 +45,7 @@ func resourceAwsRoute53HostedZoneDnssec() *schema.Resource {
 }
 
 func resourceAwsRoute53HostedZoneDnssecCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).r53conn
+	conn := meta.(*AWSClient).r53conn()
 
 	hostedZoneID := d.Get("hosted_zone_id").(string)
 	signingStatus := d.Get("signing_status").(string)
@@ -73,7 +73,7 @@ func resourceAwsRoute53HostedZoneDnssecCreate(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsRoute53HostedZoneDnssecRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).r53conn
+	conn := meta.(*AWSClient).r53conn()
 
 	hostedZoneDnssec, err := finder.HostedZoneDnssec(conn, d.Id())
 
@@ -113,7 +113,7 @@ func resourceAwsRoute53HostedZoneDnssecRead(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsRoute53HostedZoneDnssecUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).r53conn
+	conn := meta.(*AWSClient).r53conn()
 
 	if d.HasChange("signing_status") {
 		signingStatus := d.Get("signing_status").(string)
@@ -140,7 +140,7 @@ func resourceAwsRoute53HostedZoneDnssecUpdate(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsRoute53HostedZoneDnssecDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).r53conn
+	conn := meta.(*AWSClient).r53conn()
 
 	input := &route53.DisableHostedZoneDNSSECInput{
 		HostedZoneId: aws.String(d.Id()),
@@ -35,7 +35,7 @@ func testSweepRoute53KeySigningKeys(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*AWSClient).r53conn
+	conn := client.(*AWSClient).r53conn()
 	sweepResources := make([]*testSweepResource, 0)
 	var errs *multierror.Error
 
@@ -217,7 +217,7 @@ func TestAccAwsRoute53KeySigningKey_Status(t *testing.T) {
 }
 
 func testAccCheckAwsRoute53KeySigningKeyDestroy(s *terraform.State) error {
-	conn := testAccProviderRoute53KeySigningKey.Meta().(*AWSClient).r53conn
+	conn := testAccProviderRoute53KeySigningKey.Meta().(*AWSClient).r53conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_route53_key_signing_key" {
@@ -258,7 +258,7 @@ func testAccAwsRoute53KeySigningKeyExists(resourceName string) resource.TestChec
 			return fmt.Errorf("resource %s has not set its id", resourceName)
 		}
 
-		conn := testAccProviderRoute53KeySigningKey.Meta().(*AWSClient).r53conn
+		conn := testAccProviderRoute53KeySigningKey.Meta().(*AWSClient).r53conn()
 
 		keySigningKey, err := finder.KeySigningKeyByResourceID(conn, rs.Primary.ID)
 
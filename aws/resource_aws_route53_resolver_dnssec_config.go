@@ -53,7 +53,7 @@ func resourceAwsRoute53ResolverDnssecConfig() *schema.Resource {
 }
 
 func resourceAwsRoute53ResolverDnssecConfigCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).route53resolverconn
+	conn := meta.(*AWSClient).route53resolverconn()
 
 	req := &route53resolver.UpdateResolverDnssecConfigInput{
 		ResourceId: aws.String(d.Get("resource_id").(string)),
@@ -77,7 +77,7 @@ func resourceAwsRoute53ResolverDnssecConfigCreate(d *schema.ResourceData, meta i
 }
 
 func resourceAwsRoute53ResolverDnssecConfigRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).route53resolverconn
+	conn := meta.(*AWSClient).route53resolverconn()
 
 	config, err := finder.ResolverDnssecConfigByID(conn, d.Id())
 
@@ -109,7 +109,7 @@ func resourceAwsRoute53ResolverDnssecConfigRead(d *schema.ResourceData, meta int
 }
 
 func resourceAwsRoute53ResolverDnssecConfigDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).route53resolverconn
+	conn := meta.(*AWSClient).route53resolverconn()
 
 	// To delete a Route 53 ResolverDnssecConfig, it must be:
 	// (1) updated to a "DISABLED" state
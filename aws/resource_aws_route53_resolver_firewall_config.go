@@ -45,7 +45,7 @@ func resourceAwsRoute53ResolverFirewallConfig() *schema.Resource {
 }
 
 func resourceAwsRoute53ResolverFirewallConfigCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).route53resolverconn
+	conn := meta.(*AWSClient).route53resolverconn()
 
 	input := &route53resolver.UpdateFirewallConfigInput{
 		ResourceId: aws.String(d.Get("resource_id").(string)),
@@ -67,7 +67,7 @@ func resourceAwsRoute53ResolverFirewallConfigCreate(d *schema.ResourceData, meta
 }
 
 func resourceAwsRoute53ResolverFirewallConfigRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).route53resolverconn
+	conn := meta.(*AWSClient).route53resolverconn()
 
 	config, err := finder.FirewallConfigByID(conn, d.Id())
 
@@ -89,7 +89,7 @@ func resourceAwsRoute53ResolverFirewallConfigRead(d *schema.ResourceData, meta i
 }
 
 func resourceAwsRoute53ResolverFirewallConfigUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).route53resolverconn
+	conn := meta.(*AWSClient).route53resolverconn()
 
 	input := &route53resolver.UpdateFirewallConfigInput{
 		ResourceId: aws.String(d.Get("resource_id").(string)),
@@ -109,7 +109,7 @@ func resourceAwsRoute53ResolverFirewallConfigUpdate(d *schema.ResourceData, meta
 }
 
 func resourceAwsRoute53ResolverFirewallConfigDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).route53resolverconn
+	conn := meta.(*AWSClient).route53resolverconn()
 
 	log.Printf("[DEBUG] Deleting Route 53 Resolver DNS Firewall config")
 	_, err := conn.UpdateFirewallConfig(&route53resolver.UpdateFirewallConfigInput{
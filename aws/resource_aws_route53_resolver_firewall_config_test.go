@@ -19,9 +19,6 @@ func init() {
 	resource.AddTestSweepers("aws_route53_resolver_firewall_config", &resource.Sweeper{
 		Name: "aws_route53_resolver_firewall_config",
 		F:    testSweepRoute53ResolverFirewallConfigs,
-		Dependencies: []string{
-			"aws_route53_resolver_firewall_config_association",
-		},
 	})
 }
 
@@ -30,7 +27,7 @@ func testSweepRoute53ResolverFirewallConfigs(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).route53resolverconn
+	conn := client.(*AWSClient).route53resolverconn()
 	var sweeperErrs *multierror.Error
 
 	err = conn.ListFirewallConfigsPages(&route53resolver.ListFirewallConfigsInput{}, func(page *route53resolver.ListFirewallConfigsOutput, lastPage bool) bool {
@@ -119,7 +116,7 @@ func TestAccAWSRoute53ResolverFirewallConfig_disappears(t *testing.T) {
 }
 
 func testAccCheckRoute53ResolverFirewallConfigDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).route53resolverconn
+	conn := testAccProvider.Meta().(*AWSClient).route53resolverconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_route53_resolver_firewall_config" {
@@ -157,7 +154,7 @@ func testAccCheckRoute53ResolverFirewallConfigExists(n string, v *route53resolve
 			return fmt.Errorf("No Route 53 Resolver DNS Firewall config ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).route53resolverconn
+		conn := testAccProvider.Meta().(*AWSClient).route53resolverconn()
 
 		out, err := finder.FirewallConfigByID(conn, rs.Primary.ID)
 
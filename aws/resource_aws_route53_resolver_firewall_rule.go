@@ -83,7 +83,7 @@ func resourceAwsRoute53ResolverFirewallRule() *schema.Resource {
 }
 
 func resourceAwsRoute53ResolverFirewallRuleCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).route53resolverconn
+	conn := meta.(*AWSClient).route53resolverconn()
 
 	firewallRuleGroupId := d.Get("firewall_rule_group_id").(string)
 	firewallDomainListId := d.Get("firewall_domain_list_id").(string)
@@ -124,7 +124,7 @@ func resourceAwsRoute53ResolverFirewallRuleCreate(d *schema.ResourceData, meta i
 }
 
 func resourceAwsRoute53ResolverFirewallRuleRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).route53resolverconn
+	conn := meta.(*AWSClient).route53resolverconn()
 
 	rule, err := finder.FirewallRuleByID(conn, d.Id())
 
@@ -158,7 +158,7 @@ func resourceAwsRoute53ResolverFirewallRuleRead(d *schema.ResourceData, meta int
 }
 
 func resourceAwsRoute53ResolverFirewallRuleUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).route53resolverconn
+	conn := meta.(*AWSClient).route53resolverconn()
 
 	input := &route53resolver.UpdateFirewallRuleInput{
 		Name:                 aws.String(d.Get("name").(string)),
@@ -194,7 +194,7 @@ func resourceAwsRoute53ResolverFirewallRuleUpdate(d *schema.ResourceData, meta i
 }
 
 func resourceAwsRoute53ResolverFirewallRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).route53resolverconn
+	conn := meta.(*AWSClient).route53resolverconn()
 
 	_, err := conn.DeleteFirewallRule(&route53resolver.DeleteFirewallRuleInput{
 		FirewallRuleGroupId:  aws.String(d.Get("firewall_rule_group_id").(string)),
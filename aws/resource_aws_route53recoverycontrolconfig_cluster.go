@@ -56,7 +56,7 @@ func resourceAwsRoute53RecoveryControlConfigCluster() *schema.Resource {
 }
 
 func resourceAwsRoute53RecoveryControlConfigClusterCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).route53recoverycontrolconfigconn
+	conn := meta.(*AWSClient).route53recoverycontrolconfigconn()
 
 	input := &r53rcc.CreateClusterInput{
 		ClientToken: aws.String(resource.UniqueId()),
@@ -84,7 +84,7 @@ func resourceAwsRoute53RecoveryControlConfigClusterCreate(d *schema.ResourceData
 }
 
 func resourceAwsRoute53RecoveryControlConfigClusterRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).route53recoverycontrolconfigconn
+	conn := meta.(*AWSClient).route53recoverycontrolconfigconn()
 
 	input := &r53rcc.DescribeClusterInput{
 		ClusterArn: aws.String(d.Id()),
@@ -119,7 +119,7 @@ func resourceAwsRoute53RecoveryControlConfigClusterRead(d *schema.ResourceData,
 }
 
 func resourceAwsRoute53RecoveryControlConfigClusterDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).route53recoverycontrolconfigconn
+	conn := meta.(*AWSClient).route53recoverycontrolconfigconn()
 
 	input := &r53rcc.DeleteClusterInput{
 		ClusterArn: aws.String(d.Id()),
@@ -63,7 +63,7 @@ func testAccAWSRoute53RecoveryControlConfigCluster_disappears(t *testing.T) {
 }
 
 func testAccCheckAwsRoute53RecoveryControlConfigClusterDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).route53recoverycontrolconfigconn
+	conn := testAccProvider.Meta().(*AWSClient).route53recoverycontrolconfigconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_route53recoverycontrolconfig_cluster" {
@@ -99,7 +99,7 @@ func testAccCheckAwsRoute53RecoveryControlConfigClusterExists(name string) resou
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).route53recoverycontrolconfigconn
+		conn := testAccProvider.Meta().(*AWSClient).route53recoverycontrolconfigconn()
 
 		input := &r53rcc.DescribeClusterInput{
 			ClusterArn: aws.String(rs.Primary.ID),
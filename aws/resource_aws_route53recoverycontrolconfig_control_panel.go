@@ -52,7 +52,7 @@ func resourceAwsRoute53RecoveryControlConfigControlPanel() *schema.Resource {
 }
 
 func resourceAwsRoute53RecoveryControlConfigControlPanelCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).route53recoverycontrolconfigconn
+	conn := meta.(*AWSClient).route53recoverycontrolconfigconn()
 
 	input := &r53rcc.CreateControlPanelInput{
 		ClientToken:      aws.String(resource.UniqueId()),
@@ -81,7 +81,7 @@ func resourceAwsRoute53RecoveryControlConfigControlPanelCreate(d *schema.Resourc
 }
 
 func resourceAwsRoute53RecoveryControlConfigControlPanelRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).route53recoverycontrolconfigconn
+	conn := meta.(*AWSClient).route53recoverycontrolconfigconn()
 
 	input := &r53rcc.DescribeControlPanelInput{
 		ControlPanelArn: aws.String(d.Id()),
@@ -115,7 +115,7 @@ func resourceAwsRoute53RecoveryControlConfigControlPanelRead(d *schema.ResourceD
 }
 
 func resourceAwsRoute53RecoveryControlConfigControlPanelUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).route53recoverycontrolconfigconn
+	conn := meta.(*AWSClient).route53recoverycontrolconfigconn()
 
 	input := &r53rcc.UpdateControlPanelInput{
 		ControlPanelName: aws.String(d.Get("name").(string)),
@@ -132,7 +132,7 @@ func resourceAwsRoute53RecoveryControlConfigControlPanelUpdate(d *schema.Resourc
 }
 
 func resourceAwsRoute53RecoveryControlConfigControlPanelDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).route53recoverycontrolconfigconn
+	conn := meta.(*AWSClient).route53recoverycontrolconfigconn()
 
 	input := &r53rcc.DeleteControlPanelInput{
 		ControlPanelArn: aws.String(d.Id()),
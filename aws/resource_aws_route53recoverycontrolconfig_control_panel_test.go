@@ -63,7 +63,7 @@ func testAccAWSRoute53RecoveryControlConfigControlPanel_disappears(t *testing.T)
 }
 
 func testAccCheckAwsRoute53RecoveryControlConfigControlPanelDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).route53recoverycontrolconfigconn
+	conn := testAccProvider.Meta().(*AWSClient).route53recoverycontrolconfigconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_route53recoverycontrolconfig_control_panel" {
@@ -108,7 +108,7 @@ func testAccCheckAwsRoute53RecoveryControlConfigControlPanelExists(name string)
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).route53recoverycontrolconfigconn
+		conn := testAccProvider.Meta().(*AWSClient).route53recoverycontrolconfigconn()
 
 		input := &r53rcc.DescribeControlPanelInput{
 			ControlPanelArn: aws.String(rs.Primary.ID),
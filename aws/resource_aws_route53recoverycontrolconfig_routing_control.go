@@ -49,7 +49,7 @@ func resourceAwsRoute53RecoveryControlConfigRoutingControl() *schema.Resource {
 }
 
 func resourceAwsRoute53RecoveryControlConfigRoutingControlCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).route53recoverycontrolconfigconn
+	conn := meta.(*AWSClient).route53recoverycontrolconfigconn()
 
 	input := &r53rcc.CreateRoutingControlInput{
 		ClientToken:        aws.String(resource.UniqueId()),
@@ -82,7 +82,7 @@ func resourceAwsRoute53RecoveryControlConfigRoutingControlCreate(d *schema.Resou
 }
 
 func resourceAwsRoute53RecoveryControlConfigRoutingControlRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).route53recoverycontrolconfigconn
+	conn := meta.(*AWSClient).route53recoverycontrolconfigconn()
 
 	input := &r53rcc.DescribeRoutingControlInput{
 		RoutingControlArn: aws.String(d.Id()),
@@ -114,7 +114,7 @@ func resourceAwsRoute53RecoveryControlConfigRoutingControlRead(d *schema.Resourc
 }
 
 func resourceAwsRoute53RecoveryControlConfigRoutingControlUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).route53recoverycontrolconfigconn
+	conn := meta.(*AWSClient).route53recoverycontrolconfigconn()
 
 	input := &r53rcc.UpdateRoutingControlInput{
 		RoutingControlName: aws.String(d.Get("name").(string)),
@@ -131,7 +131,7 @@ func resourceAwsRoute53RecoveryControlConfigRoutingControlUpdate(d *schema.Resou
 }
 
 func resourceAwsRoute53RecoveryControlConfigRoutingControlDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).route53recoverycontrolconfigconn
+	conn := meta.(*AWSClient).route53recoverycontrolconfigconn()
 
 	input := &r53rcc.DeleteRoutingControlInput{
 		RoutingControlArn: aws.String(d.Id()),
@@ -92,7 +92,7 @@ func testAccCheckAwsRoute53RecoveryControlConfigRoutingControlExists(name string
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).route53recoverycontrolconfigconn
+		conn := testAccProvider.Meta().(*AWSClient).route53recoverycontrolconfigconn()
 
 		input := &r53rcc.DescribeRoutingControlInput{
 			RoutingControlArn: aws.String(rs.Primary.ID),
@@ -105,7 +105,7 @@ func testAccCheckAwsRoute53RecoveryControlConfigRoutingControlExists(name string
 }
 
 func testAccCheckAwsRoute53RecoveryControlConfigRoutingControlDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).route53recoverycontrolconfigconn
+	conn := testAccProvider.Meta().(*AWSClient).route53recoverycontrolconfigconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_route53recoverycontrolconfig_routing_control" {
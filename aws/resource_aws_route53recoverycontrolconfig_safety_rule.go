@@ -120,7 +120,7 @@ func resourceAwsRoute53RecoveryControlConfigSafetyRuleCreate(d *schema.ResourceD
 }
 
 func resourceAwsRoute53RecoveryControlConfigSafetyRuleRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).route53recoverycontrolconfigconn
+	conn := meta.(*AWSClient).route53recoverycontrolconfigconn()
 
 	input := &r53rcc.DescribeSafetyRuleInput{
 		SafetyRuleArn: aws.String(d.Id()),
@@ -202,7 +202,7 @@ func resourceAwsRoute53RecoveryControlConfigSafetyRuleUpdate(d *schema.ResourceD
 }
 
 func resourceAwsRoute53RecoveryControlConfigSafetyRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).route53recoverycontrolconfigconn
+	conn := meta.(*AWSClient).route53recoverycontrolconfigconn()
 
 	input := &r53rcc.DeleteSafetyRuleInput{
 		SafetyRuleArn: aws.String(d.Id()),
@@ -232,7 +232,7 @@ func resourceAwsRoute53RecoveryControlConfigSafetyRuleDelete(d *schema.ResourceD
 }
 
 func createAssertionRule(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).route53recoverycontrolconfigconn
+	conn := meta.(*AWSClient).route53recoverycontrolconfigconn()
 
 	assertionRule := &r53rcc.NewAssertionRule{
 		Name:             aws.String(d.Get("name").(string)),
@@ -268,7 +268,7 @@ func createAssertionRule(d *schema.ResourceData, meta interface{}) error {
 }
 
 func createGatingRule(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).route53recoverycontrolconfigconn
+	conn := meta.(*AWSClient).route53recoverycontrolconfigconn()
 
 	gatingRule := &r53rcc.NewGatingRule{
 		Name:            aws.String(d.Get("name").(string)),
@@ -305,7 +305,7 @@ func createGatingRule(d *schema.ResourceData, meta interface{}) error {
 }
 
 func updateAssertionRule(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).route53recoverycontrolconfigconn
+	conn := meta.(*AWSClient).route53recoverycontrolconfigconn()
 
 	assertionRuleUpdate := &r53rcc.AssertionRuleUpdate{
 		SafetyRuleArn: aws.String(d.Get("arn").(string)),
@@ -333,7 +333,7 @@ func updateAssertionRule(d *schema.ResourceData, meta interface{}) error {
 }
 
 func updateGatingRule(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).route53recoverycontrolconfigconn
+	conn := meta.(*AWSClient).route53recoverycontrolconfigconn()
 
 	gatingRuleUpdate := &r53rcc.GatingRuleUpdate{
 		SafetyRuleArn: aws.String(d.Get("arn").(string)),
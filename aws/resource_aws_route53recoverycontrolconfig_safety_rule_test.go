@@ -95,7 +95,7 @@ func testAccAWSRoute53RecoveryControlConfigSafetyRule_gatingRule(t *testing.T) {
 }
 
 func testAccCheckAwsRoute53RecoveryControlConfigSafetyRuleDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).route53recoverycontrolconfigconn
+	conn := testAccProvider.Meta().(*AWSClient).route53recoverycontrolconfigconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_route53recoverycontrolconfig_safety_rule" {
@@ -123,7 +123,7 @@ func testAccCheckAwsRoute53RecoveryControlConfigSafetyRuleExists(name string) re
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).route53recoverycontrolconfigconn
+		conn := testAccProvider.Meta().(*AWSClient).route53recoverycontrolconfigconn()
 
 		input := &r53rcc.DescribeSafetyRuleInput{
 			SafetyRuleArn: aws.String(rs.Primary.ID),
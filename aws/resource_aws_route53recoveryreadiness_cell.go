@@ -60,7 +60,7 @@ func resourceAwsRoute53RecoveryReadinessCell() *schema.Resource {
 }
 
 func resourceAwsRoute53RecoveryReadinessCellCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).route53recoveryreadinessconn
+	conn := meta.(*AWSClient).route53recoveryreadinessconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 
@@ -87,7 +87,7 @@ func resourceAwsRoute53RecoveryReadinessCellCreate(d *schema.ResourceData, meta
 }
 
 func resourceAwsRoute53RecoveryReadinessCellRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).route53recoveryreadinessconn
+	conn := meta.(*AWSClient).route53recoveryreadinessconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
@@ -133,7 +133,7 @@ func resourceAwsRoute53RecoveryReadinessCellRead(d *schema.ResourceData, meta in
 }
 
 func resourceAwsRoute53RecoveryReadinessCellUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).route53recoveryreadinessconn
+	conn := meta.(*AWSClient).route53recoveryreadinessconn()
 
 	input := &route53recoveryreadiness.UpdateCellInput{
 		CellName: aws.String(d.Id()),
@@ -157,7 +157,7 @@ func resourceAwsRoute53RecoveryReadinessCellUpdate(d *schema.ResourceData, meta
 }
 
 func resourceAwsRoute53RecoveryReadinessCellDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).route53recoveryreadinessconn
+	conn := meta.(*AWSClient).route53recoveryreadinessconn()
 
 	input := &route53recoveryreadiness.DeleteCellInput{
 		CellName: aws.String(d.Id()),
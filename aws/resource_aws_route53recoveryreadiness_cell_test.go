@@ -188,7 +188,7 @@ func TestAccAwsRoute53RecoveryReadinessCell_timeout(t *testing.T) {
 }
 
 func testAccCheckAwsRoute53RecoveryReadinessCellDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).route53recoveryreadinessconn
+	conn := testAccProvider.Meta().(*AWSClient).route53recoveryreadinessconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_route53recoveryreadiness_cell" {
@@ -216,7 +216,7 @@ func testAccCheckAwsRoute53RecoveryReadinessCellExists(name string) resource.Tes
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).route53recoveryreadinessconn
+		conn := testAccProvider.Meta().(*AWSClient).route53recoveryreadinessconn()
 
 		input := &route53recoveryreadiness.GetCellInput{
 			CellName: aws.String(rs.Primary.ID),
@@ -229,7 +229,7 @@ func testAccCheckAwsRoute53RecoveryReadinessCellExists(name string) resource.Tes
 }
 
 func testAccPreCheckAwsRoute53RecoveryReadiness(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).route53recoveryreadinessconn
+	conn := testAccProvider.Meta().(*AWSClient).route53recoveryreadinessconn()
 
 	input := &route53recoveryreadiness.ListCellsInput{}
 
@@ -50,7 +50,7 @@ func resourceAwsRoute53RecoveryReadinessReadinessCheck() *schema.Resource {
 }
 
 func resourceAwsRoute53RecoveryReadinessReadinessCheckCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).route53recoveryreadinessconn
+	conn := meta.(*AWSClient).route53recoveryreadinessconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 
@@ -77,7 +77,7 @@ func resourceAwsRoute53RecoveryReadinessReadinessCheckCreate(d *schema.ResourceD
 }
 
 func resourceAwsRoute53RecoveryReadinessReadinessCheckRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).route53recoveryreadinessconn
+	conn := meta.(*AWSClient).route53recoveryreadinessconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
@@ -122,7 +122,7 @@ func resourceAwsRoute53RecoveryReadinessReadinessCheckRead(d *schema.ResourceDat
 }
 
 func resourceAwsRoute53RecoveryReadinessReadinessCheckUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).route53recoveryreadinessconn
+	conn := meta.(*AWSClient).route53recoveryreadinessconn()
 
 	input := &route53recoveryreadiness.UpdateReadinessCheckInput{
 		ReadinessCheckName: aws.String(d.Get("readiness_check_name").(string)),
@@ -146,7 +146,7 @@ func resourceAwsRoute53RecoveryReadinessReadinessCheckUpdate(d *schema.ResourceD
 }
 
 func resourceAwsRoute53RecoveryReadinessReadinessCheckDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).route53recoveryreadinessconn
+	conn := meta.(*AWSClient).route53recoveryreadinessconn()
 
 	input := &route53recoveryreadiness.DeleteReadinessCheckInput{
 		ReadinessCheckName: aws.String(d.Id()),
@@ -53,7 +53,7 @@ func resourceAwsRoute53RecoveryReadinessRecoveryGroup() *schema.Resource {
 }
 
 func resourceAwsRoute53RecoveryReadinessRecoveryGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).route53recoveryreadinessconn
+	conn := meta.(*AWSClient).route53recoveryreadinessconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 
@@ -80,7 +80,7 @@ func resourceAwsRoute53RecoveryReadinessRecoveryGroupCreate(d *schema.ResourceDa
 }
 
 func resourceAwsRoute53RecoveryReadinessRecoveryGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).route53recoveryreadinessconn
+	conn := meta.(*AWSClient).route53recoveryreadinessconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
@@ -124,7 +124,7 @@ func resourceAwsRoute53RecoveryReadinessRecoveryGroupRead(d *schema.ResourceData
 }
 
 func resourceAwsRoute53RecoveryReadinessRecoveryGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).route53recoveryreadinessconn
+	conn := meta.(*AWSClient).route53recoveryreadinessconn()
 
 	input := &route53recoveryreadiness.UpdateRecoveryGroupInput{
 		RecoveryGroupName: aws.String(d.Id()),
@@ -149,7 +149,7 @@ func resourceAwsRoute53RecoveryReadinessRecoveryGroupUpdate(d *schema.ResourceDa
 }
 
 func resourceAwsRoute53RecoveryReadinessRecoveryGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).route53recoveryreadinessconn
+	conn := meta.(*AWSClient).route53recoveryreadinessconn()
 
 	input := &route53recoveryreadiness.DeleteRecoveryGroupInput{
 		RecoveryGroupName: aws.String(d.Id()),
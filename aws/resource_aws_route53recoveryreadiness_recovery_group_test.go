@@ -160,7 +160,7 @@ func TestAccAwsRoute53RecoveryReadinessRecoveryGroup_timeout(t *testing.T) {
 }
 
 func testAccCheckAwsRoute53RecoveryReadinessRecoveryGroupDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).route53recoveryreadinessconn
+	conn := testAccProvider.Meta().(*AWSClient).route53recoveryreadinessconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_route53recoveryreadiness_recovery_group" {
@@ -187,7 +187,7 @@ func testAccCheckAwsRoute53RecoveryReadinessRecoveryGroupExists(name string) res
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).route53recoveryreadinessconn
+		conn := testAccProvider.Meta().(*AWSClient).route53recoveryreadinessconn()
 
 		input := &route53recoveryreadiness.GetRecoveryGroupInput{
 			RecoveryGroupName: aws.String(rs.Primary.ID),
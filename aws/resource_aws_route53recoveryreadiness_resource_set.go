@@ -138,7 +138,7 @@ func resourceAwsRoute53RecoveryReadinessResourceSet() *schema.Resource {
 }
 
 func resourceAwsRoute53RecoveryReadinessResourceSetCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).route53recoveryreadinessconn
+	conn := meta.(*AWSClient).route53recoveryreadinessconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 
@@ -166,7 +166,7 @@ func resourceAwsRoute53RecoveryReadinessResourceSetCreate(d *schema.ResourceData
 }
 
 func resourceAwsRoute53RecoveryReadinessResourceSetRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).route53recoveryreadinessconn
+	conn := meta.(*AWSClient).route53recoveryreadinessconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
@@ -215,7 +215,7 @@ func resourceAwsRoute53RecoveryReadinessResourceSetRead(d *schema.ResourceData,
 }
 
 func resourceAwsRoute53RecoveryReadinessResourceSetUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).route53recoveryreadinessconn
+	conn := meta.(*AWSClient).route53recoveryreadinessconn()
 
 	input := &route53recoveryreadiness.UpdateResourceSetInput{
 		ResourceSetName: aws.String(d.Id()),
@@ -240,7 +240,7 @@ func resourceAwsRoute53RecoveryReadinessResourceSetUpdate(d *schema.ResourceData
 }
 
 func resourceAwsRoute53RecoveryReadinessResourceSetDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).route53recoveryreadinessconn
+	conn := meta.(*AWSClient).route53recoveryreadinessconn()
 
 	input := &route53recoveryreadiness.DeleteResourceSetInput{
 		ResourceSetName: aws.String(d.Id()),
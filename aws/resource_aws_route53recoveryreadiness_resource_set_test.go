@@ -319,7 +319,7 @@ func TestAccAwsRoute53RecoveryReadinessResourceSet_timeout(t *testing.T) {
 }
 
 func testAccCheckAwsRoute53RecoveryReadinessResourceSetDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).route53recoveryreadinessconn
+	conn := testAccProvider.Meta().(*AWSClient).route53recoveryreadinessconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_route53recoveryreadiness_resource_set" {
@@ -346,7 +346,7 @@ func testAccCheckAwsRoute53RecoveryReadinessResourceSetExists(name string) resou
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).route53recoveryreadinessconn
+		conn := testAccProvider.Meta().(*AWSClient).route53recoveryreadinessconn()
 
 		input := &route53recoveryreadiness.GetResourceSetInput{
 			ResourceSetName: aws.String(rs.Primary.ID),
@@ -359,7 +359,7 @@ func testAccCheckAwsRoute53RecoveryReadinessResourceSetExists(name string) resou
 }
 
 func testAccPreCheckAwsRoute53RecoveryReadinessResourceSet(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).route53recoveryreadinessconn
+	conn := testAccProvider.Meta().(*AWSClient).route53recoveryreadinessconn()
 
 	input := &route53recoveryreadiness.ListResourceSetsInput{}
 
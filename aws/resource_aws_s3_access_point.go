@@ -122,7 +122,7 @@ func resourceAwsS3AccessPoint() *schema.Resource {
 }
 
 func resourceAwsS3AccessPointCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).s3controlconn
+	conn := meta.(*AWSClient).s3controlconn()
 
 	accountId := meta.(*AWSClient).accountid
 	if v, ok := d.GetOk("account_id"); ok {
@@ -175,7 +175,7 @@ func resourceAwsS3AccessPointCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsS3AccessPointRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).s3controlconn
+	conn := meta.(*AWSClient).s3controlconn()
 
 	accountId, name, err := s3AccessPointParseId(d.Id())
 	if err != nil {
@@ -288,7 +288,7 @@ func resourceAwsS3AccessPointRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsS3AccessPointUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).s3controlconn
+	conn := meta.(*AWSClient).s3controlconn()
 
 	accountId, name, err := s3AccessPointParseId(d.Id())
 	if err != nil {
@@ -324,7 +324,7 @@ func resourceAwsS3AccessPointUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsS3AccessPointDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).s3controlconn
+	conn := meta.(*AWSClient).s3controlconn()
 
 	accountId, name, err := s3AccessPointParseId(d.Id())
 	if err != nil {
@@ -131,7 +131,7 @@ func resourceAwsS3BucketNotification() *schema.Resource {
 }
 
 func resourceAwsS3BucketNotificationPut(d *schema.ResourceData, meta interface{}) error {
-	s3conn := meta.(*AWSClient).s3conn
+	s3conn := meta.(*AWSClient).s3conn()
 	bucket := d.Get("bucket").(string)
 
 	// TopicNotifications
@@ -337,7 +337,7 @@ func resourceAwsS3BucketNotificationPut(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsS3BucketNotificationDelete(d *schema.ResourceData, meta interface{}) error {
-	s3conn := meta.(*AWSClient).s3conn
+	s3conn := meta.(*AWSClient).s3conn()
 
 	i := &s3.PutBucketNotificationConfigurationInput{
 		Bucket:                    aws.String(d.Id()),
@@ -355,7 +355,7 @@ func resourceAwsS3BucketNotificationDelete(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsS3BucketNotificationRead(d *schema.ResourceData, meta interface{}) error {
-	s3conn := meta.(*AWSClient).s3conn
+	s3conn := meta.(*AWSClient).s3conn()
 
 	notificationConfigs, err := s3conn.GetBucketNotificationConfiguration(&s3.GetBucketNotificationConfigurationRequest{
 		Bucket: aws.String(d.Id()),
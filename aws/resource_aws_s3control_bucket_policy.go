@@ -41,7 +41,7 @@ func resourceAwsS3ControlBucketPolicy() *schema.Resource {
 }
 
 func resourceAwsS3ControlBucketPolicyCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).s3controlconn
+	conn := meta.(*AWSClient).s3controlconn()
 
 	bucket := d.Get("bucket").(string)
 
@@ -62,7 +62,7 @@ func resourceAwsS3ControlBucketPolicyCreate(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsS3ControlBucketPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).s3controlconn
+	conn := meta.(*AWSClient).s3controlconn()
 
 	parsedArn, err := arn.Parse(d.Id())
 
@@ -114,7 +114,7 @@ func resourceAwsS3ControlBucketPolicyRead(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsS3ControlBucketPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).s3controlconn
+	conn := meta.(*AWSClient).s3controlconn()
 
 	input := &s3control.PutBucketPolicyInput{
 		Bucket: aws.String(d.Id()),
@@ -131,7 +131,7 @@ func resourceAwsS3ControlBucketPolicyUpdate(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsS3ControlBucketPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).s3controlconn
+	conn := meta.(*AWSClient).s3controlconn()
 
 	parsedArn, err := arn.Parse(d.Id())
 
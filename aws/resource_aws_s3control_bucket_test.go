@@ -114,7 +114,7 @@ func TestAccAWSS3ControlBucket_Tags(t *testing.T) {
 }
 
 func testAccCheckAWSS3ControlBucketDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).s3controlconn
+	conn := testAccProvider.Meta().(*AWSClient).s3controlconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_s3control_bucket" {
@@ -159,7 +159,7 @@ func testAccCheckAWSS3ControlBucketExists(resourceName string) resource.TestChec
 			return fmt.Errorf("no resource ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).s3controlconn
+		conn := testAccProvider.Meta().(*AWSClient).s3controlconn()
 
 		parsedArn, err := arn.Parse(rs.Primary.ID)
 
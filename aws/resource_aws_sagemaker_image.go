@@ -65,7 +65,7 @@ func resourceAwsSagemakerImage() *schema.Resource {
 }
 
 func resourceAwsSagemakerImageCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sagemakerconn
+	conn := meta.(*AWSClient).sagemakerconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 
@@ -104,7 +104,7 @@ func resourceAwsSagemakerImageCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsSagemakerImageRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sagemakerconn
+	conn := meta.(*AWSClient).sagemakerconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
@@ -147,7 +147,7 @@ func resourceAwsSagemakerImageRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsSagemakerImageUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sagemakerconn
+	conn := meta.(*AWSClient).sagemakerconn()
 	needsUpdate := false
 
 	input := &sagemaker.UpdateImageInput{
@@ -200,7 +200,7 @@ func resourceAwsSagemakerImageUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsSagemakerImageDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sagemakerconn
+	conn := meta.(*AWSClient).sagemakerconn()
 
 	input := &sagemaker.DeleteImageInput{
 		ImageName: aws.String(d.Id()),
@@ -27,7 +27,7 @@ func testSweepSagemakerModelPackageGroups(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).sagemakerconn
+	conn := client.(*AWSClient).sagemakerconn()
 
 	err = conn.ListModelPackageGroupsPages(&sagemaker.ListModelPackageGroupsInput{}, func(page *sagemaker.ListModelPackageGroupsOutput, lastPage bool) bool {
 		for _, ModelPackageGroup := range page.ModelPackageGroupSummaryList {
@@ -184,7 +184,7 @@ func TestAccAWSSagemakerModelPackageGroup_disappears(t *testing.T) {
 }
 
 func testAccCheckAWSSagemakerModelPackageGroupDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+	conn := testAccProvider.Meta().(*AWSClient).sagemakerconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_sagemaker_model_package_group" {
@@ -220,7 +220,7 @@ func testAccCheckAWSSagemakerModelPackageGroupExists(n string, mpg *sagemaker.De
 			return fmt.Errorf("No sagmaker Model Package Group ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn()
 		resp, err := finder.ModelPackageGroupByName(conn, rs.Primary.ID)
 		if err != nil {
 			return err
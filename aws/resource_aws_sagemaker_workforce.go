@@ -150,7 +150,7 @@ func resourceAwsSagemakerWorkforce() *schema.Resource {
 }
 
 func resourceAwsSagemakerWorkforceCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sagemakerconn
+	conn := meta.(*AWSClient).sagemakerconn()
 
 	name := d.Get("workforce_name").(string)
 	input := &sagemaker.CreateWorkforceInput{
@@ -182,7 +182,7 @@ func resourceAwsSagemakerWorkforceCreate(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsSagemakerWorkforceRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sagemakerconn
+	conn := meta.(*AWSClient).sagemakerconn()
 
 	workforce, err := finder.WorkforceByName(conn, d.Id())
 
@@ -218,7 +218,7 @@ func resourceAwsSagemakerWorkforceRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsSagemakerWorkforceUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sagemakerconn
+	conn := meta.(*AWSClient).sagemakerconn()
 
 	input := &sagemaker.UpdateWorkforceInput{
 		WorkforceName: aws.String(d.Id()),
@@ -243,7 +243,7 @@ func resourceAwsSagemakerWorkforceUpdate(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsSagemakerWorkforceDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sagemakerconn
+	conn := meta.(*AWSClient).sagemakerconn()
 
 	log.Printf("[DEBUG] Deleting SageMaker Workforce: %s", d.Id())
 	_, err := conn.DeleteWorkforce(&sagemaker.DeleteWorkforceInput{
@@ -31,7 +31,7 @@ func testSweepSagemakerWorkforces(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).sagemakerconn
+	conn := client.(*AWSClient).sagemakerconn()
 	var sweeperErrs *multierror.Error
 
 	err = conn.ListWorkforcesPages(&sagemaker.ListWorkforcesInput{}, func(page *sagemaker.ListWorkforcesOutput, lastPage bool) bool {
@@ -233,7 +233,7 @@ func testAccAWSSagemakerWorkforce_disappears(t *testing.T) {
 }
 
 func testAccCheckAWSSagemakerWorkforceDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+	conn := testAccProvider.Meta().(*AWSClient).sagemakerconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_sagemaker_workforce" {
@@ -267,7 +267,7 @@ func testAccCheckAWSSagemakerWorkforceExists(n string, workforce *sagemaker.Work
 			return fmt.Errorf("No SageMaker Workforce ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn()
 
 		output, err := finder.WorkforceByName(conn, rs.Primary.ID)
 
@@ -52,7 +52,7 @@ func resourceAwsSchemasDiscoverer() *schema.Resource {
 }
 
 func resourceAwsSchemasDiscovererCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).schemasconn
+	conn := meta.(*AWSClient).schemasconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 
@@ -82,7 +82,7 @@ func resourceAwsSchemasDiscovererCreate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsSchemasDiscovererRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).schemasconn
+	conn := meta.(*AWSClient).schemasconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
@@ -122,7 +122,7 @@ func resourceAwsSchemasDiscovererRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsSchemasDiscovererUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).schemasconn
+	conn := meta.(*AWSClient).schemasconn()
 
 	if d.HasChange("description") {
 		input := &schemas.UpdateDiscovererInput{
@@ -149,7 +149,7 @@ func resourceAwsSchemasDiscovererUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsSchemasDiscovererDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).schemasconn
+	conn := meta.(*AWSClient).schemasconn()
 
 	log.Printf("[INFO] Deleting EventBridge Schemas Discoverer (%s)", d.Id())
 	_, err := conn.DeleteDiscoverer(&schemas.DeleteDiscovererInput{
@@ -27,7 +27,7 @@ func testSweepSchemasDiscoverers(region string) error {
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).schemasconn
+	conn := client.(*AWSClient).schemasconn()
 	input := &schemas.ListDiscoverersInput{}
 	var sweeperErrs *multierror.Error
 
@@ -203,7 +203,7 @@ func TestAccAWSSchemasDiscoverer_Tags(t *testing.T) {
 }
 
 func testAccCheckAWSSchemasDiscovererDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).schemasconn
+	conn := testAccProvider.Meta().(*AWSClient).schemasconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_schemas_discoverer" {
@@ -237,7 +237,7 @@ func testAccCheckSchemasDiscovererExists(n string, v *schemas.DescribeDiscoverer
 			return fmt.Errorf("No EventBridge Schemas Discoverer ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).schemasconn
+		conn := testAccProvider.Meta().(*AWSClient).schemasconn()
 
 		output, err := finder.DiscovererByID(conn, rs.Primary.ID)
 
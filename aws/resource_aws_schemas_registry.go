@@ -56,7 +56,7 @@ func resourceAwsSchemasRegistry() *schema.Resource {
 }
 
 func resourceAwsSchemasRegistryCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).schemasconn
+	conn := meta.(*AWSClient).schemasconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 
@@ -86,7 +86,7 @@ func resourceAwsSchemasRegistryCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsSchemasRegistryRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).schemasconn
+	conn := meta.(*AWSClient).schemasconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
@@ -126,7 +126,7 @@ func resourceAwsSchemasRegistryRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsSchemasRegistryUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).schemasconn
+	conn := meta.(*AWSClient).schemasconn()
 
 	if d.HasChanges("description") {
 		input := &schemas.UpdateRegistryInput{
@@ -153,7 +153,7 @@ func resourceAwsSchemasRegistryUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsSchemasRegistryDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).schemasconn
+	conn := meta.(*AWSClient).schemasconn()
 
 	log.Printf("[INFO] Deleting EventBridge Schemas Registry (%s)", d.Id())
 	_, err := conn.DeleteRegistry(&schemas.DeleteRegistryInput{
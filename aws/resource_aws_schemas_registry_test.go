@@ -29,7 +29,7 @@ func testSweepSchemasRegistries(region string) error {
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).schemasconn
+	conn := client.(*AWSClient).schemasconn()
 	input := &schemas.ListRegistriesInput{}
 	var sweeperErrs *multierror.Error
 
@@ -246,7 +246,7 @@ func TestAccAWSSchemasRegistry_Tags(t *testing.T) {
 }
 
 func testAccCheckAWSSchemasRegistryDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).schemasconn
+	conn := testAccProvider.Meta().(*AWSClient).schemasconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_schemas_registry" {
@@ -280,7 +280,7 @@ func testAccCheckSchemasRegistryExists(n string, v *schemas.DescribeRegistryOutp
 			return fmt.Errorf("No EventBridge Schemas Registry ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).schemasconn
+		conn := testAccProvider.Meta().(*AWSClient).schemasconn()
 
 		output, err := finder.RegistryByName(conn, rs.Primary.ID)
 
@@ -91,7 +91,7 @@ func resourceAwsSchemasSchema() *schema.Resource {
 }
 
 func resourceAwsSchemasSchemaCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).schemasconn
+	conn := meta.(*AWSClient).schemasconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 
@@ -127,7 +127,7 @@ func resourceAwsSchemasSchemaCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsSchemasSchemaRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).schemasconn
+	conn := meta.(*AWSClient).schemasconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
@@ -187,7 +187,7 @@ func resourceAwsSchemasSchemaRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsSchemasSchemaUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).schemasconn
+	conn := meta.(*AWSClient).schemasconn()
 
 	if d.HasChanges("content", "description", "type") {
 		name, registryName, err := tfschemas.SchemaParseResourceID(d.Id())
@@ -229,7 +229,7 @@ func resourceAwsSchemasSchemaUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsSchemasSchemaDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).schemasconn
+	conn := meta.(*AWSClient).schemasconn()
 
 	name, registryName, err := tfschemas.SchemaParseResourceID(d.Id())
 
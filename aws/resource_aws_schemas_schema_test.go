@@ -216,7 +216,7 @@ func TestAccAWSSchemasSchema_Tags(t *testing.T) {
 }
 
 func testAccCheckAWSSchemasSchemaDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).schemasconn
+	conn := testAccProvider.Meta().(*AWSClient).schemasconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_schemas_schema" {
@@ -262,7 +262,7 @@ func testAccCheckSchemasSchemaExists(n string, v *schemas.DescribeSchemaOutput)
 			return err
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).schemasconn
+		conn := testAccProvider.Meta().(*AWSClient).schemasconn()
 
 		output, err := finder.SchemaByNameAndRegistryName(conn, name, registryName)
 
@@ -127,7 +127,7 @@ func TestAccAwsSecretsManagerSecretVersion_VersionStages(t *testing.T) {
 }
 
 func testAccCheckAwsSecretsManagerSecretVersionDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).secretsmanagerconn
+	conn := testAccProvider.Meta().(*AWSClient).secretsmanagerconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_secretsmanager_secret_version" {
@@ -187,7 +187,7 @@ func testAccCheckAwsSecretsManagerSecretVersionExists(resourceName string, versi
 			return err
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).secretsmanagerconn
+		conn := testAccProvider.Meta().(*AWSClient).secretsmanagerconn()
 
 		input := &secretsmanager.GetSecretValueInput{
 			SecretId:  aws.String(secretID),
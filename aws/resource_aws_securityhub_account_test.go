@@ -38,7 +38,7 @@ func testAccCheckAWSSecurityHubAccountExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).securityhubconn
+		conn := testAccProvider.Meta().(*AWSClient).securityhubconn()
 
 		_, err := conn.GetEnabledStandards(&securityhub.GetEnabledStandardsInput{})
 
@@ -55,7 +55,7 @@ func testAccCheckAWSSecurityHubAccountExists(n string) resource.TestCheckFunc {
 }
 
 func testAccCheckAWSSecurityHubAccountDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).securityhubconn
+	conn := testAccProvider.Meta().(*AWSClient).securityhubconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_securityhub_account" {
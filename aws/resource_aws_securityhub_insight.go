@@ -143,7 +143,7 @@ func resourceAwsSecurityHubInsight() *schema.Resource {
 }
 
 func resourceAwsSecurityHubInsightCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).securityhubconn
+	conn := meta.(*AWSClient).securityhubconn()
 
 	name := d.Get("name").(string)
 
@@ -172,7 +172,7 @@ func resourceAwsSecurityHubInsightCreate(ctx context.Context, d *schema.Resource
 }
 
 func resourceAwsSecurityHubInsightRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).securityhubconn
+	conn := meta.(*AWSClient).securityhubconn()
 
 	insight, err := finder.Insight(ctx, conn, d.Id())
 
@@ -206,7 +206,7 @@ func resourceAwsSecurityHubInsightRead(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceAwsSecurityHubInsightUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).securityhubconn
+	conn := meta.(*AWSClient).securityhubconn()
 
 	input := &securityhub.UpdateInsightInput{
 		InsightArn: aws.String(d.Id()),
@@ -234,7 +234,7 @@ func resourceAwsSecurityHubInsightUpdate(ctx context.Context, d *schema.Resource
 }
 
 func resourceAwsSecurityHubInsightDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).securityhubconn
+	conn := meta.(*AWSClient).securityhubconn()
 
 	input := &securityhub.DeleteInsightInput{
 		InsightArn: aws.String(d.Id()),
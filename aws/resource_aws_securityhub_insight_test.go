@@ -435,7 +435,7 @@ func testAccAwsSecurityHubInsight_WorkflowStatus(t *testing.T) {
 }
 
 func testAccCheckAwsSecurityHubInsightDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).securityhubconn
+	conn := testAccProvider.Meta().(*AWSClient).securityhubconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_securityhub_insight" {
@@ -469,7 +469,7 @@ func testAccCheckAwsSecurityHubInsightExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).securityhubconn
+		conn := testAccProvider.Meta().(*AWSClient).securityhubconn()
 
 		insight, err := finder.Insight(context.Background(), conn, rs.Primary.ID)
 
@@ -82,7 +82,7 @@ func resourceAwsSecurityHubStandardsControl() *schema.Resource {
 }
 
 func resourceAwsSecurityHubStandardsControlRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).securityhubconn
+	conn := meta.(*AWSClient).securityhubconn()
 
 	standardsSubscriptionARN, err := tfsecurityhub.StandardsControlARNToStandardsSubscriptionARN(d.Id())
 
@@ -117,7 +117,7 @@ func resourceAwsSecurityHubStandardsControlRead(ctx context.Context, d *schema.R
 }
 
 func resourceAwsSecurityHubStandardsControlPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).securityhubconn
+	conn := meta.(*AWSClient).securityhubconn()
 
 	d.SetId(d.Get("standards_control_arn").(string))
 
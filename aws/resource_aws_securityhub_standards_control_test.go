@@ -90,7 +90,7 @@ func testAccCheckAWSSecurityHubStandardsControlExists(n string, control *securit
 			return fmt.Errorf("No Security Hub Standards Control ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).securityhubconn
+		conn := testAccProvider.Meta().(*AWSClient).securityhubconn()
 
 		standardsSubscriptionARN, err := tfsecurityhub.StandardsControlARNToStandardsSubscriptionARN(rs.Primary.ID)
 
@@ -91,7 +91,7 @@ func resourceAwsServerlessApplicationRepositoryCloudFormationStack() *schema.Res
 }
 
 func resourceAwsServerlessApplicationRepositoryCloudFormationStackCreate(d *schema.ResourceData, meta interface{}) error {
-	cfConn := meta.(*AWSClient).cfconn
+	cfConn := meta.(*AWSClient).cfconn()
 
 	changeSet, err := createServerlessApplicationRepositoryCloudFormationChangeSet(d, meta.(*AWSClient))
 	if err != nil {
@@ -124,8 +124,8 @@ func resourceAwsServerlessApplicationRepositoryCloudFormationStackCreate(d *sche
 }
 
 func resourceAwsServerlessApplicationRepositoryCloudFormationStackRead(d *schema.ResourceData, meta interface{}) error {
-	serverlessConn := meta.(*AWSClient).serverlessapplicationrepositoryconn
-	cfConn := meta.(*AWSClient).cfconn
+	serverlessConn := meta.(*AWSClient).serverlessapplicationrepositoryconn()
+	cfConn := meta.(*AWSClient).cfconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
@@ -219,7 +219,7 @@ func flattenServerlessRepositoryParameterDefinitions(parameterDefinitions []*ser
 }
 
 func resourceAwsServerlessApplicationRepositoryCloudFormationStackUpdate(d *schema.ResourceData, meta interface{}) error {
-	cfConn := meta.(*AWSClient).cfconn
+	cfConn := meta.(*AWSClient).cfconn()
 
 	changeSet, err := createServerlessApplicationRepositoryCloudFormationChangeSet(d, meta.(*AWSClient))
 	if err != nil {
@@ -250,7 +250,7 @@ func resourceAwsServerlessApplicationRepositoryCloudFormationStackUpdate(d *sche
 }
 
 func resourceAwsServerlessApplicationRepositoryCloudFormationStackDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cfconn
+	conn := meta.(*AWSClient).cfconn()
 
 	requestToken := resource.UniqueId()
 	input := &cloudformation.DeleteStackInput{
@@ -286,7 +286,7 @@ func resourceAwsServerlessApplicationRepositoryCloudFormationStackImport(d *sche
 		}
 	}
 
-	cfConn := meta.(*AWSClient).cfconn
+	cfConn := meta.(*AWSClient).cfconn()
 	stack, err := cffinder.StackByID(cfConn, stackID)
 	if err != nil {
 		return nil, fmt.Errorf("error describing Serverless Application Repository CloudFormation Stack (%s): %w", stackID, err)
@@ -298,8 +298,8 @@ func resourceAwsServerlessApplicationRepositoryCloudFormationStackImport(d *sche
 }
 
 func createServerlessApplicationRepositoryCloudFormationChangeSet(d *schema.ResourceData, client *AWSClient) (*cloudformation.DescribeChangeSetOutput, error) {
-	serverlessConn := client.serverlessapplicationrepositoryconn
-	cfConn := client.cfconn
+	serverlessConn := client.serverlessapplicationrepositoryconn()
+	cfConn := client.cfconn()
 	defaultTagsConfig := client.DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 
@@ -60,7 +60,7 @@ func resourceAwsServiceDiscoveryInstance() *schema.Resource {
 }
 
 func resourceAwsServiceDiscoveryInstancePut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sdconn
+	conn := meta.(*AWSClient).sdconn()
 
 	instanceID := d.Get("instance_id").(string)
 	input := &servicediscovery.RegisterInstanceInput{
@@ -89,7 +89,7 @@ func resourceAwsServiceDiscoveryInstancePut(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsServiceDiscoveryInstanceRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sdconn
+	conn := meta.(*AWSClient).sdconn()
 
 	instance, err := finder.InstanceByServiceIDAndInstanceID(conn, d.Get("service_id").(string), d.Get("instance_id").(string))
 
@@ -117,7 +117,7 @@ func resourceAwsServiceDiscoveryInstanceRead(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsServiceDiscoveryInstanceDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sdconn
+	conn := meta.(*AWSClient).sdconn()
 
 	err := deregisterServiceDiscoveryInstance(conn, d.Get("service_id").(string), d.Get("instance_id").(string))
 
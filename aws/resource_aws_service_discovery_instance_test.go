@@ -280,7 +280,7 @@ func testAccCheckAwsServiceDiscoveryInstanceExists(name string) resource.TestChe
 			return fmt.Errorf("No Service Discovery Instance ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).sdconn
+		conn := testAccProvider.Meta().(*AWSClient).sdconn()
 
 		_, err := finder.InstanceByServiceIDAndInstanceID(conn, rs.Primary.Attributes["service_id"], rs.Primary.Attributes["instance_id"])
 
@@ -304,7 +304,7 @@ func testAccAWSServiceDiscoveryInstanceImportStateIdFunc(resourceName string) re
 }
 
 func testAccCheckAwsServiceDiscoveryInstanceDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).sdconn
+	conn := testAccProvider.Meta().(*AWSClient).sdconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_service_discovery_instance" {
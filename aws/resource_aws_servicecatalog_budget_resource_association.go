@@ -40,7 +40,7 @@ func resourceAwsServiceCatalogBudgetResourceAssociation() *schema.Resource {
 }
 
 func resourceAwsServiceCatalogBudgetResourceAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).scconn
+	conn := meta.(*AWSClient).scconn()
 
 	input := &servicecatalog.AssociateBudgetWithResourceInput{
 		BudgetName: aws.String(d.Get("budget_name").(string)),
@@ -82,7 +82,7 @@ func resourceAwsServiceCatalogBudgetResourceAssociationCreate(d *schema.Resource
 }
 
 func resourceAwsServiceCatalogBudgetResourceAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).scconn
+	conn := meta.(*AWSClient).scconn()
 
 	budgetName, resourceID, err := tfservicecatalog.BudgetResourceAssociationParseID(d.Id())
 
@@ -113,7 +113,7 @@ func resourceAwsServiceCatalogBudgetResourceAssociationRead(d *schema.ResourceDa
 }
 
 func resourceAwsServiceCatalogBudgetResourceAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).scconn
+	conn := meta.(*AWSClient).scconn()
 
 	budgetName, resourceID, err := tfservicecatalog.BudgetResourceAssociationParseID(d.Id())
 
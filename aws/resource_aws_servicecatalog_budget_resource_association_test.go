@@ -32,7 +32,7 @@ func testSweepServiceCatalogBudgetResourceAssociations(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*AWSClient).scconn
+	conn := client.(*AWSClient).scconn()
 	sweepResources := make([]*testSweepResource, 0)
 	var errs *multierror.Error
 
@@ -186,7 +186,7 @@ func TestAccAWSServiceCatalogBudgetResourceAssociation_disappears(t *testing.T)
 }
 
 func testAccCheckAwsServiceCatalogBudgetResourceAssociationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).scconn
+	conn := testAccProvider.Meta().(*AWSClient).scconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_servicecatalog_budget_resource_association" {
@@ -227,7 +227,7 @@ func testAccCheckAwsServiceCatalogBudgetResourceAssociationExists(resourceName s
 			return fmt.Errorf("could not parse ID (%s): %w", rs.Primary.ID, err)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).scconn
+		conn := testAccProvider.Meta().(*AWSClient).scconn()
 
 		_, err = waiter.BudgetResourceAssociationReady(conn, budgetName, resourceID)
 
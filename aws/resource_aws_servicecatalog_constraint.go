@@ -73,7 +73,7 @@ func resourceAwsServiceCatalogConstraint() *schema.Resource {
 }
 
 func resourceAwsServiceCatalogConstraintCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).scconn
+	conn := meta.(*AWSClient).scconn()
 
 	input := &servicecatalog.CreateConstraintInput{
 		IdempotencyToken: aws.String(resource.UniqueId()),
@@ -130,7 +130,7 @@ func resourceAwsServiceCatalogConstraintCreate(d *schema.ResourceData, meta inte
 }
 
 func resourceAwsServiceCatalogConstraintRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).scconn
+	conn := meta.(*AWSClient).scconn()
 
 	output, err := waiter.ConstraintReady(conn, d.Get("accept_language").(string), d.Id())
 
@@ -171,7 +171,7 @@ func resourceAwsServiceCatalogConstraintRead(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsServiceCatalogConstraintUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).scconn
+	conn := meta.(*AWSClient).scconn()
 
 	input := &servicecatalog.UpdateConstraintInput{
 		Id: aws.String(d.Id()),
@@ -215,7 +215,7 @@ func resourceAwsServiceCatalogConstraintUpdate(d *schema.ResourceData, meta inte
 }
 
 func resourceAwsServiceCatalogConstraintDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).scconn
+	conn := meta.(*AWSClient).scconn()
 
 	input := &servicecatalog.DeleteConstraintInput{
 		Id: aws.String(d.Id()),
@@ -31,7 +31,7 @@ func testSweepServiceCatalogConstraints(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*AWSClient).scconn
+	conn := client.(*AWSClient).scconn()
 	sweepResources := make([]*testSweepResource, 0)
 	var errs *multierror.Error
 
@@ -176,7 +176,7 @@ func TestAccAWSServiceCatalogConstraint_update(t *testing.T) {
 }
 
 func testAccCheckAwsServiceCatalogConstraintDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).scconn
+	conn := testAccProvider.Meta().(*AWSClient).scconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_servicecatalog_constraint" {
@@ -213,7 +213,7 @@ func testAccCheckAwsServiceCatalogConstraintExists(resourceName string) resource
 			return fmt.Errorf("resource not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).scconn
+		conn := testAccProvider.Meta().(*AWSClient).scconn()
 
 		input := &servicecatalog.DescribeConstraintInput{
 			Id: aws.String(rs.Primary.ID),
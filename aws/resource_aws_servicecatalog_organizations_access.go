@@ -27,7 +27,7 @@ func resourceAwsServiceCatalogOrganizationsAccess() *schema.Resource {
 }
 
 func resourceAwsServiceCatalogOrganizationsAccessCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).scconn
+	conn := meta.(*AWSClient).scconn()
 
 	d.SetId(meta.(*AWSClient).accountid)
 
@@ -54,7 +54,7 @@ func resourceAwsServiceCatalogOrganizationsAccessCreate(d *schema.ResourceData,
 }
 
 func resourceAwsServiceCatalogOrganizationsAccessRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).scconn
+	conn := meta.(*AWSClient).scconn()
 
 	output, err := waiter.OrganizationsAccessStable(conn)
 
@@ -83,7 +83,7 @@ func resourceAwsServiceCatalogOrganizationsAccessRead(d *schema.ResourceData, me
 }
 
 func resourceAwsServiceCatalogOrganizationsAccessDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).scconn
+	conn := meta.(*AWSClient).scconn()
 
 	// During create, if enabled = "true", then Enable Access and vice versa
 	// During delete, the opposite
@@ -35,7 +35,7 @@ func TestAccAWSServiceCatalogOrganizationsAccess_basic(t *testing.T) {
 }
 
 func testAccCheckAwsServiceCatalogOrganizationsAccessDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).scconn
+	conn := testAccProvider.Meta().(*AWSClient).scconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_servicecatalog_organizations_access" {
@@ -66,7 +66,7 @@ func testAccCheckAwsServiceCatalogOrganizationsAccessExists(resourceName string)
 			return fmt.Errorf("resource not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).scconn
+		conn := testAccProvider.Meta().(*AWSClient).scconn()
 
 		output, err := waiter.OrganizationsAccessStable(conn)
 
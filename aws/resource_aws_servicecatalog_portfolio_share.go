@@ -84,7 +84,7 @@ func resourceAwsServiceCatalogPortfolioShare() *schema.Resource {
 }
 
 func resourceAwsServiceCatalogPortfolioShareCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).scconn
+	conn := meta.(*AWSClient).scconn()
 
 	input := &servicecatalog.CreatePortfolioShareInput{
 		PortfolioId: aws.String(d.Get("portfolio_id").(string)),
@@ -165,7 +165,7 @@ func resourceAwsServiceCatalogPortfolioShareCreate(d *schema.ResourceData, meta
 }
 
 func resourceAwsServiceCatalogPortfolioShareRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).scconn
+	conn := meta.(*AWSClient).scconn()
 
 	portfolioID, shareType, principalID, err := tfservicecatalog.PortfolioShareParseResourceID(d.Id())
 
@@ -205,7 +205,7 @@ func resourceAwsServiceCatalogPortfolioShareRead(d *schema.ResourceData, meta in
 }
 
 func resourceAwsServiceCatalogPortfolioShareUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).scconn
+	conn := meta.(*AWSClient).scconn()
 
 	if d.HasChanges("accept_language", "share_tag_options") {
 		input := &servicecatalog.UpdatePortfolioShareInput{
@@ -247,7 +247,7 @@ func resourceAwsServiceCatalogPortfolioShareUpdate(d *schema.ResourceData, meta
 }
 
 func resourceAwsServiceCatalogPortfolioShareDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).scconn
+	conn := meta.(*AWSClient).scconn()
 
 	input := &servicecatalog.DeletePortfolioShareInput{
 		PortfolioId: aws.String(d.Get("portfolio_id").(string)),
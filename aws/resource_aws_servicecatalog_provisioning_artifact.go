@@ -98,7 +98,7 @@ func resourceAwsServiceCatalogProvisioningArtifact() *schema.Resource {
 }
 
 func resourceAwsServiceCatalogProvisioningArtifactCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).scconn
+	conn := meta.(*AWSClient).scconn()
 
 	parameters := make(map[string]interface{})
 	parameters["description"] = d.Get("description")
@@ -156,7 +156,7 @@ func resourceAwsServiceCatalogProvisioningArtifactCreate(d *schema.ResourceData,
 }
 
 func resourceAwsServiceCatalogProvisioningArtifactRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).scconn
+	conn := meta.(*AWSClient).scconn()
 
 	artifactID, productID, err := tfservicecatalog.ProvisioningArtifactParseID(d.Id())
 
@@ -204,7 +204,7 @@ func resourceAwsServiceCatalogProvisioningArtifactRead(d *schema.ResourceData, m
 }
 
 func resourceAwsServiceCatalogProvisioningArtifactUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).scconn
+	conn := meta.(*AWSClient).scconn()
 
 	if d.HasChanges("accept_language", "active", "description", "guidance", "name", "product_id") {
 		artifactID, productID, err := tfservicecatalog.ProvisioningArtifactParseID(d.Id())
@@ -262,7 +262,7 @@ func resourceAwsServiceCatalogProvisioningArtifactUpdate(d *schema.ResourceData,
 }
 
 func resourceAwsServiceCatalogProvisioningArtifactDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).scconn
+	conn := meta.(*AWSClient).scconn()
 
 	artifactID, productID, err := tfservicecatalog.ProvisioningArtifactParseID(d.Id())
 
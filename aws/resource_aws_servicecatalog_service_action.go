@@ -81,7 +81,7 @@ func resourceAwsServiceCatalogServiceAction() *schema.Resource {
 }
 
 func resourceAwsServiceCatalogServiceActionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).scconn
+	conn := meta.(*AWSClient).scconn()
 
 	input := &servicecatalog.CreateServiceActionInput{
 		IdempotencyToken: aws.String(resource.UniqueId()),
@@ -133,7 +133,7 @@ func resourceAwsServiceCatalogServiceActionCreate(d *schema.ResourceData, meta i
 }
 
 func resourceAwsServiceCatalogServiceActionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).scconn
+	conn := meta.(*AWSClient).scconn()
 
 	output, err := waiter.ServiceActionReady(conn, d.Get("accept_language").(string), d.Id())
 
@@ -166,7 +166,7 @@ func resourceAwsServiceCatalogServiceActionRead(d *schema.ResourceData, meta int
 }
 
 func resourceAwsServiceCatalogServiceActionUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).scconn
+	conn := meta.(*AWSClient).scconn()
 
 	input := &servicecatalog.UpdateServiceActionInput{
 		Id: aws.String(d.Id()),
@@ -214,7 +214,7 @@ func resourceAwsServiceCatalogServiceActionUpdate(d *schema.ResourceData, meta i
 }
 
 func resourceAwsServiceCatalogServiceActionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).scconn
+	conn := meta.(*AWSClient).scconn()
 
 	input := &servicecatalog.DeleteServiceActionInput{
 		Id: aws.String(d.Id()),
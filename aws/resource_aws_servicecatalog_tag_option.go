@@ -48,7 +48,7 @@ func resourceAwsServiceCatalogTagOption() *schema.Resource {
 }
 
 func resourceAwsServiceCatalogTagOptionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).scconn
+	conn := meta.(*AWSClient).scconn()
 
 	input := &servicecatalog.CreateTagOptionInput{
 		Key:   aws.String(d.Get("key").(string)),
@@ -104,7 +104,7 @@ func resourceAwsServiceCatalogTagOptionCreate(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsServiceCatalogTagOptionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).scconn
+	conn := meta.(*AWSClient).scconn()
 
 	output, err := waiter.TagOptionReady(conn, d.Id())
 
@@ -131,7 +131,7 @@ func resourceAwsServiceCatalogTagOptionRead(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsServiceCatalogTagOptionUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).scconn
+	conn := meta.(*AWSClient).scconn()
 
 	input := &servicecatalog.UpdateTagOptionInput{
 		Id: aws.String(d.Id()),
@@ -174,7 +174,7 @@ func resourceAwsServiceCatalogTagOptionUpdate(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsServiceCatalogTagOptionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).scconn
+	conn := meta.(*AWSClient).scconn()
 
 	input := &servicecatalog.DeleteTagOptionInput{
 		Id: aws.String(d.Id()),
@@ -155,7 +155,7 @@ func TestAccAwsServiceQuotasServiceQuota_PermissionError(t *testing.T) {
 }
 
 func testAccPreCheckAWSServiceQuotas(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).servicequotasconn
+	conn := testAccProvider.Meta().(*AWSClient).servicequotasconn()
 
 	input := &servicequotas.ListServicesInput{}
 
@@ -44,7 +44,7 @@ func resourceAwsShieldProtection() *schema.Resource {
 }
 
 func resourceAwsShieldProtectionUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).shieldconn
+	conn := meta.(*AWSClient).shieldconn()
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
@@ -57,7 +57,7 @@ func resourceAwsShieldProtectionUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsShieldProtectionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).shieldconn
+	conn := meta.(*AWSClient).shieldconn()
 
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
@@ -77,7 +77,7 @@ func resourceAwsShieldProtectionCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsShieldProtectionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).shieldconn
+	conn := meta.(*AWSClient).shieldconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
@@ -123,7 +123,7 @@ func resourceAwsShieldProtectionRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsShieldProtectionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).shieldconn
+	conn := meta.(*AWSClient).shieldconn()
 
 	input := &shield.DeleteProtectionInput{
 		ProtectionId: aws.String(d.Id()),
@@ -70,7 +70,7 @@ func resourceAwsShieldProtectionGroup() *schema.Resource {
 }
 
 func resourceAwsShieldProtectionGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).shieldconn
+	conn := meta.(*AWSClient).shieldconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 
@@ -103,7 +103,7 @@ func resourceAwsShieldProtectionGroupCreate(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsShieldProtectionGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).shieldconn
+	conn := meta.(*AWSClient).shieldconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
@@ -158,7 +158,7 @@ func resourceAwsShieldProtectionGroupRead(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsShieldProtectionGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).shieldconn
+	conn := meta.(*AWSClient).shieldconn()
 
 	input := &shield.UpdateProtectionGroupInput{
 		Aggregation:       aws.String(d.Get("aggregation").(string)),
@@ -192,7 +192,7 @@ func resourceAwsShieldProtectionGroupUpdate(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsShieldProtectionGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).shieldconn
+	conn := meta.(*AWSClient).shieldconn()
 
 	log.Printf("[DEBUG] Deletinh Shield Protection Group: %s", d.Id())
 	_, err := conn.DeleteProtectionGroup(&shield.DeleteProtectionGroupInput{
@@ -236,7 +236,7 @@ func TestAccAWSShieldProtectionGroup_resourceType(t *testing.T) {
 }
 
 func testAccCheckAWSShieldProtectionGroupDestroy(s *terraform.State) error {
-	shieldconn := testAccProvider.Meta().(*AWSClient).shieldconn
+	shieldconn := testAccProvider.Meta().(*AWSClient).shieldconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_shield_protection_group" {
@@ -272,7 +272,7 @@ func testAccCheckAWSShieldProtectionGroupExists(name string) resource.TestCheckF
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).shieldconn
+		conn := testAccProvider.Meta().(*AWSClient).shieldconn()
 
 		input := &shield.DescribeProtectionGroupInput{
 			ProtectionGroupId: aws.String(rs.Primary.ID),
@@ -122,7 +122,7 @@ func resourceAwsSignerSigningProfile() *schema.Resource {
 }
 
 func resourceAwsSignerSigningProfileCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).signerconn
+	conn := meta.(*AWSClient).signerconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 
@@ -159,7 +159,7 @@ func resourceAwsSignerSigningProfileCreate(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsSignerSigningProfileRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).signerconn
+	conn := meta.(*AWSClient).signerconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
@@ -233,7 +233,7 @@ func resourceAwsSignerSigningProfileRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsSignerSigningProfileUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).signerconn
+	conn := meta.(*AWSClient).signerconn()
 
 	arn := d.Get("arn").(string)
 	if d.HasChange("tags_all") {
@@ -248,7 +248,7 @@ func resourceAwsSignerSigningProfileUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsSignerSigningProfileDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).signerconn
+	conn := meta.(*AWSClient).signerconn()
 
 	_, err := conn.CancelSigningProfile(&signer.CancelSigningProfileInput{
 		ProfileName: aws.String(d.Id()),
@@ -75,7 +75,7 @@ func resourceAwsSignerSigningProfilePermission() *schema.Resource {
 }
 
 func resourceAwsSignerSigningProfilePermissionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).signerconn
+	conn := meta.(*AWSClient).signerconn()
 
 	profileName := d.Get("profile_name").(string)
 
@@ -163,7 +163,7 @@ func resourceAwsSignerSigningProfilePermissionCreate(d *schema.ResourceData, met
 }
 
 func resourceAwsSignerSigningProfilePermissionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).signerconn
+	conn := meta.(*AWSClient).signerconn()
 
 	listProfilePermissionsInput := &signer.ListProfilePermissionsInput{
 		ProfileName: aws.String(d.Get("profile_name").(string)),
@@ -237,7 +237,7 @@ func getProfilePermission(permissions []*signer.Permission, statementId string)
 }
 
 func resourceAwsSignerSigningProfilePermissionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).signerconn
+	conn := meta.(*AWSClient).signerconn()
 
 	profileName := d.Get("profile_name").(string)
 
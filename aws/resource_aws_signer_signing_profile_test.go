@@ -154,7 +154,7 @@ func TestAccAWSSignerSigningProfile_SignatureValidityPeriod(t *testing.T) {
 }
 
 func testAccPreCheckSingerSigningProfile(t *testing.T, platformID string) {
-	conn := testAccProvider.Meta().(*AWSClient).signerconn
+	conn := testAccProvider.Meta().(*AWSClient).signerconn()
 
 	input := &signer.ListSigningPlatformsInput{}
 
@@ -274,7 +274,7 @@ func testAccCheckAWSSignerSigningProfileExists(res string, sp *signer.GetSigning
 			return fmt.Errorf("Signing Profile with that ARN does not exist")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).signerconn
+		conn := testAccProvider.Meta().(*AWSClient).signerconn()
 
 		params := &signer.GetSigningProfileInput{
 			ProfileName: aws.String(rs.Primary.ID),
@@ -292,7 +292,7 @@ func testAccCheckAWSSignerSigningProfileExists(res string, sp *signer.GetSigning
 }
 
 func testAccCheckAWSSignerSigningProfileDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).signerconn
+	conn := testAccProvider.Meta().(*AWSClient).signerconn()
 
 	time.Sleep(5 * time.Second)
 
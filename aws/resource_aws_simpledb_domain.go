@@ -30,7 +30,7 @@ func resourceAwsSimpleDBDomain() *schema.Resource {
 }
 
 func resourceAwsSimpleDBDomainCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).simpledbconn
+	conn := meta.(*AWSClient).simpledbconn()
 
 	name := d.Get("name").(string)
 	input := &simpledb.CreateDomainInput{
@@ -46,7 +46,7 @@ func resourceAwsSimpleDBDomainCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsSimpleDBDomainRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).simpledbconn
+	conn := meta.(*AWSClient).simpledbconn()
 
 	input := &simpledb.DomainMetadataInput{
 		DomainName: aws.String(d.Id()),
@@ -68,7 +68,7 @@ func resourceAwsSimpleDBDomainRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsSimpleDBDomainDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).simpledbconn
+	conn := meta.(*AWSClient).simpledbconn()
 
 	input := &simpledb.DeleteDomainInput{
 		DomainName: aws.String(d.Id()),
@@ -92,7 +92,7 @@ var smsAttributeDefaultValues = map[string]string{
 }
 
 func resourceAwsSnsSmsPreferencesSet(d *schema.ResourceData, meta interface{}) error {
-	snsconn := meta.(*AWSClient).snsconn
+	snsconn := meta.(*AWSClient).snsconn()
 
 	log.Printf("[DEBUG] SNS Set SMS preferences")
 
@@ -124,7 +124,7 @@ func resourceAwsSnsSmsPreferencesSet(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsSnsSmsPreferencesGet(d *schema.ResourceData, meta interface{}) error {
-	snsconn := meta.(*AWSClient).snsconn
+	snsconn := meta.(*AWSClient).snsconn()
 
 	// Fetch ALL attributes
 	attrs, err := snsconn.GetSMSAttributes(&sns.GetSMSAttributesInput{})
@@ -149,7 +149,7 @@ func resourceAwsSnsSmsPreferencesGet(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsSnsSmsPreferencesDelete(d *schema.ResourceData, meta interface{}) error {
-	snsconn := meta.(*AWSClient).snsconn
+	snsconn := meta.(*AWSClient).snsconn()
 
 	// Reset the attributes to their default value
 	attrs := map[string]*string{}
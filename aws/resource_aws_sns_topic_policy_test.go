@@ -127,7 +127,7 @@ func TestAccAWSSNSTopicPolicy_disappears(t *testing.T) {
 }
 
 func testAccCheckAWSSNSTopicPolicyDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).snsconn
+	conn := testAccProvider.Meta().(*AWSClient).snsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_sns_topic_policy" {
@@ -41,7 +41,7 @@ func testSweepSqsQueues(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).sqsconn
+	conn := client.(*AWSClient).sqsconn()
 	input := &sqs.ListQueuesInput{}
 	var sweeperErrs *multierror.Error
 
@@ -728,7 +728,7 @@ func testAccCheckAWSSQSQueueExists(resourceName string, v *map[string]string) re
 			return fmt.Errorf("No SQS Queue URL is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).sqsconn
+		conn := testAccProvider.Meta().(*AWSClient).sqsconn()
 
 		output, err := finder.QueueAttributesByURL(conn, rs.Primary.ID)
 
@@ -743,7 +743,7 @@ func testAccCheckAWSSQSQueueExists(resourceName string, v *map[string]string) re
 }
 
 func testAccCheckAWSSQSQueueDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).sqsconn
+	conn := testAccProvider.Meta().(*AWSClient).sqsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_sqs_queue" {
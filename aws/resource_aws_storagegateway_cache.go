@@ -37,7 +37,7 @@ func resourceAwsStorageGatewayCache() *schema.Resource {
 }
 
 func resourceAwsStorageGatewayCacheCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).storagegatewayconn
+	conn := meta.(*AWSClient).storagegatewayconn()
 
 	diskID := d.Get("disk_id").(string)
 	gatewayARN := d.Get("gateway_arn").(string)
@@ -87,7 +87,7 @@ func resourceAwsStorageGatewayCacheCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsStorageGatewayCacheRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).storagegatewayconn
+	conn := meta.(*AWSClient).storagegatewayconn()
 
 	gatewayARN, diskID, err := decodeStorageGatewayCacheID(d.Id())
 	if err != nil {
@@ -93,7 +93,7 @@ func resourceAwsStorageGatewayFileSystemAssociation() *schema.Resource {
 }
 
 func resourceAwsStorageGatewayFileSystemAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).storagegatewayconn
+	conn := meta.(*AWSClient).storagegatewayconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 
@@ -131,7 +131,7 @@ func resourceAwsStorageGatewayFileSystemAssociationCreate(d *schema.ResourceData
 }
 
 func resourceAwsStorageGatewayFileSystemAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).storagegatewayconn
+	conn := meta.(*AWSClient).storagegatewayconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
@@ -172,7 +172,7 @@ func resourceAwsStorageGatewayFileSystemAssociationRead(d *schema.ResourceData,
 }
 
 func resourceAwsStorageGatewayFileSystemAssociationUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).storagegatewayconn
+	conn := meta.(*AWSClient).storagegatewayconn()
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
@@ -209,7 +209,7 @@ func resourceAwsStorageGatewayFileSystemAssociationUpdate(d *schema.ResourceData
 }
 
 func resourceAwsStorageGatewayFileSystemAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).storagegatewayconn
+	conn := meta.(*AWSClient).storagegatewayconn()
 
 	input := &storagegateway.DisassociateFileSystemInput{
 		FileSystemAssociationARN: aws.String(d.Id()),
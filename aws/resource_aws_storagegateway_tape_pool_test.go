@@ -149,7 +149,7 @@ func testAccCheckAWSStorageGatewayTapePoolExists(resourceName string, TapePool *
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).storagegatewayconn
+		conn := testAccProvider.Meta().(*AWSClient).storagegatewayconn()
 
 		input := &storagegateway.ListTapePoolsInput{
 			PoolARNs: []*string{aws.String(rs.Primary.ID)},
@@ -172,7 +172,7 @@ func testAccCheckAWSStorageGatewayTapePoolExists(resourceName string, TapePool *
 }
 
 func testAccCheckAWSStorageGatewayTapePoolDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).storagegatewayconn
+	conn := testAccProvider.Meta().(*AWSClient).storagegatewayconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_storagegateway_tape_pool" {
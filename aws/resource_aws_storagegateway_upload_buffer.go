@@ -47,7 +47,7 @@ func resourceAwsStorageGatewayUploadBuffer() *schema.Resource {
 }
 
 func resourceAwsStorageGatewayUploadBufferCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).storagegatewayconn
+	conn := meta.(*AWSClient).storagegatewayconn()
 
 	input := &storagegateway.AddUploadBufferInput{}
 
@@ -96,7 +96,7 @@ func resourceAwsStorageGatewayUploadBufferCreate(d *schema.ResourceData, meta in
 }
 
 func resourceAwsStorageGatewayUploadBufferRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).storagegatewayconn
+	conn := meta.(*AWSClient).storagegatewayconn()
 
 	gatewayARN, diskID, err := decodeStorageGatewayUploadBufferID(d.Id())
 	if err != nil {
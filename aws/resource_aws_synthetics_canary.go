@@ -223,7 +223,7 @@ func resourceAwsSyntheticsCanary() *schema.Resource {
 }
 
 func resourceAwsSyntheticsCanaryCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).syntheticsconn
+	conn := meta.(*AWSClient).syntheticsconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 
@@ -312,7 +312,7 @@ func resourceAwsSyntheticsCanaryCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsSyntheticsCanaryRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).syntheticsconn
+	conn := meta.(*AWSClient).syntheticsconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
@@ -378,7 +378,7 @@ func resourceAwsSyntheticsCanaryRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsSyntheticsCanaryUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).syntheticsconn
+	conn := meta.(*AWSClient).syntheticsconn()
 
 	if d.HasChangesExcept("tags", "tags_all", "start_canary") {
 		input := &synthetics.UpdateCanaryInput{
@@ -484,7 +484,7 @@ func resourceAwsSyntheticsCanaryUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsSyntheticsCanaryDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).syntheticsconn
+	conn := meta.(*AWSClient).syntheticsconn()
 
 	if status := d.Get("status").(string); status == synthetics.CanaryStateRunning {
 		if err := syntheticsStopCanary(d.Id(), conn); err != nil {
@@ -36,7 +36,7 @@ func testSweepSyntheticsCanaries(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).syntheticsconn
+	conn := client.(*AWSClient).syntheticsconn()
 	input := &synthetics.DescribeCanariesInput{}
 	var sweeperErrs *multierror.Error
 
@@ -523,7 +523,7 @@ func TestAccAWSSyntheticsCanary_disappears(t *testing.T) {
 }
 
 func testAccCheckAwsSyntheticsCanaryDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).syntheticsconn
+	conn := testAccProvider.Meta().(*AWSClient).syntheticsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_synthetics_canary" {
@@ -557,7 +557,7 @@ func testAccCheckAwsSyntheticsCanaryExists(n string, canary *synthetics.Canary)
 			return fmt.Errorf("No Synthetics Canary ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).syntheticsconn
+		conn := testAccProvider.Meta().(*AWSClient).syntheticsconn()
 
 		output, err := finder.CanaryByName(conn, rs.Primary.ID)
 
@@ -582,7 +582,7 @@ func testAccCheckAwsSyntheticsCanaryDeleteImplicitResources(n string) resource.T
 			return fmt.Errorf("synthetics Canary name not set")
 		}
 
-		lambdaConn := testAccProvider.Meta().(*AWSClient).lambdaconn
+		lambdaConn := testAccProvider.Meta().(*AWSClient).lambdaconn()
 
 		layerArn := rs.Primary.Attributes["source_location_arn"]
 		layerArnObj, err := arn.Parse(layerArn)
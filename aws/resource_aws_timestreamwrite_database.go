@@ -68,7 +68,7 @@ func resourceAwsTimestreamWriteDatabase() *schema.Resource {
 }
 
 func resourceAwsTimestreamWriteDatabaseCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).timestreamwriteconn
+	conn := meta.(*AWSClient).timestreamwriteconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 
@@ -102,7 +102,7 @@ func resourceAwsTimestreamWriteDatabaseCreate(ctx context.Context, d *schema.Res
 }
 
 func resourceAwsTimestreamWriteDatabaseRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).timestreamwriteconn
+	conn := meta.(*AWSClient).timestreamwriteconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
@@ -155,7 +155,7 @@ func resourceAwsTimestreamWriteDatabaseRead(ctx context.Context, d *schema.Resou
 }
 
 func resourceAwsTimestreamWriteDatabaseUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).timestreamwriteconn
+	conn := meta.(*AWSClient).timestreamwriteconn()
 
 	if d.HasChange("kms_key_id") {
 		input := &timestreamwrite.UpdateDatabaseInput{
@@ -182,7 +182,7 @@ func resourceAwsTimestreamWriteDatabaseUpdate(ctx context.Context, d *schema.Res
 }
 
 func resourceAwsTimestreamWriteDatabaseDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).timestreamwriteconn
+	conn := meta.(*AWSClient).timestreamwriteconn()
 
 	input := &timestreamwrite.DeleteDatabaseInput{
 		DatabaseName: aws.String(d.Id()),
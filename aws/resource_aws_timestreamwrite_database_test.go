@@ -30,7 +30,7 @@ func testSweepTimestreamWriteDatabases(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).timestreamwriteconn
+	conn := client.(*AWSClient).timestreamwriteconn()
 	ctx := context.Background()
 
 	var sweeperErrs *multierror.Error
@@ -254,7 +254,7 @@ func TestAccAWSTimestreamWriteDatabase_Tags(t *testing.T) {
 }
 
 func testAccCheckAWSTimestreamWriteDatabaseDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).timestreamwriteconn
+	conn := testAccProvider.Meta().(*AWSClient).timestreamwriteconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_timestreamwrite_database" {
@@ -292,7 +292,7 @@ func testAccCheckAWSTimestreamWriteDatabaseExists(n string) resource.TestCheckFu
 			return fmt.Errorf("no resource ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).timestreamwriteconn
+		conn := testAccProvider.Meta().(*AWSClient).timestreamwriteconn()
 
 		output, err := conn.DescribeDatabase(&timestreamwrite.DescribeDatabaseInput{
 			DatabaseName: aws.String(rs.Primary.ID),
@@ -311,7 +311,7 @@ func testAccCheckAWSTimestreamWriteDatabaseExists(n string) resource.TestCheckFu
 }
 
 func testAccPreCheckAWSTimestreamWrite(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).timestreamwriteconn
+	conn := testAccProvider.Meta().(*AWSClient).timestreamwriteconn()
 
 	input := &timestreamwrite.ListDatabasesInput{}
 
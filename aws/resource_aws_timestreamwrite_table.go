@@ -85,7 +85,7 @@ func resourceAwsTimestreamWriteTable() *schema.Resource {
 }
 
 func resourceAwsTimestreamWriteTableCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).timestreamwriteconn
+	conn := meta.(*AWSClient).timestreamwriteconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 
@@ -119,7 +119,7 @@ func resourceAwsTimestreamWriteTableCreate(ctx context.Context, d *schema.Resour
 }
 
 func resourceAwsTimestreamWriteTableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).timestreamwriteconn
+	conn := meta.(*AWSClient).timestreamwriteconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
@@ -179,7 +179,7 @@ func resourceAwsTimestreamWriteTableRead(ctx context.Context, d *schema.Resource
 }
 
 func resourceAwsTimestreamWriteTableUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).timestreamwriteconn
+	conn := meta.(*AWSClient).timestreamwriteconn()
 
 	if d.HasChange("retention_properties") {
 		tableName, databaseName, err := resourceAwsTimestreamWriteTableParseId(d.Id())
@@ -213,7 +213,7 @@ func resourceAwsTimestreamWriteTableUpdate(ctx context.Context, d *schema.Resour
 }
 
 func resourceAwsTimestreamWriteTableDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*AWSClient).timestreamwriteconn
+	conn := meta.(*AWSClient).timestreamwriteconn()
 
 	tableName, databaseName, err := resourceAwsTimestreamWriteTableParseId(d.Id())
 
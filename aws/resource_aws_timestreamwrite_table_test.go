@@ -28,7 +28,7 @@ func testSweepTimestreamWriteTables(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).timestreamwriteconn
+	conn := client.(*AWSClient).timestreamwriteconn()
 	ctx := context.Background()
 
 	var sweeperErrs *multierror.Error
@@ -235,7 +235,7 @@ func TestAccAWSTimestreamWriteTable_Tags(t *testing.T) {
 }
 
 func testAccCheckAWSTimestreamWriteTableDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).timestreamwriteconn
+	conn := testAccProvider.Meta().(*AWSClient).timestreamwriteconn()
 	ctx := context.Background()
 
 	for _, rs := range s.RootModule().Resources {
@@ -289,7 +289,7 @@ func testAccCheckAWSTimestreamWriteTableExists(n string) resource.TestCheckFunc
 			return err
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).timestreamwriteconn
+		conn := testAccProvider.Meta().(*AWSClient).timestreamwriteconn()
 
 		input := &timestreamwrite.DescribeTableInput{
 			DatabaseName: aws.String(dbName),
@@ -114,7 +114,7 @@ func resourceAwsTransferAccess() *schema.Resource {
 }
 
 func resourceAwsTransferAccessCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).transferconn
+	conn := meta.(*AWSClient).transferconn()
 
 	externalID := d.Get("external_id").(string)
 	serverID := d.Get("server_id").(string)
@@ -161,7 +161,7 @@ func resourceAwsTransferAccessCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsTransferAccessRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).transferconn
+	conn := meta.(*AWSClient).transferconn()
 
 	serverID, externalID, err := tftransfer.AccessParseResourceID(d.Id())
 
@@ -199,7 +199,7 @@ func resourceAwsTransferAccessRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsTransferAccessUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).transferconn
+	conn := meta.(*AWSClient).transferconn()
 
 	serverID, externalID, err := tftransfer.AccessParseResourceID(d.Id())
 
@@ -247,7 +247,7 @@ func resourceAwsTransferAccessUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsTransferAccessDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).transferconn
+	conn := meta.(*AWSClient).transferconn()
 
 	serverID, externalID, err := tftransfer.AccessParseResourceID(d.Id())
 
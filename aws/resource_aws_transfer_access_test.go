@@ -176,7 +176,7 @@ func testAccCheckAWSTransferAccessExists(n string, v *transfer.DescribedAccess)
 			return fmt.Errorf("error parsing Transfer Access ID: %w", err)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).transferconn
+		conn := testAccProvider.Meta().(*AWSClient).transferconn()
 
 		output, err := finder.AccessByServerIDAndExternalID(conn, serverID, externalID)
 
@@ -191,7 +191,7 @@ func testAccCheckAWSTransferAccessExists(n string, v *transfer.DescribedAccess)
 }
 
 func testAccCheckAWSTransferAccessDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).transferconn
+	conn := testAccProvider.Meta().(*AWSClient).transferconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_transfer_access" {
@@ -30,7 +30,7 @@ func testSweepTransferServers(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).transferconn
+	conn := client.(*AWSClient).transferconn()
 	input := &transfer.ListServersInput{}
 	sweepResources := make([]*testSweepResource, 0)
 
@@ -970,7 +970,7 @@ func testAccCheckAWSTransferServerExists(n string, v *transfer.DescribedServer)
 			return fmt.Errorf("No Transfer Server ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).transferconn
+		conn := testAccProvider.Meta().(*AWSClient).transferconn()
 
 		output, err := finder.ServerByID(conn, rs.Primary.ID)
 
@@ -985,7 +985,7 @@ func testAccCheckAWSTransferServerExists(n string, v *transfer.DescribedServer)
 }
 
 func testAccCheckAWSTransferServerDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).transferconn
+	conn := testAccProvider.Meta().(*AWSClient).transferconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_transfer_server" {
@@ -1009,7 +1009,7 @@ func testAccCheckAWSTransferServerDestroy(s *terraform.State) error {
 }
 
 func testAccPreCheckAWSTransfer(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).transferconn
+	conn := testAccProvider.Meta().(*AWSClient).transferconn()
 
 	input := &transfer.ListServersInput{}
 
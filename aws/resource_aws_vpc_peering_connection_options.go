@@ -40,7 +40,7 @@ func resourceAwsVpcPeeringConnectionOptionsCreate(d *schema.ResourceData, meta i
 }
 
 func resourceAwsVpcPeeringConnectionOptionsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	pc, err := vpcPeeringConnection(conn, d.Id())
 
@@ -67,7 +67,7 @@ func resourceAwsVpcPeeringConnectionOptionsRead(d *schema.ResourceData, meta int
 }
 
 func resourceAwsVpcPeeringConnectionOptionsUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	pc, err := vpcPeeringConnection(conn, d.Id())
 
@@ -72,7 +72,7 @@ func testAccCheckAWSVPNGatewayRoutePropagationExists(n string) resource.TestChec
 			return err
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 		err = finder.VpnGatewayRoutePropagationExists(conn, routeTableID, gatewayID)
 
@@ -96,7 +96,7 @@ func testAccCheckAWSVPNGatewayRoutePropagationDestroy(s *terraform.State) error
 			return err
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 		err = finder.VpnGatewayRoutePropagationExists(conn, routeTableID, gatewayID)
 
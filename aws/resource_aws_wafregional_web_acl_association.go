@@ -37,7 +37,7 @@ func resourceAwsWafRegionalWebAclAssociation() *schema.Resource {
 }
 
 func resourceAwsWafRegionalWebAclAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafregionalconn
+	conn := meta.(*AWSClient).wafregionalconn()
 
 	log.Printf(
 		"[INFO] Creating WAF Regional Web ACL association: %s => %s",
@@ -76,7 +76,7 @@ func resourceAwsWafRegionalWebAclAssociationCreate(d *schema.ResourceData, meta
 }
 
 func resourceAwsWafRegionalWebAclAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafregionalconn
+	conn := meta.(*AWSClient).wafregionalconn()
 
 	resourceArn := resourceAwsWafRegionalWebAclAssociationParseId(d.Id())
 
@@ -109,7 +109,7 @@ func resourceAwsWafRegionalWebAclAssociationRead(d *schema.ResourceData, meta in
 }
 
 func resourceAwsWafRegionalWebAclAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafregionalconn
+	conn := meta.(*AWSClient).wafregionalconn()
 
 	resourceArn := resourceAwsWafRegionalWebAclAssociationParseId(d.Id())
 
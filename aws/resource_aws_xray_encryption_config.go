@@ -40,7 +40,7 @@ func resourceAwsXrayEncryptionConfig() *schema.Resource {
 }
 
 func resourceAwsXrayEncryptionConfigPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).xrayconn
+	conn := meta.(*AWSClient).xrayconn()
 
 	input := &xray.PutEncryptionConfigInput{
 		Type: aws.String(d.Get("type").(string)),
@@ -65,7 +65,7 @@ func resourceAwsXrayEncryptionConfigPut(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsXrayEncryptionConfigRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).xrayconn
+	conn := meta.(*AWSClient).xrayconn()
 
 	config, err := conn.GetEncryptionConfig(&xray.GetEncryptionConfigInput{})
 
@@ -44,7 +44,7 @@ func resourceAwsXrayGroup() *schema.Resource {
 }
 
 func resourceAwsXrayGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).xrayconn
+	conn := meta.(*AWSClient).xrayconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 	input := &xray.CreateGroupInput{
@@ -64,7 +64,7 @@ func resourceAwsXrayGroupCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsXrayGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).xrayconn
+	conn := meta.(*AWSClient).xrayconn()
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
@@ -108,7 +108,7 @@ func resourceAwsXrayGroupRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsXrayGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).xrayconn
+	conn := meta.(*AWSClient).xrayconn()
 
 	if d.HasChange("filter_expression") {
 		input := &xray.UpdateGroupInput{
@@ -133,7 +133,7 @@ func resourceAwsXrayGroupUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsXrayGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).xrayconn
+	conn := meta.(*AWSClient).xrayconn()
 
 	log.Printf("[INFO] Deleting XRay Group: %s", d.Id())
 
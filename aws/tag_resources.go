@@ -1,5 +1,112 @@
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename accessanalyzer -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename acm -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename acmpca -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename amplify -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename apigatewayv2 -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename appconfig -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename appmesh -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename apprunner -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename appstream -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename appsync -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename athena -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename backup -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename batch -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename cloud9 -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename cloudfront -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename cloudhsmv2 -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename cloudtrail -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename cloudwatch -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename cloudwatchevents -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename cloudwatchlogs -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename codeartifact -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename codecommit -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename codedeploy -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename codepipeline -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename codestarconnections -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename codestarnotifications -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename cognitoidentity -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename cognitoidentityprovider -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename configservice -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename databasemigrationservice -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename dataexchange -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename datasync -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename dax -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename devicefarm -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename directconnect -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename directoryservice -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename dlm -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename docdb -docs
 //go:generate go run -tags generate internal/tagresource/generator/main.go -servicename dynamodb
 //go:generate go run -tags generate internal/tagresource/generator/main.go -servicename ec2
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename ecr -docs
 //go:generate go run -tags generate internal/tagresource/generator/main.go -servicename ecs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename efs -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename eks -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename elasticache -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename elasticbeanstalk -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename elasticsearchservice -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename elb -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename elbv2 -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename firehose -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename fsx -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename gamelift -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename glacier -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename globalaccelerator -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename glue -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename greengrass -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename guardduty -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename imagebuilder -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename iot -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename iotanalytics -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename iotevents -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename kafka -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename kinesis -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename kinesisanalytics -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename kinesisanalyticsv2 -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename kinesisvideo -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename kms -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename lambda -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename licensemanager -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename mediaconnect -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename medialive -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename mediapackage -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename mediastore -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename mq -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename neptune -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename networkfirewall -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename networkmanager -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename opsworks -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename organizations -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename pinpoint -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename qldb -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename quicksight -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename rds -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename resourcegroups -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename route53recoveryreadiness -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename route53resolver -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename sagemaker -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename schemas -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename securityhub -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename servicediscovery -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename sfn -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename shield -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename signer -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename sns -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename sqs -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename storagegateway -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename swf -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename timestreamwrite -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename transfer -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename waf -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename wafregional -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename wafv2 -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename worklink -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename workspaces -docs
+//go:generate go run -tags generate internal/tagresource/generator/main.go -servicename xray -docs
 
 package aws
+
+// Individual resource tag resources are generated for each service supported by the
+// keyvaluetags ListTags and UpdateTags functions, except for services whose tags also
+// require a resource type (autoscaling, route53, ssm, ssoadmin) and MediaConvert, which
+// requires an account-specific endpoint. Documentation is generated unless hand-written.
@@ -0,0 +1,82 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	multierror "github.com/hashicorp/go-multierror"
+)
+
+// testSweepTagResources removes tags with keys beginning with the acceptance test
+// resource prefix, as managed by the individual resource tag resources, from the
+// resources of the given ARN service namespace.
+func testSweepTagResources(region string, arnNamespace string) error {
+	client, err := sharedClientForRegion(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*AWSClient).resourcegroupstaggingapiconn()
+	input := &resourcegroupstaggingapi.GetResourcesInput{
+		ResourceTypeFilters: aws.StringSlice([]string{arnNamespace}),
+	}
+	var sweeperErrs *multierror.Error
+
+	err = conn.GetResourcesPages(input, func(page *resourcegroupstaggingapi.GetResourcesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, resourceTagMapping := range page.ResourceTagMappingList {
+			arn := aws.StringValue(resourceTagMapping.ResourceARN)
+			var keys []string
+
+			for _, tag := range resourceTagMapping.Tags {
+				if key := aws.StringValue(tag.Key); strings.HasPrefix(key, "tf-acc-test") {
+					keys = append(keys, key)
+				}
+			}
+
+			if len(keys) == 0 {
+				continue
+			}
+
+			log.Printf("[INFO] Deleting %s resource (%s) tags: %s", arnNamespace, arn, strings.Join(keys, ", "))
+
+			output, err := conn.UntagResources(&resourcegroupstaggingapi.UntagResourcesInput{
+				ResourceARNList: aws.StringSlice([]string{arn}),
+				TagKeys:         aws.StringSlice(keys),
+			})
+
+			if err == nil {
+				for _, failureInfo := range output.FailedResourcesMap {
+					err = fmt.Errorf("%s: %s", aws.StringValue(failureInfo.ErrorCode), aws.StringValue(failureInfo.ErrorMessage))
+				}
+			}
+
+			if err != nil {
+				sweeperErr := fmt.Errorf("error deleting %s resource (%s) tags: %w", arnNamespace, arn, err)
+				log.Printf("[ERROR] %s", sweeperErr)
+				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+				continue
+			}
+		}
+
+		return !lastPage
+	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping %s resource tags sweep for %s: %s", arnNamespace, region, err)
+		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing %s resource tags: %w", arnNamespace, err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
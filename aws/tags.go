@@ -2,10 +2,15 @@ package aws
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log"
+	"sort"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
@@ -93,15 +98,26 @@ func ec2TagSpecificationsFromKeyValueTags(tags keyvaluetags.KeyValueTags, t stri
 	}
 }
 
+// tagsResourceTypeContextKey is the context key of the resource type passed to CustomizeDiff functions.
+type tagsResourceTypeContextKey struct{}
+
 // SetTagsDiff sets the new plan difference with the result of
 // merging resource tags on to those defined at the provider-level;
 // returns an error if unsuccessful or if the resource tags are identical
 // to those configured at the provider-level to avoid non-empty plans
 // after resource READ operations as resource and provider-level tags
 // will be indistinguishable when returned from an AWS API.
-func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+// Resource tag keys are normalized to the casing of the provider-level
+// tag key normalization configuration, returning an error if several
+// resource tag keys normalize to the same key.
+// Also returns an error if the merged tags violate the provider-level
+// required tags configuration or the AWS Organizations tag policy,
+// when enforced with a level of "error".
+func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+	requiredTagsConfig := meta.(*AWSClient).RequiredTagsConfig
+	tagKeyCaseConfig := meta.(*AWSClient).TagKeyCaseConfig
 
 	resourceTags := keyvaluetags.New(diff.Get("tags").(map[string]interface{}))
 
@@ -109,7 +125,23 @@ func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{})
 		return fmt.Errorf(`"tags" are identical to those in the "default_tags" configuration block of the provider: please de-duplicate and try again`)
 	}
 
-	allTags := defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig)
+	resourceTags, collisions := resourceTags.NormalizeKeyCase(tagKeyCaseConfig)
+
+	if len(collisions) > 0 && diff.NewValueKnown("tags") {
+		return fmt.Errorf("%s tags collide when normalized by the provider tag_key_normalization configuration: %s", tagsDiffResourceName(ctx, diff), strings.Join(collisions, "; "))
+	}
+
+	mergedTags := defaultTagsConfig.MergeTags(resourceTags)
+
+	if err := checkRequiredTags(ctx, diff, requiredTagsConfig, mergedTags); err != nil {
+		return err
+	}
+
+	if err := checkTagPolicy(ctx, diff, meta.(*AWSClient), mergedTags); err != nil {
+		return err
+	}
+
+	allTags := mergedTags.IgnoreConfig(ignoreTagsConfig)
 
 	// To ensure "tags_all" is correctly computed, we explicitly set the attribute diff
 	// when the merger of resource-level tags onto provider-level tags results in n > 0 tags,
@@ -134,6 +166,247 @@ func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{})
 	return nil
 }
 
+// defaultTagsExcludeKeysSchema returns the schema of the argument, added to every resource
+// with a "tags_all" attribute, that excludes provider-level default tags from the resource.
+func defaultTagsExcludeKeysSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "Keys of tags in the provider default_tags configuration block not to apply to this resource",
+	}
+}
+
+// configureResourceDefaultTags adds the "default_tags_exclude_keys" argument to every resource with
+// a "tags_all" attribute and wraps the resource's CRUD and CustomizeDiff functions so that the
+// DefaultTagsConfig of the client passed to them excludes the resource's type, if excluded by
+// the provider configuration, and the resource's excluded keys. The context passed to
+// CustomizeDiff functions identifies the resource type. Tag keys read into the resource's
+// state are normalized by the provider tag key normalization configuration.
+func configureResourceDefaultTags(resources map[string]*schema.Resource) {
+	for resourceType, resource := range resources {
+		if _, ok := resource.Schema["tags_all"]; !ok {
+			continue
+		}
+
+		if _, ok := resource.Schema["default_tags_exclude_keys"]; ok {
+			continue
+		}
+
+		excludeKeysSchema := defaultTagsExcludeKeysSchema()
+
+		if resource.Update == nil && resource.UpdateContext == nil && resource.UpdateWithoutTimeout == nil {
+			excludeKeysSchema.ForceNew = true
+		}
+
+		resource.Schema["default_tags_exclude_keys"] = excludeKeysSchema
+
+		wrapResourceFuncs(resource, defaultTagsWrappers(resourceType))
+	}
+}
+
+func defaultTagsWrappers(resourceType string) resourceFuncWrappers {
+	return resourceFuncWrappers{
+		CRUD: func(operation string, f resourceCRUDFunc) resourceCRUDFunc {
+			return func(d *schema.ResourceData, meta interface{}) error {
+				priorTags := d.Get("tags").(map[string]interface{})
+
+				if err := f(d, defaultTagsResourceClient(meta, resourceType, d.Get("default_tags_exclude_keys"))); err != nil {
+					return err
+				}
+
+				if operation == "delete" {
+					return nil
+				}
+
+				return normalizeResourceDataTagKeyCase(d, meta, priorTags)
+			}
+		},
+		CRUDContext: func(operation string, f resourceCRUDContextFunc) resourceCRUDContextFunc {
+			return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+				priorTags := d.Get("tags").(map[string]interface{})
+
+				if diags := f(ctx, d, defaultTagsResourceClient(meta, resourceType, d.Get("default_tags_exclude_keys"))); diags.HasError() || operation == "delete" {
+					return diags
+				}
+
+				if err := normalizeResourceDataTagKeyCase(d, meta, priorTags); err != nil {
+					return diag.FromErr(err)
+				}
+
+				return nil
+			}
+		},
+		CustomizeDiff: func(f schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+			return func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+				ctx = context.WithValue(ctx, tagsResourceTypeContextKey{}, resourceType)
+
+				return f(ctx, diff, defaultTagsResourceClient(meta, resourceType, diff.Get("default_tags_exclude_keys")))
+			}
+		},
+	}
+}
+
+// defaultTagsResourceClient returns the client to pass to the functions of a resource of the given type,
+// whose DefaultTagsConfig excludes the given keys and the resource type, if excluded by the provider configuration.
+func defaultTagsResourceClient(meta interface{}, resourceType string, excludeKeys interface{}) interface{} {
+	client, ok := meta.(*AWSClient)
+
+	if !ok {
+		return meta
+	}
+
+	var keys keyvaluetags.KeyValueTags
+
+	if v, ok := excludeKeys.(*schema.Set); ok && v.Len() > 0 {
+		keys = keyvaluetags.New(v.List())
+	}
+
+	defaultTagsConfig := client.DefaultTagsConfig.ForResource(resourceType, keys)
+
+	if defaultTagsConfig == client.DefaultTagsConfig {
+		return meta
+	}
+
+	defaultKeys := defaultTagsConfig.GetTags().Keys()
+	sort.Strings(defaultKeys)
+	key := fmt.Sprintf("defaulttags/%s/%s", resourceType, strings.Join(defaultKeys, ","))
+
+	return client.resourceClient(key, func(v *AWSClient) {
+		v.DefaultTagsConfig = defaultTagsConfig
+	})
+}
+
+// normalizeResourceDataTagKeyCase normalizes the keys of the "tags_all" attribute in the resource's state
+// by the client's tag key normalization configuration, so that tag key casing changed by AWS services or
+// by earlier configurations does not cause differences when "tags_all" is planned.
+// Keys of the "tags" attribute keep the casing of the given prior (configured) tags, if case-insensitively equal.
+// Colliding tag keys are logged.
+func normalizeResourceDataTagKeyCase(d *schema.ResourceData, meta interface{}, priorTags map[string]interface{}) error {
+	client, ok := meta.(*AWSClient)
+
+	if !ok || client.TagKeyCaseConfig == nil || d.Id() == "" {
+		return nil
+	}
+
+	tagKeyCaseConfig := client.TagKeyCaseConfig
+
+	allTags, collisions := keyvaluetags.New(d.Get("tags_all").(map[string]interface{})).NormalizeKeyCase(tagKeyCaseConfig)
+
+	if len(collisions) > 0 {
+		log.Printf("[WARN] Resource (%s) tags collide when normalized by the provider tag_key_normalization configuration: %s", d.Id(), strings.Join(collisions, "; "))
+	}
+
+	if err := d.Set("tags_all", allTags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	priorKeys := make(map[string]string, len(priorTags))
+
+	for k := range priorTags {
+		priorKeys[tagKeyCaseConfig.CanonicalKey(k)] = k
+	}
+
+	tags, _ := keyvaluetags.New(d.Get("tags").(map[string]interface{})).NormalizeKeyCase(tagKeyCaseConfig)
+	result := make(map[string]string, len(tags))
+
+	for k, v := range tags.Map() {
+		if priorKey, ok := priorKeys[k]; ok {
+			k = priorKey
+		}
+
+		result[k] = v
+	}
+
+	if err := d.Set("tags", result); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+// checkRequiredTags returns an error naming the resource and the offending tag keys if the
+// tags violate a required tags configuration with an enforcement level of "error".
+// Violations of a configuration with an enforcement level of "warn" are logged.
+// Tags are not checked while the resource's tags are unknown.
+func checkRequiredTags(ctx context.Context, diff *schema.ResourceDiff, requiredTagsConfig *keyvaluetags.RequiredConfig, tags keyvaluetags.KeyValueTags) error {
+	if requiredTagsConfig == nil || !diff.NewValueKnown("tags") {
+		return nil
+	}
+
+	missing, invalid := requiredTagsConfig.Violations(tags)
+
+	if len(missing) == 0 && len(invalid) == 0 {
+		return nil
+	}
+
+	var violations []string
+
+	if len(missing) > 0 {
+		violations = append(violations, fmt.Sprintf("missing required tags: %s", strings.Join(missing, ", ")))
+	}
+
+	if len(invalid) > 0 {
+		violations = append(violations, fmt.Sprintf("tag values not allowed for keys: %s", strings.Join(invalid, ", ")))
+	}
+
+	message := fmt.Sprintf("%s does not satisfy the provider required_tags configuration: %s", tagsDiffResourceName(ctx, diff), strings.Join(violations, "; "))
+
+	if requiredTagsConfig.EnforcementLevel == keyvaluetags.RequiredEnforcementLevelWarn {
+		log.Printf("[WARN] %s", message)
+		return nil
+	}
+
+	return errors.New(message)
+}
+
+// checkTagPolicy returns an error naming the resource and its non-compliant tags if the tags
+// do not comply with the AWS Organizations tag policy in effect for the account and
+// compliance is enforced with a level of "error". Non-compliance at a level of "warn" is logged.
+// Tags are not checked while the resource's tags are unknown.
+func checkTagPolicy(ctx context.Context, diff *schema.ResourceDiff, client *AWSClient, tags keyvaluetags.KeyValueTags) error {
+	if client.tagPolicyCompliance == nil || !diff.NewValueKnown("tags") {
+		return nil
+	}
+
+	policy, err := client.tagPolicyCompliance.effectiveTagPolicy(client.organizationsconn())
+
+	if err != nil {
+		return err
+	}
+
+	violations := policy.Violations(tags)
+
+	if len(violations) == 0 {
+		return nil
+	}
+
+	message := fmt.Sprintf("%s does not comply with the AWS Organizations tag policy: %s", tagsDiffResourceName(ctx, diff), strings.Join(violations, "; "))
+
+	if client.tagPolicyCompliance.EnforcementLevel == keyvaluetags.RequiredEnforcementLevelWarn {
+		log.Printf("[WARN] %s", message)
+		return nil
+	}
+
+	return errors.New(message)
+}
+
+// tagsDiffResourceName returns a description of the resource being planned,
+// its type and its ID, if it exists, for use in error messages.
+func tagsDiffResourceName(ctx context.Context, diff *schema.ResourceDiff) string {
+	resource, ok := ctx.Value(tagsResourceTypeContextKey{}).(string)
+
+	if !ok {
+		resource = "resource"
+	}
+
+	if id := diff.Id(); id != "" {
+		return fmt.Sprintf("%s (%s)", resource, id)
+	}
+
+	return fmt.Sprintf("new %s", resource)
+}
+
 // getInstanceTagValue returns instance tag value by name
 func getInstanceTagValue(conn *ec2.EC2, instanceId string, tagKey string) (*string, error) {
 	tagsResp, err := conn.DescribeTags(&ec2.DescribeTagsInput{
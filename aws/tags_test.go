@@ -0,0 +1,376 @@
+package aws
+
+import (
+	"context"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func TestConfigureResourceDefaultTags(t *testing.T) {
+	client := &AWSClient{
+		DefaultTagsConfig: &keyvaluetags.DefaultConfig{
+			Tags: keyvaluetags.New(map[string]interface{}{
+				"Environment": "test",
+				"Name":        "provider",
+			}),
+			ExcludeResourceTypes: []string{"aws_excluded"},
+		},
+	}
+
+	var gotDefaultTags keyvaluetags.KeyValueTags
+
+	testResource := func() *schema.Resource {
+		return &schema.Resource{
+			Read: func(d *schema.ResourceData, meta interface{}) error {
+				gotDefaultTags = meta.(*AWSClient).DefaultTagsConfig.GetTags()
+				return nil
+			},
+			Update: func(d *schema.ResourceData, meta interface{}) error {
+				return nil
+			},
+			Schema: map[string]*schema.Schema{
+				"tags":     tagsSchema(),
+				"tags_all": tagsSchemaComputed(),
+			},
+		}
+	}
+
+	resources := map[string]*schema.Resource{
+		"aws_excluded":   testResource(),
+		"aws_test":       testResource(),
+		"aws_not_tagged": {Schema: map[string]*schema.Schema{"name": {Type: schema.TypeString, Optional: true}}},
+	}
+
+	configureResourceDefaultTags(resources)
+
+	if _, ok := resources["aws_not_tagged"].Schema["default_tags_exclude_keys"]; ok {
+		t.Errorf("unexpected default_tags_exclude_keys argument in resource without tags_all")
+	}
+
+	testCases := []struct {
+		Name         string
+		ResourceType string
+		ExcludeKeys  []interface{}
+		Expected     map[string]string
+	}{
+		{
+			Name:         "no exclusions",
+			ResourceType: "aws_test",
+			Expected:     map[string]string{"Environment": "test", "Name": "provider"},
+		},
+		{
+			Name:         "excluded keys",
+			ResourceType: "aws_test",
+			ExcludeKeys:  []interface{}{"Name"},
+			Expected:     map[string]string{"Environment": "test"},
+		},
+		{
+			Name:         "excluded resource type",
+			ResourceType: "aws_excluded",
+			Expected:     map[string]string{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			resource := resources[testCase.ResourceType]
+			d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+				"default_tags_exclude_keys": testCase.ExcludeKeys,
+			})
+
+			if err := resource.Read(d, client); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := gotDefaultTags.Map(); len(got) != len(testCase.Expected) {
+				t.Errorf("got default tags %v, expected %v", got, testCase.Expected)
+			} else {
+				for k, v := range testCase.Expected {
+					if got[k] != v {
+						t.Errorf("got default tags %v, expected %v", got, testCase.Expected)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestSetTagsDiff_RequiredTags(t *testing.T) {
+	resources := map[string]*schema.Resource{
+		"aws_test": {
+			CustomizeDiff: SetTagsDiff,
+			Read: func(d *schema.ResourceData, meta interface{}) error {
+				return nil
+			},
+			Update: func(d *schema.ResourceData, meta interface{}) error {
+				return nil
+			},
+			Schema: map[string]*schema.Schema{
+				"tags":     tagsSchema(),
+				"tags_all": tagsSchemaComputed(),
+			},
+		},
+	}
+
+	configureResourceDefaultTags(resources)
+
+	testCases := []struct {
+		Name             string
+		EnforcementLevel string
+		Tags             map[string]interface{}
+		ExpectedError    *regexp.Regexp
+	}{
+		{
+			Name:             "satisfied",
+			EnforcementLevel: keyvaluetags.RequiredEnforcementLevelError,
+			Tags:             map[string]interface{}{"Environment": "prod", "Owner": "team"},
+		},
+		{
+			Name:             "default tags",
+			EnforcementLevel: keyvaluetags.RequiredEnforcementLevelError,
+			Tags:             map[string]interface{}{"Environment": "prod"},
+		},
+		{
+			Name:             "missing",
+			EnforcementLevel: keyvaluetags.RequiredEnforcementLevelError,
+			Tags:             map[string]interface{}{"Name": "test"},
+			ExpectedError:    regexp.MustCompile(`new aws_test does not satisfy .* missing required tags: Environment`),
+		},
+		{
+			Name:             "value not allowed",
+			EnforcementLevel: keyvaluetags.RequiredEnforcementLevelError,
+			Tags:             map[string]interface{}{"Environment": "qa"},
+			ExpectedError:    regexp.MustCompile(`tag values not allowed for keys: Environment`),
+		},
+		{
+			Name:             "warn",
+			EnforcementLevel: keyvaluetags.RequiredEnforcementLevelWarn,
+			Tags:             map[string]interface{}{"Name": "test"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			client := &AWSClient{
+				DefaultTagsConfig: &keyvaluetags.DefaultConfig{
+					Tags: keyvaluetags.New(map[string]interface{}{"Owner": "provider"}),
+				},
+				RequiredTagsConfig: &keyvaluetags.RequiredConfig{
+					EnforcementLevel: testCase.EnforcementLevel,
+					Tags: []*keyvaluetags.RequiredTag{
+						{Key: "Environment", ValueRegex: regexp.MustCompile(`^(dev|prod)$`)},
+						{Key: "Owner"},
+					},
+				},
+			}
+
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{"tags": testCase.Tags})
+			_, err := resources["aws_test"].Diff(context.Background(), nil, config, client)
+
+			if testCase.ExpectedError == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil || !testCase.ExpectedError.MatchString(err.Error()) {
+				t.Fatalf("expected error matching %q, got: %v", testCase.ExpectedError, err)
+			}
+
+			if strings.Contains(err.Error(), "Owner") {
+				t.Errorf("unexpected Owner violation, satisfied by default tags: %s", err)
+			}
+		})
+	}
+}
+
+func TestSetTagsDiff_TagPolicy(t *testing.T) {
+	sess, err := session.NewSession(&aws.Config{Region: aws.String(endpoints.UsWest2RegionID)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	policy, err := keyvaluetags.ParseTagPolicy(`{"tags": {"costcenter": {"tag_key": {"@@assign": "CostCenter"}, "tag_value": {"@@assign": ["100", "200"]}}}}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resources := map[string]*schema.Resource{
+		"aws_test": {
+			CustomizeDiff: SetTagsDiff,
+			Read: func(d *schema.ResourceData, meta interface{}) error {
+				return nil
+			},
+			Update: func(d *schema.ResourceData, meta interface{}) error {
+				return nil
+			},
+			Schema: map[string]*schema.Schema{
+				"tags":     tagsSchema(),
+				"tags_all": tagsSchemaComputed(),
+			},
+		},
+	}
+
+	configureResourceDefaultTags(resources)
+
+	testCases := []struct {
+		Name             string
+		EnforcementLevel string
+		Tags             map[string]interface{}
+		ExpectedError    *regexp.Regexp
+	}{
+		{
+			Name:             "compliant",
+			EnforcementLevel: keyvaluetags.RequiredEnforcementLevelError,
+			Tags:             map[string]interface{}{"CostCenter": "100", "Name": "test"},
+		},
+		{
+			Name:             "key capitalization",
+			EnforcementLevel: keyvaluetags.RequiredEnforcementLevelError,
+			Tags:             map[string]interface{}{"costcenter": "100"},
+			ExpectedError:    regexp.MustCompile(`new aws_test does not comply with the AWS Organizations tag policy: tag key "costcenter" must be capitalized as "CostCenter"`),
+		},
+		{
+			Name:             "value not allowed",
+			EnforcementLevel: keyvaluetags.RequiredEnforcementLevelError,
+			Tags:             map[string]interface{}{"CostCenter": "300"},
+			ExpectedError:    regexp.MustCompile(`tag "CostCenter" value "300" is not one of the allowed values: 100, 200`),
+		},
+		{
+			Name:             "warn",
+			EnforcementLevel: keyvaluetags.RequiredEnforcementLevelWarn,
+			Tags:             map[string]interface{}{"CostCenter": "300"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			compliance := &tagPolicyCompliance{EnforcementLevel: testCase.EnforcementLevel}
+			// Use the test policy instead of fetching the effective policy.
+			compliance.once.Do(func() { compliance.policy = policy })
+
+			client := &AWSClient{
+				partition:           endpoints.AwsPartitionID,
+				region:              endpoints.UsWest2RegionID,
+				session:             sess,
+				tagPolicyCompliance: compliance,
+			}
+
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{"tags": testCase.Tags})
+			_, err := resources["aws_test"].Diff(context.Background(), nil, config, client)
+
+			if testCase.ExpectedError == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil || !testCase.ExpectedError.MatchString(err.Error()) {
+				t.Fatalf("expected error matching %q, got: %v", testCase.ExpectedError, err)
+			}
+		})
+	}
+}
+
+func TestSetTagsDiff_TagKeyNormalization(t *testing.T) {
+	tagKeyCaseConfig, err := keyvaluetags.NewKeyCaseConfig([]string{"Environment"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resources := map[string]*schema.Resource{
+		"aws_test": {
+			CustomizeDiff: SetTagsDiff,
+			Read: func(d *schema.ResourceData, meta interface{}) error {
+				return nil
+			},
+			Update: func(d *schema.ResourceData, meta interface{}) error {
+				return nil
+			},
+			Schema: map[string]*schema.Schema{
+				"tags":     tagsSchema(),
+				"tags_all": tagsSchemaComputed(),
+			},
+		},
+	}
+
+	configureResourceDefaultTags(resources)
+
+	client := &AWSClient{
+		TagKeyCaseConfig: tagKeyCaseConfig,
+	}
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{"tags": map[string]interface{}{"environment": "prod", "Name": "test"}})
+	diff, err := resources["aws_test"].Diff(context.Background(), nil, config, client)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := diff.Attributes["tags_all.Environment"]; got == nil || got.New != "prod" {
+		t.Errorf("expected normalized tags_all.Environment, got: %#v", diff.Attributes)
+	}
+
+	if got := diff.Attributes["tags_all.environment"]; got != nil {
+		t.Errorf("unexpected tags_all.environment: %#v", got)
+	}
+
+	config = terraform.NewResourceConfigRaw(map[string]interface{}{"tags": map[string]interface{}{"environment": "prod", "ENVIRONMENT": "dev"}})
+	_, err = resources["aws_test"].Diff(context.Background(), nil, config, client)
+
+	if err == nil || !regexp.MustCompile(`new aws_test tags collide .*"ENVIRONMENT" "environment"\] normalize to "Environment"`).MatchString(err.Error()) {
+		t.Fatalf("expected collision error, got: %v", err)
+	}
+}
+
+func TestNormalizeResourceDataTagKeyCase(t *testing.T) {
+	tagKeyCaseConfig, err := keyvaluetags.NewKeyCaseConfig([]string{"Environment", "Owner"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := &AWSClient{
+		TagKeyCaseConfig: tagKeyCaseConfig,
+	}
+
+	resourceSchema := map[string]*schema.Schema{
+		"tags":     tagsSchema(),
+		"tags_all": tagsSchemaComputed(),
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{})
+	d.SetId("test")
+	d.Set("tags", map[string]interface{}{"environment": "prod", "OWNER": "team"})
+	d.Set("tags_all", map[string]interface{}{"environment": "prod", "OWNER": "team", "Default": "value"})
+
+	priorTags := map[string]interface{}{"environment": "prod", "Owner": "team"}
+
+	if err := normalizeResourceDataTagKeyCase(d, client, priorTags); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	wantTags := map[string]string{"environment": "prod", "Owner": "team"}
+
+	if got := keyvaluetags.New(d.Get("tags").(map[string]interface{})).Map(); !reflect.DeepEqual(got, wantTags) {
+		t.Errorf("got tags %v, want %v", got, wantTags)
+	}
+
+	wantAllTags := map[string]string{"Environment": "prod", "Owner": "team", "Default": "value"}
+
+	if got := keyvaluetags.New(d.Get("tags_all").(map[string]interface{})).Map(); !reflect.DeepEqual(got, wantAllTags) {
+		t.Errorf("got tags_all %v, want %v", got, wantAllTags)
+	}
+}
@@ -1086,6 +1086,28 @@ func validateUTCTimestamp(v interface{}, k string) (ws []string, errors []error)
 	return
 }
 
+// validateDuration validates a string that can be parsed by time.ParseDuration
+// as a non-negative duration, e.g. "30s" or "1h30m".
+func validateDuration(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q cannot be parsed as a duration: %s", k, err))
+		return
+	}
+	if duration < 0 {
+		errors = append(errors, fmt.Errorf("%q must not be negative", k))
+	}
+	return
+}
+
+// validateEndpointURLTemplate validates an HTTP or HTTPS URL which may contain
+// {service} and {region} placeholders, e.g. "https://{service}.{region}.internal".
+func validateEndpointURLTemplate(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	return validation.IsURLWithHTTPorHTTPS(expandEndpointURLTemplate(value, "service", "region"), k)
+}
+
 // Validates that ECS Placement Constraints are set correctly
 // Takes type, and expression as strings
 func validateAwsEcsPlacementConstraint(constType, constExpr string) error {
@@ -3265,6 +3265,64 @@ func TestValidateUTCTimestamp(t *testing.T) {
 	}
 }
 
+func TestValidateDuration(t *testing.T) {
+	validT := []string{
+		"0s",
+		"30s",
+		"1h30m",
+	}
+
+	invalidT := []string{
+		"",
+		"30",
+		"-5m",
+		"one minute",
+	}
+
+	for _, f := range validT {
+		_, errors := validateDuration(f, "duration")
+		if len(errors) > 0 {
+			t.Fatalf("expected the duration %q to be valid, got error %q", f, errors)
+		}
+	}
+
+	for _, f := range invalidT {
+		_, errors := validateDuration(f, "duration")
+		if len(errors) == 0 {
+			t.Fatalf("expected the duration %q to fail validation", f)
+		}
+	}
+}
+
+func TestValidateEndpointURLTemplate(t *testing.T) {
+	validT := []string{
+		"http://localhost:4566",
+		"https://{service}.{region}.internal",
+		"https://aws.example.com/{region}/{service}",
+	}
+
+	invalidT := []string{
+		"",
+		"localhost:4566",
+		"ftp://{service}.example.com",
+		"https://",
+	}
+
+	for _, f := range validT {
+		_, errors := validateEndpointURLTemplate(f, "endpoint_url_template")
+		if len(errors) > 0 {
+			t.Fatalf("expected the endpoint URL template %q to be valid, got error %q", f, errors)
+		}
+	}
+
+	for _, f := range invalidT {
+		_, errors := validateEndpointURLTemplate(f, "endpoint_url_template")
+		if len(errors) == 0 {
+			t.Fatalf("expected the endpoint URL template %q to fail validation", f)
+		}
+	}
+}
+
 func TestValidateTypeStringIsDateOrInt(t *testing.T) {
 	validT := []string{
 		"2006-01-02T15:04:05Z",
@@ -0,0 +1,68 @@
+// Package allowlist reads allowlist files of justified exceptions to analyzers.
+//
+// Each non-empty line of an allowlist file which is not a comment contains the
+// analyzer name, the exempted name and a justification comment, e.g.
+//
+//	AWSR003 aws_example_thing # Deleted with its parent resource
+package allowlist
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Load returns the names exempted from the analyzer by the allowlist file,
+// mapped to their justifications. An empty filename returns no names.
+func Load(filename string, analyzerName string) (map[string]string, error) {
+	result := make(map[string]string)
+
+	if filename == "" {
+		return result, nil
+	}
+
+	f, err := os.Open(filename)
+
+	if err != nil {
+		return nil, fmt.Errorf("error opening allowlist: %w", err)
+	}
+
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		entry, justification := text, ""
+
+		if i := strings.Index(text, "#"); i >= 0 {
+			entry, justification = strings.TrimSpace(text[:i]), strings.TrimSpace(text[i+1:])
+		}
+
+		fields := strings.Fields(entry)
+
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("%s:%d: expected analyzer name and exempted name, got: %s", filename, line, entry)
+		}
+
+		if justification == "" {
+			return nil, fmt.Errorf("%s:%d: missing justification comment for %s %s", filename, line, fields[0], fields[1])
+		}
+
+		if fields[0] == analyzerName {
+			result[fields[1]] = justification
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading allowlist: %w", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,91 @@
+package allowlist
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLoad(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Contents      string
+		Expected      map[string]string
+		ExpectedError string
+	}{
+		{
+			Name: "valid",
+			Contents: `# Comment
+
+AWSR003 aws_example_thing # Deleted with its parent
+AWSR004 aws_example_thing # Not deletable
+AWSR003   aws_example_other   #   Singleton  
+`,
+			Expected: map[string]string{
+				"aws_example_other": "Singleton",
+				"aws_example_thing": "Deleted with its parent",
+			},
+		},
+		{
+			Name:          "missing justification",
+			Contents:      "AWSR003 aws_example_thing\n",
+			ExpectedError: "missing justification comment for AWSR003 aws_example_thing",
+		},
+		{
+			Name:          "missing name",
+			Contents:      "AWSR003 # Justification\n",
+			ExpectedError: "expected analyzer name and exempted name",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "allowlist")
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer os.RemoveAll(dir)
+
+			filename := filepath.Join(dir, "allowlist.txt")
+
+			if err := ioutil.WriteFile(filename, []byte(testCase.Contents), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := Load(filename, "AWSR003")
+
+			if testCase.ExpectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.ExpectedError) {
+					t.Fatalf("got error %v, expected %q", err, testCase.ExpectedError)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestLoad_NoFile(t *testing.T) {
+	got, err := Load("", "AWSR003")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(got) != 0 {
+		t.Errorf("got %v, expected no names", got)
+	}
+}
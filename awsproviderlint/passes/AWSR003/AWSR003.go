@@ -0,0 +1,101 @@
+package AWSR003
+
+import (
+	"go/ast"
+
+	"github.com/bflad/tfproviderlint/helper/astutils"
+	"github.com/bflad/tfproviderlint/helper/terraformtype/helper/resource"
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"github.com/terraform-providers/terraform-provider-aws/awsproviderlint/helper/allowlist"
+	"github.com/terraform-providers/terraform-provider-aws/awsproviderlint/passes/helper/providerresourcesmap"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const Doc = `check for resources without a test sweeper
+
+The AWSR003 analyzer reports when a resource registered in the provider
+ResourcesMap has no test sweeper registered with resource.AddTestSweepers()
+under the resource type name. Sweepers remove resources left behind by failed
+acceptance tests. Justified exceptions can be listed in an allowlist file,
+which is set with the -AWSR003.allowlist flag.
+`
+
+const (
+	analyzerName = "AWSR003"
+
+	funcNameAddTestSweepers = `AddTestSweepers`
+)
+
+var allowlistFile string
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+		inspect.Analyzer,
+		providerresourcesmap.Analyzer,
+	},
+	Run: run,
+}
+
+func init() {
+	Analyzer.Flags.StringVar(&allowlistFile, "allowlist", "", "allowlist file of resources without test sweepers")
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	if !providerresourcesmap.HasTestFiles(pass) {
+		return nil, nil
+	}
+
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	resources := pass.ResultOf[providerresourcesmap.Analyzer].([]*providerresourcesmap.Resource)
+
+	if len(resources) == 0 {
+		return nil, nil
+	}
+
+	allowlisted, err := allowlist.Load(allowlistFile, analyzerName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	sweepers := make(map[string]bool)
+	nodeFilter := []ast.Node{
+		(*ast.CallExpr)(nil),
+	}
+
+	inspect.Preorder(nodeFilter, func(n ast.Node) {
+		callExpr := n.(*ast.CallExpr)
+
+		if !resource.IsFunc(callExpr.Fun, pass.TypesInfo, funcNameAddTestSweepers) || len(callExpr.Args) < 1 {
+			return
+		}
+
+		if name := astutils.ExprStringValue(callExpr.Args[0]); name != nil {
+			sweepers[*name] = true
+		}
+	})
+
+	for _, r := range resources {
+		if sweepers[r.Name] {
+			continue
+		}
+
+		if _, ok := allowlisted[r.Name]; ok {
+			continue
+		}
+
+		if commentIgnorer.ShouldIgnore(analyzerName, r.Node) {
+			continue
+		}
+
+		pass.Reportf(r.Node.Pos(), "%s: missing resource.AddTestSweepers() for resource (%s)", analyzerName, r.Name)
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,25 @@
+package a
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceAwsExampleThing() *schema.Resource {
+	return &schema.Resource{}
+}
+
+func resourceAwsExampleAllowlisted() *schema.Resource {
+	return &schema.Resource{}
+}
+
+func resourceAwsExampleIgnored() *schema.Resource {
+	return &schema.Resource{}
+}
+
+func resourceAwsExampleMissing() *schema.Resource {
+	return &schema.Resource{}
+}
+
+func resourceAwsExampleAssigned() *schema.Resource {
+	return &schema.Resource{}
+}
@@ -0,0 +1,30 @@
+package a
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Provider is declared in a test file, as the analyzer only reports in the test variant of a package.
+func Provider() *schema.Provider {
+	provider := &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			/* Passing cases */
+
+			"aws_example_thing":       resourceAwsExampleThing(),
+			"aws_example_allowlisted": resourceAwsExampleAllowlisted(),
+
+			/* Comment ignored cases */
+
+			//lintignore:AWSR003
+			"aws_example_ignored": resourceAwsExampleIgnored(),
+
+			/* Failing cases */
+
+			"aws_example_missing": resourceAwsExampleMissing(), // want "missing resource.AddTestSweepers\\(\\) for resource \\(aws_example_missing\\)"
+		},
+	}
+
+	provider.ResourcesMap["aws_example_assigned"] = resourceAwsExampleAssigned() // want "missing resource.AddTestSweepers\\(\\) for resource \\(aws_example_assigned\\)"
+
+	return provider
+}
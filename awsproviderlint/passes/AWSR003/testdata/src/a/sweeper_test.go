@@ -0,0 +1,16 @@
+package a
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_example_thing", &resource.Sweeper{
+		Name: "aws_example_thing",
+		F:    testSweepExampleThings,
+	})
+}
+
+func testSweepExampleThings(region string) error {
+	return nil
+}
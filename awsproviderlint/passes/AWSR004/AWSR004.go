@@ -0,0 +1,173 @@
+package AWSR004
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"github.com/terraform-providers/terraform-provider-aws/awsproviderlint/helper/allowlist"
+	"github.com/terraform-providers/terraform-provider-aws/awsproviderlint/passes/helper/providerresourcesmap"
+	"golang.org/x/tools/go/analysis"
+)
+
+const Doc = `check for resources without a disappears acceptance test
+
+The AWSR004 analyzer reports when a resource registered in the provider
+ResourcesMap has no acceptance test with _disappears in its name, which
+verifies that the resource is recreated when deleted outside Terraform.
+
+A resource is considered tested when a _disappears test function, or a test
+helper function it calls directly, references the function returning the
+resource, e.g. resourceAwsVpc() in
+testAccCheckResourceDisappears(testAccProvider, resourceAwsVpc(), resourceName),
+or when a _disappears test function is declared in the _test.go file
+corresponding to the file declaring that function. Justified exceptions can be
+listed in an allowlist file, which is set with the -AWSR004.allowlist flag.
+`
+
+const (
+	analyzerName = "AWSR004"
+
+	testFuncNameDisappears = `_disappears`
+)
+
+var allowlistFile string
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+		providerresourcesmap.Analyzer,
+	},
+	Run: run,
+}
+
+func init() {
+	Analyzer.Flags.StringVar(&allowlistFile, "allowlist", "", "allowlist file of resources without disappears acceptance tests")
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	if !providerresourcesmap.HasTestFiles(pass) {
+		return nil, nil
+	}
+
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+	resources := pass.ResultOf[providerresourcesmap.Analyzer].([]*providerresourcesmap.Resource)
+
+	if len(resources) == 0 {
+		return nil, nil
+	}
+
+	allowlisted, err := allowlist.Load(allowlistFile, analyzerName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	funcDecls := make(map[*types.Func]*ast.FuncDecl)
+	var disappearsTests []*ast.FuncDecl
+
+	for _, f := range pass.Files {
+		for _, decl := range f.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+
+			if !ok || funcDecl.Body == nil {
+				continue
+			}
+
+			if fn, ok := pass.TypesInfo.Defs[funcDecl.Name].(*types.Func); ok {
+				funcDecls[fn] = funcDecl
+			}
+
+			if isDisappearsTest(pass, funcDecl) {
+				disappearsTests = append(disappearsTests, funcDecl)
+			}
+		}
+	}
+
+	tested := make(map[*types.Func]bool)
+	testedFiles := make(map[string]bool)
+
+	for _, test := range disappearsTests {
+		testedFiles[pass.Fset.File(test.Pos()).Name()] = true
+
+		for _, fn := range referencedFuncs(pass, test.Body) {
+			tested[fn] = true
+
+			// Test helper functions are inspected, but not functions declared outside test files,
+			// such as Provider(), which references every resource.
+			if funcDecl, ok := funcDecls[fn]; ok && isTestFile(pass, funcDecl) {
+				for _, fn := range referencedFuncs(pass, funcDecl.Body) {
+					tested[fn] = true
+				}
+			}
+		}
+	}
+
+	for _, r := range resources {
+		if r.Func != nil {
+			if tested[r.Func] {
+				continue
+			}
+
+			if filename := pass.Fset.File(r.Func.Pos()).Name(); testedFiles[strings.TrimSuffix(filename, ".go")+"_test.go"] {
+				continue
+			}
+		}
+
+		if _, ok := allowlisted[r.Name]; ok {
+			continue
+		}
+
+		if commentIgnorer.ShouldIgnore(analyzerName, r.Node) {
+			continue
+		}
+
+		pass.Reportf(r.Node.Pos(), "%s: missing %s acceptance test for resource (%s)", analyzerName, testFuncNameDisappears, r.Name)
+	}
+
+	return nil, nil
+}
+
+// isDisappearsTest returns whether the function is a _disappears acceptance test, e.g. TestAccAWSVpc_disappears,
+// or a serialized test function called by one, e.g. testAccAWSAccessAnalyzerAnalyzer_disappears.
+func isDisappearsTest(pass *analysis.Pass, funcDecl *ast.FuncDecl) bool {
+	if funcDecl.Recv != nil || !strings.Contains(funcDecl.Name.Name, testFuncNameDisappears) {
+		return false
+	}
+
+	params := funcDecl.Type.Params.List
+
+	if len(params) != 1 || len(params[0].Names) > 1 {
+		return false
+	}
+
+	return pass.TypesInfo.TypeOf(params[0].Type).String() == "*testing.T"
+}
+
+func isTestFile(pass *analysis.Pass, node ast.Node) bool {
+	return strings.HasSuffix(pass.Fset.File(node.Pos()).Name(), "_test.go")
+}
+
+// referencedFuncs returns the functions of the analyzed package referenced in the node.
+func referencedFuncs(pass *analysis.Pass, node ast.Node) []*types.Func {
+	var result []*types.Func
+
+	ast.Inspect(node, func(n ast.Node) bool {
+		ident, ok := n.(*ast.Ident)
+
+		if !ok {
+			return true
+		}
+
+		if fn, ok := pass.TypesInfo.Uses[ident].(*types.Func); ok && fn.Pkg() == pass.Pkg {
+			result = append(result, fn)
+		}
+
+		return true
+	})
+
+	return result
+}
@@ -0,0 +1,9 @@
+package a
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceAwsExampleFile() *schema.Resource {
+	return &schema.Resource{}
+}
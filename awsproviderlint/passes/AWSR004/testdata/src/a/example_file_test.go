@@ -0,0 +1,13 @@
+package a
+
+import (
+	"testing"
+)
+
+func TestAccAWSExampleFile_disappears(t *testing.T) {
+	testAccCheckExampleFileDisappears()
+}
+
+func testAccCheckExampleFileDisappears() error {
+	return nil
+}
@@ -0,0 +1,33 @@
+package a
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceAwsExampleThing() *schema.Resource {
+	return &schema.Resource{}
+}
+
+func resourceAwsExampleHelper() *schema.Resource {
+	return &schema.Resource{}
+}
+
+func resourceAwsExampleAllowlisted() *schema.Resource {
+	return &schema.Resource{}
+}
+
+func resourceAwsExampleIgnored() *schema.Resource {
+	return &schema.Resource{}
+}
+
+func resourceAwsExampleMissing() *schema.Resource {
+	return &schema.Resource{}
+}
+
+func resourceAwsExampleSerial() *schema.Resource {
+	return &schema.Resource{}
+}
+
+func resourceAwsExampleSignature() *schema.Resource {
+	return &schema.Resource{}
+}
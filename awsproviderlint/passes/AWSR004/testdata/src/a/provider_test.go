@@ -0,0 +1,70 @@
+package a
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Provider is declared in a test file, as the analyzer only reports in the test variant of a package.
+func Provider() *schema.Provider {
+	return &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			/* Passing cases */
+
+			"aws_example_thing":       resourceAwsExampleThing(),
+			"aws_example_helper":      resourceAwsExampleHelper(),
+			"aws_example_file":        resourceAwsExampleFile(),
+			"aws_example_serial":      resourceAwsExampleSerial(),
+			"aws_example_allowlisted": resourceAwsExampleAllowlisted(),
+
+			/* Comment ignored cases */
+
+			//lintignore:AWSR004
+			"aws_example_ignored": resourceAwsExampleIgnored(),
+
+			/* Failing cases */
+
+			"aws_example_missing":   resourceAwsExampleMissing(),   // want "missing _disappears acceptance test for resource \\(aws_example_missing\\)"
+			"aws_example_signature": resourceAwsExampleSignature(), // want "missing _disappears acceptance test for resource \\(aws_example_signature\\)"
+		},
+	}
+}
+
+func testAccCheckResourceDisappears(resource *schema.Resource) error {
+	return nil
+}
+
+func TestAccAWSExampleThing_disappears(t *testing.T) {
+	testAccCheckResourceDisappears(resourceAwsExampleThing())
+}
+
+func TestAccAWSExampleHelper_disappears(t *testing.T) {
+	testAccCheckExampleHelperDisappears()
+}
+
+func testAccCheckExampleHelperDisappears() error {
+	return testAccCheckResourceDisappears(resourceAwsExampleHelper())
+}
+
+func TestAccAWSExampleMissing_basic(t *testing.T) {
+	testAccCheckResourceDisappears(resourceAwsExampleMissing())
+}
+
+func TestAccAWSExample_serial(t *testing.T) {
+	testCases := map[string]func(t *testing.T){
+		"disappears": testAccAWSExampleSerial_disappears,
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, tc)
+	}
+}
+
+func testAccAWSExampleSerial_disappears(t *testing.T) {
+	testAccCheckResourceDisappears(resourceAwsExampleSerial())
+}
+
+func testAccCheckExampleSignature_disappears(resource *schema.Resource) error {
+	return testAccCheckResourceDisappears(resourceAwsExampleSignature())
+}
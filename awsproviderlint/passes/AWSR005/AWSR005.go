@@ -0,0 +1,224 @@
+package AWSR005
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/token"
+	"go/types"
+	"strconv"
+	"strings"
+
+	"github.com/bflad/tfproviderlint/helper/astutils"
+	"github.com/bflad/tfproviderlint/helper/terraformtype/diag"
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"github.com/bflad/tfproviderlint/passes/helper/schema/resourcedatasetcallexpr"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const Doc = `check for d.Set() of complex values without error checking
+
+The AWSR005 analyzer reports when a (schema.ResourceData).Set() call with a
+value which is not a primitive, such as the result of a flatten function or a
+*schema.Set, does not check the returned error. Setting a TypeList, TypeMap or
+TypeSet attribute can fail, for example when the value does not match the
+schema, and the unchecked error silently loses the attribute from state.
+
+Values of interface types are not reported, as their dynamic type is unknown.
+Where the enclosing function returns error or diag.Diagnostics, a suggested
+fix wraps the call with error checking.
+`
+
+const analyzerName = "AWSR005"
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+		inspect.Analyzer,
+		resourcedatasetcallexpr.Analyzer,
+	},
+	Run: run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	callExprs := pass.ResultOf[resourcedatasetcallexpr.Analyzer].([]*ast.CallExpr)
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	setCallExprs := make(map[*ast.CallExpr]bool, len(callExprs))
+
+	for _, callExpr := range callExprs {
+		setCallExprs[callExpr] = true
+	}
+
+	nodeFilter := []ast.Node{
+		(*ast.ExprStmt)(nil),
+	}
+
+	inspect.WithStack(nodeFilter, func(n ast.Node, push bool, stack []ast.Node) bool {
+		if !push {
+			return true
+		}
+
+		exprStmt := n.(*ast.ExprStmt)
+		callExpr, ok := exprStmt.X.(*ast.CallExpr)
+
+		if !ok || !setCallExprs[callExpr] {
+			return true
+		}
+
+		if commentIgnorer.ShouldIgnore(analyzerName, callExpr) {
+			return true
+		}
+
+		if len(callExpr.Args) < 2 {
+			return true
+		}
+
+		if !isComplexType(pass.TypesInfo.TypeOf(callExpr.Args[1])) {
+			return true
+		}
+
+		diagnostic := analysis.Diagnostic{
+			Pos:     callExpr.Pos(),
+			End:     callExpr.End(),
+			Message: fmt.Sprintf("%s: missing error checking of (schema.ResourceData).Set() with complex value", analyzerName),
+		}
+
+		if fix := suggestedFix(pass, exprStmt, callExpr, stack); fix != nil {
+			diagnostic.SuggestedFixes = []analysis.SuggestedFix{*fix}
+		}
+
+		pass.Report(diagnostic)
+
+		return true
+	})
+
+	return nil, nil
+}
+
+// isComplexType returns whether the type is neither a primitive, a pointer to a primitive, nor an interface.
+func isComplexType(t types.Type) bool {
+	if t == nil {
+		return false
+	}
+
+	if pointer, ok := t.Underlying().(*types.Pointer); ok {
+		t = pointer.Elem()
+	}
+
+	switch t.Underlying().(type) {
+	case *types.Basic, *types.Interface:
+		return false
+	}
+
+	return true
+}
+
+// suggestedFix returns a fix wrapping the d.Set() call with error checking,
+// or nil if the enclosing function does not return error or diag.Diagnostics,
+// the attribute name is not a string literal or the fmt package is not imported.
+func suggestedFix(pass *analysis.Pass, exprStmt *ast.ExprStmt, callExpr *ast.CallExpr, stack []ast.Node) *analysis.SuggestedFix {
+	attributeName := astutils.ExprStringValue(callExpr.Args[0])
+
+	if attributeName == nil {
+		return nil
+	}
+
+	fmtName := importName(pass, exprStmt, "fmt")
+
+	if fmtName == "" {
+		return nil
+	}
+
+	results := enclosingFuncResults(stack)
+
+	if results == nil || len(results.List) != 1 || len(results.List[0].Names) > 1 {
+		return nil
+	}
+
+	errExpr := fmt.Sprintf("%s.Errorf(%s, err)", fmtName, strconv.Quote(fmt.Sprintf("error setting %s: %%w", *attributeName)))
+	resultType := pass.TypesInfo.TypeOf(results.List[0].Type)
+
+	switch {
+	case types.Identical(resultType, types.Universe.Lookup("error").Type()):
+	case isDiagnosticsType(resultType):
+		var b bytes.Buffer
+
+		// Reuse the qualifier of the result type, e.g. diag.Diagnostics.
+		if err := format.Node(&b, token.NewFileSet(), results.List[0].Type); err != nil {
+			return nil
+		}
+
+		errExpr = fmt.Sprintf("%s.FromErr(%s)", strings.TrimSuffix(b.String(), "."+diag.TypeNameDiagnostics), errExpr)
+	default:
+		return nil
+	}
+
+	var call bytes.Buffer
+
+	if err := format.Node(&call, pass.Fset, callExpr); err != nil {
+		return nil
+	}
+
+	indent := strings.Repeat("\t", pass.Fset.Position(exprStmt.Pos()).Column-1)
+
+	return &analysis.SuggestedFix{
+		Message: "check error from (schema.ResourceData).Set()",
+		TextEdits: []analysis.TextEdit{
+			{
+				Pos:     exprStmt.Pos(),
+				End:     exprStmt.End(),
+				NewText: []byte(fmt.Sprintf("if err := %s; err != nil {\n%s\treturn %s\n%s}", call.String(), indent, errExpr, indent)),
+			},
+		},
+	}
+}
+
+// enclosingFuncResults returns the results of the innermost function declaration or literal in the stack.
+func enclosingFuncResults(stack []ast.Node) *ast.FieldList {
+	for i := len(stack) - 1; i >= 0; i-- {
+		switch n := stack[i].(type) {
+		case *ast.FuncDecl:
+			return n.Type.Results
+		case *ast.FuncLit:
+			return n.Type.Results
+		}
+	}
+
+	return nil
+}
+
+func isDiagnosticsType(t types.Type) bool {
+	named, ok := t.(*types.Named)
+
+	return ok && astutils.IsModulePackageNamedType(named, diag.PackageModule, diag.PackageModulePath, diag.TypeNameDiagnostics)
+}
+
+// importName returns the name of the package imported by the file containing the node, or an empty string if it is not imported.
+func importName(pass *analysis.Pass, node ast.Node, path string) string {
+	for _, f := range pass.Files {
+		if f.Pos() > node.Pos() || node.Pos() > f.End() {
+			continue
+		}
+
+		for _, spec := range f.Imports {
+			if importPath, err := strconv.Unquote(spec.Path.Value); err != nil || importPath != path {
+				continue
+			}
+
+			if spec.Name != nil {
+				return spec.Name.Name
+			}
+
+			return path[strings.LastIndex(path, "/")+1:]
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,73 @@
+package a
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func f() {
+	var d schema.ResourceData
+
+	d.Set("test", []interface{}{}) // want "AWSR005: missing error checking of \\(schema.ResourceData\\).Set\\(\\) with complex value"
+
+	/* Passing cases */
+
+	d.Set("test", "primitive")
+	d.Set("test", 1)
+	d.Set("test", true)
+
+	var s *string
+	d.Set("test", s)
+
+	var i interface{}
+	d.Set("test", i)
+
+	//lintignore:AWSR005
+	d.Set("test", []interface{}{})
+
+	d.Set("test", []interface{}{}) //lintignore:AWSR005
+
+	if err := d.Set("test", []interface{}{}); err != nil {
+		return
+	}
+
+	_ = d.Set("test", []interface{}{})
+}
+
+func resourceRead(d *schema.ResourceData, meta interface{}) error {
+	d.Set("test", flattenTest()) // want "AWSR005: missing error checking of \\(schema.ResourceData\\).Set\\(\\) with complex value"
+
+	d.Set("test", map[string]interface{}{}) // want "AWSR005: missing error checking of \\(schema.ResourceData\\).Set\\(\\) with complex value"
+
+	key := "test"
+	d.Set(key, flattenTest()) // want "AWSR005: missing error checking of \\(schema.ResourceData\\).Set\\(\\) with complex value"
+
+	/* Passing cases */
+
+	d.Set("test", "primitive")
+
+	if err := d.Set("test", flattenTest()); err != nil {
+		return fmt.Errorf("error setting test: %w", err)
+	}
+
+	return nil
+}
+
+func resourceReadContext(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.Set("test", flattenTest()) // want "AWSR005: missing error checking of \\(schema.ResourceData\\).Set\\(\\) with complex value"
+
+	f := func() {
+		d.Set("test", flattenTest()) // want "AWSR005: missing error checking of \\(schema.ResourceData\\).Set\\(\\) with complex value"
+	}
+
+	f()
+
+	return nil
+}
+
+func flattenTest() []interface{} {
+	return nil
+}
@@ -0,0 +1,132 @@
+package providerresourcesmap
+
+import (
+	"go/ast"
+	"go/types"
+	"reflect"
+	"strings"
+
+	"github.com/bflad/tfproviderlint/helper/astutils"
+	"github.com/bflad/tfproviderlint/passes/helper/schema/resourcemapcompositelit"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const fieldNameResourcesMap = `ResourcesMap`
+
+// Resource is a resource type registered in a provider ResourcesMap.
+type Resource struct {
+	// Name is the resource type name, e.g. aws_vpc.
+	Name string
+
+	// Node is the ResourcesMap key-value expression or assignment registering the resource.
+	Node ast.Node
+
+	// Func is the function returning the *schema.Resource, e.g. resourceAwsVpc,
+	// or nil if the resource is not registered by calling a package function.
+	Func *types.Func
+}
+
+var Analyzer = &analysis.Analyzer{
+	Name: "providerresourcesmap",
+	Doc:  "find resources registered in (schema.Provider).ResourcesMap for later passes",
+	Requires: []*analysis.Analyzer{
+		inspect.Analyzer,
+		resourcemapcompositelit.Analyzer,
+	},
+	Run:        run,
+	ResultType: reflect.TypeOf([]*Resource{}),
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	compositeLits := pass.ResultOf[resourcemapcompositelit.Analyzer].([]*ast.CompositeLit)
+	nodeFilter := []ast.Node{
+		(*ast.AssignStmt)(nil),
+		(*ast.KeyValueExpr)(nil),
+	}
+	var result []*Resource
+
+	resourcesMaps := make(map[*ast.CompositeLit]bool)
+
+	for _, compositeLit := range compositeLits {
+		resourcesMaps[compositeLit] = true
+	}
+
+	inspect.Preorder(nodeFilter, func(n ast.Node) {
+		switch n := n.(type) {
+		case *ast.KeyValueExpr:
+			// ResourcesMap: map[string]*schema.Resource{...}
+			if ident, ok := n.Key.(*ast.Ident); !ok || ident.Name != fieldNameResourcesMap {
+				return
+			}
+
+			compositeLit, ok := n.Value.(*ast.CompositeLit)
+
+			if !ok || !resourcesMaps[compositeLit] {
+				return
+			}
+
+			for _, elt := range compositeLit.Elts {
+				kv, ok := elt.(*ast.KeyValueExpr)
+
+				if !ok {
+					continue
+				}
+
+				if name := astutils.ExprStringValue(kv.Key); name != nil {
+					result = append(result, newResource(pass, *name, kv, kv.Value))
+				}
+			}
+		case *ast.AssignStmt:
+			// provider.ResourcesMap["name"] = ...
+			if len(n.Lhs) != 1 || len(n.Rhs) != 1 {
+				return
+			}
+
+			indexExpr, ok := n.Lhs[0].(*ast.IndexExpr)
+
+			if !ok {
+				return
+			}
+
+			if selectorExpr, ok := indexExpr.X.(*ast.SelectorExpr); !ok || selectorExpr.Sel.Name != fieldNameResourcesMap {
+				return
+			}
+
+			if name := astutils.ExprStringValue(indexExpr.Index); name != nil {
+				result = append(result, newResource(pass, *name, n, n.Rhs[0]))
+			}
+		}
+	})
+
+	return result, nil
+}
+
+func newResource(pass *analysis.Pass, name string, node ast.Node, value ast.Expr) *Resource {
+	resource := &Resource{
+		Name: name,
+		Node: node,
+	}
+
+	if callExpr, ok := value.(*ast.CallExpr); ok {
+		if ident, ok := callExpr.Fun.(*ast.Ident); ok {
+			resource.Func, _ = pass.TypesInfo.ObjectOf(ident).(*types.Func)
+		}
+	}
+
+	return resource
+}
+
+// HasTestFiles returns whether the analyzed package includes _test.go files.
+// Analyzers checking for test coverage of resources only report in the test variant of a package.
+func HasTestFiles(pass *analysis.Pass) bool {
+	for _, f := range pass.Files {
+		if strings.HasSuffix(pass.Fset.File(f.Pos()).Name(), "_test.go") {
+			return true
+		}
+	}
+
+	return false
+}
@@ -25,6 +25,7 @@ require (
 	github.com/pquerna/otp v1.3.0
 	github.com/shopspring/decimal v1.2.0
 	golang.org/x/crypto v0.0.0-20210421170649-83a5a9bb288b
+	golang.org/x/net v0.0.0-20210614182718-04defd469f4e
 	gopkg.in/yaml.v2 v2.4.0
 )
 
@@ -20,6 +20,8 @@ func main() {
 	if debugMode {
 		err := plugin.Debug(context.Background(), "registry.terraform.io/hashicorp/aws", opts)
 
+		aws.WriteAPIMetrics()
+
 		if err != nil {
 			log.Fatal(err.Error())
 		}
@@ -28,4 +30,6 @@ func main() {
 	}
 
 	plugin.Serve(opts)
+
+	aws.WriteAPIMetrics()
 }